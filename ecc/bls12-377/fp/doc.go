@@ -50,4 +50,6 @@
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// The methods with a CT suffix (InverseCT, ExpCT, ...) run in constant time with respect to their
+// inputs, and should be used instead of their variable-time counterparts on secret values.
 package fp
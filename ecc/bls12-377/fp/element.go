@@ -26,10 +26,12 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/bits-and-blooms/bitset"
 	"github.com/consensys/gnark-crypto/field/hash"
 	"github.com/consensys/gnark-crypto/field/pool"
+	"github.com/consensys/gnark-crypto/utils"
 )
 
 // Element represents a field element stored on 6 words (uint64)
@@ -44,6 +46,8 @@ import (
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// The methods with a CT suffix (InverseCT, ExpCT, ...) run in constant time with respect to their
+// inputs, and should be used instead of their variable-time counterparts on secret values.
 type Element [6]uint64
 
 const (
@@ -1335,11 +1339,16 @@ func (z *Element) Legendre() int {
 // if the square root doesn't exist (x is not a square mod q)
 // Sqrt leaves z unchanged and returns nil
 func (z *Element) Sqrt(x *Element) *Element {
-	// q ≡ 1 (mod 4)
-	// see modSqrtTonelliShanks in math/big/int.go
-	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+	// q ≡ 1 (mod 4), q-1 = 2ᵉ * s with s odd and a large e
+	// using the table-based Tonelli-Shanks algorithm of https://eprint.iacr.org/2020/1407:
+	// y = x^((s+1)/2) satisfies y² = x * b with b = xˢ = gᵏ a 2ᵉ-th root of unity,
+	// and the discrete logarithm k is computed 8 bits at a time.
+	if x.IsZero() {
+		return z.SetZero()
+	}
+	tables := getSqrtTablesElement()
 
-	var y, b, t, w Element
+	var y, b, w Element
 	// w = x^((s-1)/2))
 	w.expBySqrtExp(*x)
 
@@ -1349,54 +1358,255 @@ func (z *Element) Sqrt(x *Element) *Element {
 	// b = xˢ = w * w * x = y * x
 	b.Mul(&w, &y)
 
-	// g = nonResidue ^ s
-	var g = Element{
-		7563926049028936178,
-		2688164645460651601,
-		12112688591437172399,
-		3177973240564633687,
-		14764383749841851163,
-		52487407124055189,
+	// bs[l] = b^(2^(sqrtWindow*l))
+	var bs [sqrtNbWindows]Element
+	bs[0] = b
+	for l := 1; l < sqrtNbWindows; l++ {
+		bs[l] = bs[l-1]
+		for i := 0; i < sqrtWindow; i++ {
+			bs[l].Square(&bs[l])
+		}
+	}
+
+	// k is split in windows of sqrtFirstWindow, sqrtWindow, ..., sqrtWindow bits, starting
+	// from the least significant bits. If k' is the value of the windows k₀, ..., kᵢ₋₁
+	// already known, (b * g^(-k'))^(2^(sqrtWindow*(sqrtNbWindows-1-i))) lies in the
+	// subgroup of order 2^sqrtWindow, where its discrete logarithm gives kᵢ.
+	var k [sqrtNbWindows]uint64
+	for i := 0; i < sqrtNbWindows; i++ {
+		l := sqrtNbWindows - 1 - i
+		t := bs[l]
+		for j := 0; j < i; j++ {
+			t.Mul(&t, &tables.table(j, l)[k[j]])
+		}
+		k[i] = tables.dlog[t]
+		if i == 0 {
+			// the first window is in the subgroup of order 2^sqrtFirstWindow
+			k[0] >>= sqrtWindow - sqrtFirstWindow
+		}
 	}
-	r := uint64(46)
 
-	// compute legendre symbol
-	// t = x^((q-1)/2) = r-1 squaring of xˢ
+	if k[0]&1 == 1 {
+		// k is odd, x is not a square
+		return nil
+	}
+
+	// y = y * g^(-k/2)
+	isOne := true
+	for i := 0; i < sqrtNbWindows; i++ {
+		d := k[i] >> 1
+		if i+1 < sqrtNbWindows {
+			if i == 0 {
+				d |= (k[1] & 1) << (sqrtFirstWindow - 1)
+			} else {
+				d |= (k[i+1] & 1) << (sqrtWindow - 1)
+			}
+		}
+		if d != 0 {
+			y.Mul(&y, &tables.table(i, 0)[d])
+			isOne = false
+		}
+	}
+
+	// return the same root as the Tonelli-Shanks algorithm, x^((s+1)/2) * gᵗ with 0 ≤ t < 2ᵉ⁻¹:
+	// since g^(2ᵉ⁻¹) = -1, g^(-k/2) = -g^(2ᵉ⁻¹-k/2) when k ≠ 0
+	if !isOne {
+		y.Neg(&y)
+	}
+	return z.Set(&y)
+}
+
+const (
+	sqrtWindow      = 8
+	sqrtFirstWindow = 6
+	sqrtNbWindows   = 6
+)
+
+// sqrtTables holds the precomputed tables of Sqrt, with g = nonResidueˢ of order 2ᵉ
+type sqrtTables struct {
+	// a[l][j] = g^(-j * 2^(sqrtWindow*l))
+	// b[l][j] = g^(-j * 2^(sqrtFirstWindow+sqrtWindow*l))
+	a, b [sqrtNbWindows - 1][1 << sqrtWindow]Element
+
+	// dlog[hʲ] = j, with h = g^(2^(e-sqrtWindow)) of order 2^sqrtWindow
+	dlog map[Element]uint64
+}
+
+// table returns the table of the powers of g^(-2^(o+sqrtWindow*l)), where o is the offset of
+// the i-th window of the discrete logarithm
+func (t *sqrtTables) table(i, l int) *[1 << sqrtWindow]Element {
+	if i == 0 {
+		return &t.a[l]
+	}
+	return &t.b[i-1+l]
+}
+
+var (
+	_sqrtTablesElement     *sqrtTables
+	_sqrtTablesOnceElement sync.Once
+)
+
+// getSqrtTablesElement returns the tables used by Sqrt, computing them on first use
+func getSqrtTablesElement() *sqrtTables {
+	_sqrtTablesOnceElement.Do(func() {
+		t := new(sqrtTables)
+
+		// g = nonResidue ^ s
+		var g = Element{
+			7563926049028936178,
+			2688164645460651601,
+			12112688591437172399,
+			3177973240564633687,
+			14764383749841851163,
+			52487407124055189,
+		}
+
+		// fill sets table[j] = baseʲ and returns base^(2^sqrtWindow)
+		fill := func(table *[1 << sqrtWindow]Element, base Element) Element {
+			table[0].SetOne()
+			for j := 1; j < len(table); j++ {
+				table[j].Mul(&table[j-1], &base)
+			}
+			for j := 0; j < sqrtWindow; j++ {
+				base.Square(&base)
+			}
+			return base
+		}
+
+		var base Element
+		base.Inverse(&g)
+		for l := range t.a {
+			base = fill(&t.a[l], base)
+		}
+		base.Inverse(&g)
+		for j := 0; j < sqrtFirstWindow; j++ {
+			base.Square(&base)
+		}
+		for l := range t.b {
+			base = fill(&t.b[l], base)
+		}
+
+		// h = g^(2^(e-sqrtWindow))
+		h := g
+		for j := 0; j < 46-sqrtWindow; j++ {
+			h.Square(&h)
+		}
+		t.dlog = make(map[Element]uint64, 1<<sqrtWindow)
+		hj := One()
+		for j := uint64(0); j < 1<<sqrtWindow; j++ {
+			t.dlog[hj] = j
+			hj.Mul(&hj, &h)
+		}
+
+		_sqrtTablesElement = t
+	})
+	return _sqrtTablesElement
+}
+
+// BatchSqrt returns a new slice with the square roots of the elements of a, computed in parallel.
+// isSquare[i] is false if a[i] is not a square, in which case res[i] is 0.
+func BatchSqrt(a []Element) (res []Element, isSquare []bool) {
+	res = make([]Element, len(a))
+	isSquare = make([]bool, len(a))
+	utils.Parallelize(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			isSquare[i] = res[i].Sqrt(&a[i]) != nil
+		}
+	})
+	return
+}
+
+var _bCbrtExponentElement *big.Int
+
+func init() {
+	_bCbrtExponentElement, _ = new(big.Int).SetString("2fcd9602a41e52f994c7c00c11ebb13bcafbc5aac5e5ba91a943cc6a010800028f7c2405555555641d6aaaaaaaaaaa", 16)
+}
+
+// Cbrt z = ∛x (mod q)
+// if the cube root doesn't exist (x is not a cube mod q)
+// Cbrt leaves z unchanged and returns nil
+func (z *Element) Cbrt(x *Element) *Element {
+	// q ≡ 1 (mod 3), q-1 = 3ᵉ * s with s prime to 3
+	// using the cube root variant of Tonelli-Shanks: y is a cube root of x * b where b is
+	// a 3ᵉ-th root of unity, which is corrected by powers of g.
+	var y, b, w, t, t2 Element
+	// w = x^⌊s/3⌋
+	w.Exp(*x, _bCbrtExponentElement)
+
+	// s ≡ 2 (mod 3)
+	// y = x^((s+1)/3) = w * x
+	y.Mul(&w, x)
+
+	// b = xˢ = w³ * x² = y² * w
+	b.Square(&y).Mul(&b, &w)
+
+	// g = nonCubicResidue ^ s
+	var g = Element{
+		3203870859294639911,
+		276961138506029237,
+		9479726329337356593,
+		13645541738420943632,
+		7584832609311778094,
+		101110569012358506,
+	}
+	// ω = g^(3ᵉ⁻¹), a primitive cube root of unity
+	var omega = Element{
+		3203870859294639911,
+		276961138506029237,
+		9479726329337356593,
+		13645541738420943632,
+		7584832609311778094,
+		101110569012358506,
+	}
+	r := uint64(1)
+
+	// x is a cube iff b^(3ᵉ⁻¹) = 1
 	t = b
 	for i := uint64(0); i < r-1; i++ {
-		t.Square(&t)
+		t2.Square(&t)
+		t.Mul(&t, &t2)
 	}
 	if t.IsZero() {
 		return z.SetZero()
 	}
 	if !t.IsOne() {
-		// t != 1, we don't have a square root
 		return nil
 	}
 	for {
+		// b is of order 3ᵐ, and β = b^(3ᵐ⁻¹) ∈ {ω, ω²} if m > 0
 		var m uint64
+		var beta Element
 		t = b
-
-		// for t != 1
 		for !t.IsOne() {
-			t.Square(&t)
+			beta = t
+			t2.Square(&t)
+			t.Mul(&t, &t2)
 			m++
 		}
 
 		if m == 0 {
 			return z.Set(&y)
 		}
-		// t = g^(2^(r-m-1)) (mod q)
-		ge := int(r - m - 1)
+		// t = g^(3^(r-m-1)), with t^(3ᵐ) = ω
 		t = g
-		for ge > 0 {
+		for i := r - m - 1; i > 0; i-- {
+			t2.Square(&t)
+			t.Mul(&t, &t2)
+		}
+		// choose d ∈ {t, t²} such that d^(3ᵐ) = β², then b * d³ is of order < 3ᵐ
+		if beta.Equal(&omega) {
 			t.Square(&t)
-			ge--
 		}
-
-		g.Square(&t)
 		y.Mul(&y, &t)
-		b.Mul(&b, &g)
+		t2.Square(&t)
+		t.Mul(&t, &t2)
+		b.Mul(&b, &t)
+
+		// g = g^(3^(r-m)) is of order 3ᵐ
+		for i := r - m; i > 0; i-- {
+			t2.Square(&g)
+			g.Mul(&g, &t2)
+		}
 		r = m
 	}
 }
@@ -228,3 +228,148 @@ TEXT ·Butterfly(SB), NOSPLIT, $0-16
 	MOVQ SI, 16(AX)
 	MOVQ DI, 24(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l2           // n == 0, nothing to do
+
+l1:
+	MOVQ a+8(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	MOVQ b+16(FP), AX
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	MOVQ res+0(FP), AX
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	ADDQ $0x0000000000000020, res+0(FP)
+	ADDQ $0x0000000000000020, a+8(FP)
+	ADDQ $0x0000000000000020, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l4           // n == 0, nothing to do
+
+l3:
+	XORQ    DI, DI
+	MOVQ    a+8(FP), AX
+	MOVQ    0(AX), DX
+	MOVQ    8(AX), CX
+	MOVQ    16(AX), BX
+	MOVQ    24(AX), SI
+	MOVQ    b+16(FP), AX
+	SUBQ    0(AX), DX
+	SBBQ    8(AX), CX
+	SBBQ    16(AX), BX
+	SBBQ    24(AX), SI
+	MOVQ    $0x0a11800000000001, R8
+	MOVQ    $0x59aa76fed0000001, R9
+	MOVQ    $0x60b44d1e5c37b001, R10
+	MOVQ    $0x12ab655e9a2ca556, R11
+	CMOVQCC DI, R8
+	CMOVQCC DI, R9
+	CMOVQCC DI, R10
+	CMOVQCC DI, R11
+	ADDQ    R8, DX
+	ADCQ    R9, CX
+	ADCQ    R10, BX
+	ADCQ    R11, SI
+	MOVQ    res+0(FP), AX
+	MOVQ    DX, 0(AX)
+	MOVQ    CX, 8(AX)
+	MOVQ    BX, 16(AX)
+	MOVQ    SI, 24(AX)
+	ADDQ    $0x0000000000000020, res+0(FP)
+	ADDQ    $0x0000000000000020, a+8(FP)
+	ADDQ    $0x0000000000000020, b+16(FP)
+	DECQ    n+24(FP)
+	JNE     l3
+
+l4:
+	RET
+
+// sumVecAVX512(t *[32]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX512(SB), NOSPLIT, $0-24
+	MOVQ   a+8(FP), AX
+	MOVQ   n+16(FP), CX
+	SHRQ   $2, CX       // number of groups of 4 elements
+	VPXORQ Z0, Z0, Z0
+	VPXORQ Z1, Z1, Z1
+	VPXORQ Z2, Z2, Z2
+	VPXORQ Z3, Z3, Z3
+	TESTQ  CX, CX
+	JEQ    l6
+
+l5:
+	VPMOVZXDQ 0(AX), Z4
+	VPADDQ    Z4, Z0, Z0
+	VPMOVZXDQ 32(AX), Z5
+	VPADDQ    Z5, Z1, Z1
+	VPMOVZXDQ 64(AX), Z4
+	VPADDQ    Z4, Z2, Z2
+	VPMOVZXDQ 96(AX), Z5
+	VPADDQ    Z5, Z3, Z3
+	ADDQ      $0x0000000000000080, AX
+	DECQ      CX
+	JNE       l5
+
+l6:
+	MOVQ      t+0(FP), AX
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(t *[32]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX2(SB), NOSPLIT, $0-24
+	MOVQ  a+8(FP), AX
+	MOVQ  n+16(FP), CX
+	SHRQ  $1, CX       // number of groups of 2 elements
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+	VPXOR Y2, Y2, Y2
+	VPXOR Y3, Y3, Y3
+	TESTQ CX, CX
+	JEQ   l8
+
+l7:
+	VPMOVZXDQ 0(AX), Y4
+	VPADDQ    Y4, Y0, Y0
+	VPMOVZXDQ 16(AX), Y5
+	VPADDQ    Y5, Y1, Y1
+	VPMOVZXDQ 32(AX), Y4
+	VPADDQ    Y4, Y2, Y2
+	VPMOVZXDQ 48(AX), Y5
+	VPADDQ    Y5, Y3, Y3
+	ADDQ      $0x0000000000000040, AX
+	DECQ      CX
+	JNE       l7
+
+l8:
+	MOVQ    t+0(FP), AX
+	VMOVDQU Y0, 0(AX)
+	VMOVDQU Y1, 32(AX)
+	VMOVDQU Y2, 64(AX)
+	VMOVDQU Y3, 96(AX)
+	VZEROUPPER
+	RET
@@ -71,7 +71,6 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(22)
 
 	if len(shift) != 0 {
@@ -102,7 +101,6 @@ func Generator(m uint64) (fr.Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity fr.Element
-
 	rootOfUnity.SetString("8065159656716812877374967518403273466521432693661810619979959746626482506078")
 	const maxOrderRoot uint64 = 47
 
@@ -17,6 +17,9 @@
 package pedersen
 
 import (
+	"bytes"
+	"math/big"
+
 	"github.com/consensys/gnark-crypto/ecc/bls12-377"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/stretchr/testify/assert"
@@ -88,3 +91,124 @@ func TestCommitSingle(t *testing.T) {
 func TestCommitFiveElements(t *testing.T) {
 	testCommit(t, randomFrSlice(t, 5)...)
 }
+
+func randomBasis(t *testing.T, size int) []bls12377.G1Affine {
+	basis := make([]bls12377.G1Affine, size)
+	for i := range basis {
+		var err error
+		basis[i], err = randomOnG1()
+		assert.NoError(t, err)
+	}
+	return basis
+}
+
+func TestSetupDeterministic(t *testing.T) {
+	basis := randomBasis(t, 3)
+
+	key1, err := Setup(basis, WithRandomness(rand.New(rand.NewSource(42))))
+	assert.NoError(t, err)
+	key2, err := Setup(basis, WithRandomness(rand.New(rand.NewSource(42))))
+	assert.NoError(t, err)
+	assert.Equal(t, key1, key2)
+
+	// the same key is obtained from an externally provided σ and G2 point
+	sigma := big.NewInt(7)
+	key3, err := Setup(basis, WithSigma(sigma), WithG2Point(key1.g))
+	assert.NoError(t, err)
+	key4, err := Setup(basis, WithSigma(sigma), WithG2Point(key1.g))
+	assert.NoError(t, err)
+	assert.Equal(t, key3, key4)
+
+	// which is σ⋅basis[i] and -σ⁻¹⋅g
+	assert.Equal(t, basis, key3.basis)
+	assert.Equal(t, key1.g, key3.g)
+	for i := range basis {
+		var expected bls12377.G1Affine
+		expected.ScalarMultiplication(&basis[i], sigma)
+		assert.True(t, expected.Equal(&key3.basisExpSigma[i]))
+	}
+	var sigmaInvNeg fr.Element
+	sigmaInvNeg.SetBigInt(sigma).Inverse(&sigmaInvNeg).Neg(&sigmaInvNeg)
+	var expected bls12377.G2Affine
+	expected.ScalarMultiplication(&key1.g, sigmaInvNeg.BigInt(new(big.Int)))
+	assert.True(t, expected.Equal(&key3.gRootSigmaNeg))
+
+	values := interfaceSliceToFrSlice(t, randomFrSlice(t, 3)...)
+	commitment, pok, err := key3.Commit(values)
+	assert.NoError(t, err)
+	assert.NoError(t, key3.VerifyKnowledgeProof(commitment, pok))
+
+	_, err = Setup(basis, WithSigma(fr.Modulus()))
+	assert.Error(t, err)
+	_, err = Setup(basis, WithSigma(nil))
+	assert.Error(t, err)
+}
+
+func TestSerialization(t *testing.T) {
+	key, err := Setup(randomBasis(t, 4))
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = key.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	var decoded Key
+	_, err = decoded.ReadFrom(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	// the prover and the verifier can be given their part only
+	var pk ProvingKey
+	var vk VerifyingKey
+	buf.Reset()
+	_, err = key.ProvingKey.WriteTo(&buf)
+	assert.NoError(t, err)
+	_, err = key.VerifyingKey.WriteTo(&buf)
+	assert.NoError(t, err)
+	_, err = pk.ReadFrom(&buf)
+	assert.NoError(t, err)
+	_, err = vk.ReadFrom(&buf)
+	assert.NoError(t, err)
+
+	commitment, pok, err := pk.Commit(interfaceSliceToFrSlice(t, randomFrSlice(t, 4)...))
+	assert.NoError(t, err)
+	assert.NoError(t, vk.VerifyKnowledgeProof(commitment, pok))
+}
+
+func TestBatchVerifyKnowledgeProofs(t *testing.T) {
+	const nbCommitments = 5
+	key, err := Setup(randomBasis(t, 3))
+	assert.NoError(t, err)
+
+	commitments := make([]bls12377.G1Affine, nbCommitments)
+	poks := make([]bls12377.G1Affine, nbCommitments)
+	for i := range commitments {
+		commitments[i], poks[i], err = key.Commit(interfaceSliceToFrSlice(t, randomFrSlice(t, 3)...))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, key.BatchVerifyKnowledgeProofs(commitments, poks))
+
+	// swapping two proofs preserves their sum but not the random combination
+	poks[0], poks[1] = poks[1], poks[0]
+	assert.Error(t, key.BatchVerifyKnowledgeProofs(commitments, poks))
+
+	assert.Error(t, key.BatchVerifyKnowledgeProofs(commitments, poks[1:]))
+}
+
+func TestBatchVerifyMultiVk(t *testing.T) {
+	const nbKeys = 4
+	vks := make([]VerifyingKey, nbKeys)
+	commitments := make([]bls12377.G1Affine, nbKeys)
+	poks := make([]bls12377.G1Affine, nbKeys)
+	for i := range vks {
+		key, err := Setup(randomBasis(t, i+1))
+		assert.NoError(t, err)
+		vks[i] = key.VerifyingKey
+		commitments[i], poks[i], err = key.Commit(interfaceSliceToFrSlice(t, randomFrSlice(t, i+1)...))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, BatchVerifyMultiVk(vks, commitments, poks))
+
+	poks[2].Neg(&poks[2])
+	assert.Error(t, BatchVerifyMultiVk(vks, commitments, poks))
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// grainStateSize is the size in bits of the Grain LFSR state
+const grainStateSize = 80
+
+// Grain is the self-shrinking Grain LFSR used to derive the round constants
+// and matrices of Poseidon, as described in appendix F of the Poseidon paper.
+type Grain struct {
+	state [grainStateSize]uint8
+	pos   int
+}
+
+// NewGrain returns a Grain LFSR initialized for a permutation of the given
+// width and number of full and partial rounds, using the s-box x ↦ xᵅ over
+// a prime field.
+func NewGrain(width, nbFullRounds, nbPartialRounds int) *Grain {
+	g := new(Grain)
+
+	// the 80 bits of the initial state encode the parameters
+	g.init(1, 2)                // field: 𝔽ₚ
+	g.init(0, 4)                // s-box: x ↦ xᵅ
+	g.init(fr.Bits, 12)         // field size
+	g.init(width, 12)           // state size
+	g.init(nbFullRounds, 10)    // number of full rounds
+	g.init(nbPartialRounds, 10) // number of partial rounds
+	g.init(1<<30-1, 30)         // padding
+	g.pos = 0
+
+	// discard the first 160 bits
+	for i := 0; i < 160; i++ {
+		g.step()
+	}
+	return g
+}
+
+// init writes the nbBits least significant bits of v in the state, most
+// significant bit first.
+func (g *Grain) init(v, nbBits int) {
+	for i := nbBits - 1; i >= 0; i-- {
+		g.state[g.pos] = uint8(v>>i) & 1
+		g.pos++
+	}
+}
+
+// step updates the LFSR and returns the new bit
+// bᵢ₊₈₀ = bᵢ₊₆₂ ⊕ bᵢ₊₅₁ ⊕ bᵢ₊₃₈ ⊕ bᵢ₊₂₃ ⊕ bᵢ₊₁₃ ⊕ bᵢ
+func (g *Grain) step() uint8 {
+	b := g.state[(g.pos+62)%grainStateSize] ^
+		g.state[(g.pos+51)%grainStateSize] ^
+		g.state[(g.pos+38)%grainStateSize] ^
+		g.state[(g.pos+23)%grainStateSize] ^
+		g.state[(g.pos+13)%grainStateSize] ^
+		g.state[g.pos]
+	g.state[g.pos] = b
+	g.pos = (g.pos + 1) % grainStateSize
+	return b
+}
+
+// Bit returns the next output bit. Bits are drawn in pairs and the second
+// one is output only if the first one is set.
+func (g *Grain) Bit() uint8 {
+	for {
+		b := g.step()
+		if out := g.step(); b == 1 {
+			return out
+		}
+	}
+}
+
+// BigInt returns the integer whose fr.Bits bits are the next output bits,
+// most significant bit first.
+func (g *Grain) BigInt() *big.Int {
+	res := new(big.Int)
+	for i := 0; i < fr.Bits; i++ {
+		res.Lsh(res, 1)
+		if g.Bit() == 1 {
+			res.SetBit(res, 0, 1)
+		}
+	}
+	return res
+}
+
+// Element returns the next field element, sampled by rejection of the
+// integers larger than the modulus.
+func (g *Grain) Element() fr.Element {
+	modulus := fr.Modulus()
+	for {
+		if v := g.BigInt(); v.Cmp(modulus) < 0 {
+			var res fr.Element
+			res.SetBigInt(v)
+			return res
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package poseidon2 provides the Poseidon2 permutation and hash function over the scalar field of bls12-377.
+//
+// Poseidon2 replaces the MDS matrix of Poseidon with cheaper external and
+// internal linear layers and applies a linear layer before the first round.
+// Round constants are derived from the Grain LFSR of the poseidon package, and
+// the hash function is the sponge of the poseidon package built on top of the
+// Poseidon2 permutation.
+//
+// See https://eprint.iacr.org/2023/323.pdf
+package poseidon2
@@ -18,6 +18,7 @@ package fr
 
 import (
 	"github.com/stretchr/testify/require"
+	"math/big"
 	"reflect"
 	"sort"
 	"testing"
@@ -70,3 +71,201 @@ func TestVectorEmptyRoundTrip(t *testing.T) {
 
 	assert.True(reflect.DeepEqual(v1, v2))
 }
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	// lengths around the group sizes of the assembly kernels, and across the chunks
+	// of the inner product
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 15, 17, 257, 1025} {
+		a, b := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+
+		var s Element
+		s.SetRandom()
+
+		sum, diff, prod, scaled := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		sum.Add(a, b)
+		diff.Sub(a, b)
+		prod.Mul(a, b)
+		scaled.ScalarMul(a, &s)
+
+		var expectedSum, expectedInnerProduct Element
+		for i := 0; i < n; i++ {
+			var tmp Element
+			assert.True(sum[i].Equal(tmp.Add(&a[i], &b[i])), "Add mismatch at index %d (n = %d)", i, n)
+			assert.True(diff[i].Equal(tmp.Sub(&a[i], &b[i])), "Sub mismatch at index %d (n = %d)", i, n)
+			assert.True(prod[i].Equal(tmp.Mul(&a[i], &b[i])), "Mul mismatch at index %d (n = %d)", i, n)
+			assert.True(scaled[i].Equal(tmp.Mul(&a[i], &s)), "ScalarMul mismatch at index %d (n = %d)", i, n)
+			expectedSum.Add(&expectedSum, &a[i])
+			expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+
+		s = a.Sum()
+		assert.True(s.Equal(&expectedSum), "Sum mismatch (n = %d)", n)
+		s = a.InnerProduct(b)
+		assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch (n = %d)", n)
+
+		// in place
+		sum.Sub(sum, b)
+		assert.True(reflect.DeepEqual(sum, a), "in place Sub mismatch (n = %d)", n)
+	}
+
+	// q - 1 everywhere, whose Sum and InnerProduct are -n and n
+	const n = 1025
+	a, b := make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetInt64(-1)
+	}
+	var s, expected Element
+	expected.SetInt64(-n)
+	s = a.Sum()
+	assert.True(s.Equal(&expected), "Sum mismatch on q - 1")
+	expected.SetInt64(n)
+	s = a.InnerProduct(a)
+	assert.True(s.Equal(&expected), "InnerProduct mismatch on q - 1")
+	b.Add(a, a)
+	b.Sub(b, a)
+	assert.True(reflect.DeepEqual(a, b), "Add / Sub mismatch on q - 1")
+
+	assert.Panics(func() { b.Add(a, b[1:]) })
+	assert.Panics(func() { b.Sub(a, b[1:]) })
+	assert.Panics(func() { b.Mul(a, b[1:]) })
+	short := b[1:]
+	assert.Panics(func() { short.ScalarMul(a, &s) })
+	assert.Panics(func() { a.InnerProduct(b[1:]) })
+}
+
+func TestVectorBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[n/2].SetZero()
+
+	expected := BatchInvert(a)
+	inv := make(Vector, n)
+	inv.BatchInvert(a)
+	assert.True(reflect.DeepEqual(Vector(expected), inv))
+
+	// in place
+	a.BatchInvert(a)
+	assert.True(reflect.DeepEqual(a, inv))
+
+	assert.Panics(func() { a.BatchInvert(inv[1:]) })
+}
+
+func TestVectorCanonicalLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 1000
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[1].SetOne()
+
+	expected := make([]uint64, Limbs*n)
+	for i := 0; i < n; i++ {
+		var b big.Int
+		a[i].BigInt(&b)
+		for j, w := range b.Bits() {
+			expected[Limbs*i+j] = uint64(w)
+		}
+	}
+	limbs := a.ToCanonicalLimbs()
+	assert.Equal(expected, limbs)
+
+	var v Vector
+	assert.NoError(v.FromCanonicalLimbs(limbs))
+	assert.True(reflect.DeepEqual(a, v))
+
+	// values ⩾ q are rejected
+	bad := make([]uint64, len(limbs))
+	copy(bad, limbs)
+	copy(bad[Limbs*(n/2):], qElement[:])
+	err := v.FromCanonicalLimbs(bad)
+	assert.Error(err)
+	assert.Contains(err.Error(), "element 500")
+	assert.True(reflect.DeepEqual(a, v), "the vector should be left unchanged on error")
+	assert.Error(v.FromCanonicalLimbs(limbs[1:]), "the number of limbs must be a multiple of Limbs")
+	assert.Panics(func() { VectorFromMontgomeryLimbs(limbs[1:]) })
+
+	// empty vectors
+	assert.NoError(v.FromCanonicalLimbs(nil))
+	assert.Equal(0, len(v))
+	assert.Equal(0, len(Vector{}.ToCanonicalLimbs()))
+}
+
+func TestVectorMontgomeryLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+
+	limbs := a.MontgomeryLimbs()
+	assert.Equal(Limbs*n, len(limbs))
+	for i := 0; i < n; i++ {
+		assert.Equal(a[i][:], limbs[Limbs*i:Limbs*(i+1)])
+	}
+
+	// the views alias the vector
+	v := VectorFromMontgomeryLimbs(limbs)
+	assert.True(reflect.DeepEqual(a, v))
+	v[3].SetOne()
+	assert.True(a[3].IsOne())
+
+	assert.Nil(Vector{}.MontgomeryLimbs())
+	assert.Nil(VectorFromMontgomeryLimbs(nil))
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 20
+	a, c, res := make(Vector, n), make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		c[i].SetRandom()
+	}
+
+	b.Run("Add", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("Sub", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Sub(a, c)
+		}
+	})
+	b.Run("ScalarMul", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.ScalarMul(a, &c[0])
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("Sum", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = a.Sum()
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+}
@@ -17,6 +17,8 @@
 package bls12377
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -285,6 +287,276 @@ func TestCrossMultiExpG1(t *testing.T) {
 
 }
 
+func TestMultiExpPrecomputedG1(t *testing.T) {
+	const nbSamples = 1 << 8
+
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+	sampleScalars[rand.Intn(nbSamples)].SetZero()
+	sampleScalars[7].SetOne().Neg(&sampleScalars[7])
+
+	var expected G1Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+	cRange := multiExpTableWindowsG1()
+	if testing.Short() {
+		// large windows are slow for a small number of points
+		cRange = cRange[:1]
+	}
+	for _, c := range cRange {
+		for _, stride := range []int{0, 3, 64} {
+			config := ecc.MultiExpConfig{TableStride: stride}
+			table, err := NewMultiExpTableG1(samplePoints[:], c, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got G1Jac
+			if _, err := got.MultiExpPrecomputed(table, sampleScalars[:], config); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(&expected) {
+				t.Fatalf("precomputed msm failed with c=%d, stride=%d", c, stride)
+			}
+		}
+	}
+
+	// serialization
+	table, err := NewMultiExpTableG1(samplePoints[:], 0, ecc.MultiExpConfig{TableStride: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	written, err := table.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unsafe := range []bool{false, true} {
+		var decoded MultiExpTableG1
+		r := bytes.NewReader(buf.Bytes())
+		var read int64
+		if unsafe {
+			read, err = decoded.UnsafeReadFrom(r)
+		} else {
+			read, err = decoded.ReadFrom(r)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if read != written {
+			t.Fatal("bytes read != bytes written")
+		}
+		var got G1Jac
+		if _, err := got.MultiExpPrecomputed(&decoded, sampleScalars[:], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatal("precomputed msm failed with a deserialized table")
+		}
+	}
+
+	// a truncated table claiming about 2³² points is rejected
+	nbBlocks := uint32(len(table.points) / table.nbBases)
+	truncated := append([]byte{}, buf.Bytes()[:12+10*SizeOfG1AffineUncompressed]...)
+	binary.BigEndian.PutUint32(truncated[8:12], ^uint32(0)/nbBlocks*nbBlocks)
+	var decoded MultiExpTableG1
+	if _, err := decoded.UnsafeReadFrom(bytes.NewReader(truncated)); err == nil {
+		t.Fatal("reading a truncated table should fail")
+	}
+
+	// errors
+	if _, err := NewMultiExpTableG1(samplePoints[:], 3, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("window size 3 should not be supported")
+	}
+	if _, err := expected.MultiExpPrecomputed(table, sampleScalars[1:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("the number of scalars should match the number of bases")
+	}
+}
+
+func TestMultiExpReaderG1(t *testing.T) {
+	const nbSamples = 1 << 8
+
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	// raw encoding of the points
+	var buf bytes.Buffer
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], nbSamples)
+	buf.Write(header[:])
+	for i := range samplePoints {
+		b := samplePoints[i].RawBytes()
+		buf.Write(b[:])
+	}
+
+	var sampleScalars [nbSamples - 10]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected G1Jac
+	expected.MultiExp(samplePoints[:len(sampleScalars)], sampleScalars[:], ecc.MultiExpConfig{})
+
+	for _, chunkSize := range []int{0, 1, 7, 100} {
+		var got G1Jac
+		config := ecc.MultiExpConfig{ChunkSize: chunkSize}
+		if _, err := got.MultiExpReader(bytes.NewReader(buf.Bytes()), sampleScalars[:], config); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("streaming msm failed with chunk size %d", chunkSize)
+		}
+	}
+
+	// truncated input
+	var got G1Jac
+	truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-11*SizeOfG1AffineUncompressed])
+	if _, err := got.MultiExpReader(truncated, sampleScalars[:], ecc.MultiExpConfig{ChunkSize: 64}); err == nil {
+		t.Fatal("reading a truncated input should fail")
+	}
+	binary.BigEndian.PutUint32(buf.Bytes()[:4], nbSamples-11)
+	if _, err := got.MultiExpReader(bytes.NewReader(buf.Bytes()), sampleScalars[:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("reading less points than scalars should fail")
+	}
+}
+
+func TestMultiExpBatchG1(t *testing.T) {
+	const nbSamples = 1 << 10
+
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	// random scalars, small scalars, repeated scalars (conflicts in the batch affine
+	// additions) and zeros
+	scalars := make([][]fr.Element, 4)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+	}
+	fillBenchScalars(scalars[0])
+	for i := range scalars[1] {
+		scalars[1][i].SetUint64(uint64(i))
+	}
+	for i := range scalars[2] {
+		scalars[2][i] = scalars[0][i-i%16]
+	}
+
+	for _, nbVectors := range []int{0, 1, 4} {
+		for _, nbTasks := range []int{0, 1, 5} {
+			res, err := MultiExpBatchG1(samplePoints[:], scalars[:nbVectors], ecc.MultiExpConfig{NbTasks: nbTasks})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != nbVectors {
+				t.Fatal("MultiExpBatch should return one result per vector")
+			}
+			for k := range res {
+				var expected G1Jac
+				expected.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+				if !res[k].Equal(&expected) {
+					t.Fatalf("MultiExpBatch failed for vector %d/%d with %d tasks", k, nbVectors, nbTasks)
+				}
+			}
+		}
+	}
+
+	// the batch affine additions are used for c > 9
+	cRange := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	if testing.Short() {
+		cRange = []uint64{5, 14}
+	}
+	for _, c := range cRange {
+		res := make([]G1Jac, len(scalars))
+		_innerMsmBatchG1(res, c, samplePoints[:], scalars, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		for k := range res {
+			var expected G1Jac
+			expected.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+			if !res[k].Equal(&expected) {
+				t.Fatalf("MultiExpBatch failed for vector %d with c=%d", k, c)
+			}
+		}
+	}
+
+	if _, err := MultiExpBatchG1(samplePoints[1:], scalars, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("MultiExpBatch should fail when len(scalars[k]) != len(points)")
+	}
+}
+
+func TestMultiExpGLVG1(t *testing.T) {
+	const nbSamples = 1 << 8
+
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+	sampleScalars[rand.Intn(nbSamples)].SetZero()
+	sampleScalars[rand.Intn(nbSamples)].SetOne()
+	var one fr.Element
+	one.SetOne()
+	sampleScalars[rand.Intn(nbSamples)].Neg(&one)
+
+	var expected G1Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+	cRange := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	if testing.Short() {
+		cRange = []uint64{5, 14}
+	}
+	for _, c := range cRange {
+		var got G1Jac
+		_innerMsmGLVG1(&got, c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		if !got.Equal(&expected) {
+			t.Fatalf("GLV msm failed with c=%d", c)
+		}
+	}
+
+	for _, nbTasks := range []int{0, 1, 5} {
+		var got G1Jac
+		if _, err := got.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: nbTasks, GLV: true}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("GLV msm failed with %d tasks", nbTasks)
+		}
+	}
+
+	// all scalars are zero
+	var zeros [nbSamples]fr.Element
+	var got G1Jac
+	got.MultiExp(samplePoints[:], zeros[:], ecc.MultiExpConfig{GLV: true})
+	if !got.Z.IsZero() {
+		t.Fatal("GLV msm with zero scalars should be infinity")
+	}
+}
+
 // _innerMsmG1Reference always do ext jacobian with c == 16
 func _innerMsmG1Reference(p *G1Jac, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
 	// partition the scalars
@@ -374,6 +646,77 @@ func BenchmarkMultiExpG1(b *testing.B) {
 				testPoint.MultiExp(samplePoints[:using], sampleScalarsRedundant[:using], ecc.MultiExpConfig{})
 			}
 		})
+
+		b.Run(fmt.Sprintf("%d points-glv", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{GLV: true})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpBatchG1(b *testing.B) {
+	const (
+		nbSamples = 1 << 16
+		nbVectors = 4
+	)
+
+	samplePoints := make([]G1Affine, nbSamples)
+	fillBenchBasesG1(samplePoints)
+	scalars := make([][]fr.Element, nbVectors)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars[k])
+	}
+
+	b.Run("batch", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			MultiExpBatchG1(samplePoints, scalars, ecc.MultiExpConfig{})
+		}
+	})
+
+	b.Run("sequential", func(b *testing.B) {
+		var testPoint G1Jac
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			for k := range scalars {
+				testPoint.MultiExp(samplePoints, scalars[k], ecc.MultiExpConfig{})
+			}
+		}
+	})
+}
+
+func BenchmarkMultiExpPrecomputedG1(b *testing.B) {
+	const nbSamples = 1 << 16
+
+	var (
+		samplePoints  [nbSamples]G1Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG1(samplePoints[:])
+
+	var testPoint G1Affine
+
+	b.Run("MultiExp", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			testPoint.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+		}
+	})
+
+	for _, stride := range []int{1, 4} {
+		table, err := NewMultiExpTableG1(samplePoints[:], 0, ecc.MultiExpConfig{TableStride: stride})
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("stride=%d", stride), func(b *testing.B) {
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpPrecomputed(table, sampleScalars[:], ecc.MultiExpConfig{})
+			}
+		})
 	}
 }
 
@@ -697,6 +1040,270 @@ func TestCrossMultiExpG2(t *testing.T) {
 
 }
 
+func TestMultiExpPrecomputedG2(t *testing.T) {
+	const nbSamples = 1 << 8
+
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+	sampleScalars[rand.Intn(nbSamples)].SetZero()
+	sampleScalars[7].SetOne().Neg(&sampleScalars[7])
+
+	var expected G2Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+	cRange := multiExpTableWindowsG2()
+	if testing.Short() {
+		// large windows are slow for a small number of points
+		cRange = cRange[:1]
+	}
+	for _, c := range cRange {
+		for _, stride := range []int{0, 3, 64} {
+			config := ecc.MultiExpConfig{TableStride: stride}
+			table, err := NewMultiExpTableG2(samplePoints[:], c, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got G2Jac
+			if _, err := got.MultiExpPrecomputed(table, sampleScalars[:], config); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(&expected) {
+				t.Fatalf("precomputed msm failed with c=%d, stride=%d", c, stride)
+			}
+		}
+	}
+
+	// serialization
+	table, err := NewMultiExpTableG2(samplePoints[:], 0, ecc.MultiExpConfig{TableStride: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	written, err := table.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unsafe := range []bool{false, true} {
+		var decoded MultiExpTableG2
+		r := bytes.NewReader(buf.Bytes())
+		var read int64
+		if unsafe {
+			read, err = decoded.UnsafeReadFrom(r)
+		} else {
+			read, err = decoded.ReadFrom(r)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if read != written {
+			t.Fatal("bytes read != bytes written")
+		}
+		var got G2Jac
+		if _, err := got.MultiExpPrecomputed(&decoded, sampleScalars[:], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatal("precomputed msm failed with a deserialized table")
+		}
+	}
+
+	// a truncated table claiming about 2³² points is rejected
+	nbBlocks := uint32(len(table.points) / table.nbBases)
+	truncated := append([]byte{}, buf.Bytes()[:12+10*SizeOfG2AffineUncompressed]...)
+	binary.BigEndian.PutUint32(truncated[8:12], ^uint32(0)/nbBlocks*nbBlocks)
+	var decoded MultiExpTableG2
+	if _, err := decoded.UnsafeReadFrom(bytes.NewReader(truncated)); err == nil {
+		t.Fatal("reading a truncated table should fail")
+	}
+
+	// errors
+	if _, err := NewMultiExpTableG2(samplePoints[:], 3, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("window size 3 should not be supported")
+	}
+	if _, err := expected.MultiExpPrecomputed(table, sampleScalars[1:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("the number of scalars should match the number of bases")
+	}
+}
+
+func TestMultiExpReaderG2(t *testing.T) {
+	const nbSamples = 1 << 8
+
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	// raw encoding of the points
+	var buf bytes.Buffer
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], nbSamples)
+	buf.Write(header[:])
+	for i := range samplePoints {
+		b := samplePoints[i].RawBytes()
+		buf.Write(b[:])
+	}
+
+	var sampleScalars [nbSamples - 10]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected G2Jac
+	expected.MultiExp(samplePoints[:len(sampleScalars)], sampleScalars[:], ecc.MultiExpConfig{})
+
+	for _, chunkSize := range []int{0, 1, 7, 100} {
+		var got G2Jac
+		config := ecc.MultiExpConfig{ChunkSize: chunkSize}
+		if _, err := got.MultiExpReader(bytes.NewReader(buf.Bytes()), sampleScalars[:], config); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("streaming msm failed with chunk size %d", chunkSize)
+		}
+	}
+
+	// truncated input
+	var got G2Jac
+	truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-11*SizeOfG2AffineUncompressed])
+	if _, err := got.MultiExpReader(truncated, sampleScalars[:], ecc.MultiExpConfig{ChunkSize: 64}); err == nil {
+		t.Fatal("reading a truncated input should fail")
+	}
+	binary.BigEndian.PutUint32(buf.Bytes()[:4], nbSamples-11)
+	if _, err := got.MultiExpReader(bytes.NewReader(buf.Bytes()), sampleScalars[:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("reading less points than scalars should fail")
+	}
+}
+
+func TestMultiExpBatchG2(t *testing.T) {
+	const nbSamples = 1 << 10
+
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	// random scalars, small scalars, repeated scalars (conflicts in the batch affine
+	// additions) and zeros
+	scalars := make([][]fr.Element, 4)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+	}
+	fillBenchScalars(scalars[0])
+	for i := range scalars[1] {
+		scalars[1][i].SetUint64(uint64(i))
+	}
+	for i := range scalars[2] {
+		scalars[2][i] = scalars[0][i-i%16]
+	}
+
+	for _, nbVectors := range []int{0, 1, 4} {
+		for _, nbTasks := range []int{0, 1, 5} {
+			res, err := MultiExpBatchG2(samplePoints[:], scalars[:nbVectors], ecc.MultiExpConfig{NbTasks: nbTasks})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != nbVectors {
+				t.Fatal("MultiExpBatch should return one result per vector")
+			}
+			for k := range res {
+				var expected G2Jac
+				expected.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+				if !res[k].Equal(&expected) {
+					t.Fatalf("MultiExpBatch failed for vector %d/%d with %d tasks", k, nbVectors, nbTasks)
+				}
+			}
+		}
+	}
+
+	// the batch affine additions are used for c > 9
+	cRange := []uint64{5, 14}
+	for _, c := range cRange {
+		res := make([]G2Jac, len(scalars))
+		_innerMsmBatchG2(res, c, samplePoints[:], scalars, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		for k := range res {
+			var expected G2Jac
+			expected.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+			if !res[k].Equal(&expected) {
+				t.Fatalf("MultiExpBatch failed for vector %d with c=%d", k, c)
+			}
+		}
+	}
+
+	if _, err := MultiExpBatchG2(samplePoints[1:], scalars, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("MultiExpBatch should fail when len(scalars[k]) != len(points)")
+	}
+}
+
+func TestMultiExpGLVG2(t *testing.T) {
+	const nbSamples = 1 << 8
+
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	samplePoints[rand.Intn(nbSamples)].setInfinity()
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+	sampleScalars[rand.Intn(nbSamples)].SetZero()
+	sampleScalars[rand.Intn(nbSamples)].SetOne()
+	var one fr.Element
+	one.SetOne()
+	sampleScalars[rand.Intn(nbSamples)].Neg(&one)
+
+	var expected G2Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+	cRange := []uint64{5, 14}
+	for _, c := range cRange {
+		var got G2Jac
+		_innerMsmGLVG2(&got, c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		if !got.Equal(&expected) {
+			t.Fatalf("GLV msm failed with c=%d", c)
+		}
+	}
+
+	for _, nbTasks := range []int{0, 1, 5} {
+		var got G2Jac
+		if _, err := got.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: nbTasks, GLV: true}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("GLV msm failed with %d tasks", nbTasks)
+		}
+	}
+
+	// all scalars are zero
+	var zeros [nbSamples]fr.Element
+	var got G2Jac
+	got.MultiExp(samplePoints[:], zeros[:], ecc.MultiExpConfig{GLV: true})
+	if !got.Z.IsZero() {
+		t.Fatal("GLV msm with zero scalars should be infinity")
+	}
+}
+
 // _innerMsmG2Reference always do ext jacobian with c == 16
 func _innerMsmG2Reference(p *G2Jac, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
 	// partition the scalars
@@ -786,6 +1393,77 @@ func BenchmarkMultiExpG2(b *testing.B) {
 				testPoint.MultiExp(samplePoints[:using], sampleScalarsRedundant[:using], ecc.MultiExpConfig{})
 			}
 		})
+
+		b.Run(fmt.Sprintf("%d points-glv", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{GLV: true})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpBatchG2(b *testing.B) {
+	const (
+		nbSamples = 1 << 16
+		nbVectors = 4
+	)
+
+	samplePoints := make([]G2Affine, nbSamples)
+	fillBenchBasesG2(samplePoints)
+	scalars := make([][]fr.Element, nbVectors)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars[k])
+	}
+
+	b.Run("batch", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			MultiExpBatchG2(samplePoints, scalars, ecc.MultiExpConfig{})
+		}
+	})
+
+	b.Run("sequential", func(b *testing.B) {
+		var testPoint G2Jac
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			for k := range scalars {
+				testPoint.MultiExp(samplePoints, scalars[k], ecc.MultiExpConfig{})
+			}
+		}
+	})
+}
+
+func BenchmarkMultiExpPrecomputedG2(b *testing.B) {
+	const nbSamples = 1 << 16
+
+	var (
+		samplePoints  [nbSamples]G2Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG2(samplePoints[:])
+
+	var testPoint G2Affine
+
+	b.Run("MultiExp", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			testPoint.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+		}
+	})
+
+	for _, stride := range []int{1, 4} {
+		table, err := NewMultiExpTableG2(samplePoints[:], 0, ecc.MultiExpConfig{TableStride: stride})
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("stride=%d", stride), func(b *testing.B) {
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpPrecomputed(table, sampleScalars[:], ecc.MultiExpConfig{})
+			}
+		})
 	}
 }
 
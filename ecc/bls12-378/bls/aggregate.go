@@ -0,0 +1,309 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+var (
+	errEmptyInput        = errors.New("empty input")
+	errLengthMismatch    = errors.New("public keys, messages and signatures must have the same length")
+	errMixedCiphersuites = errors.New("public keys must share the same ciphersuite")
+	errDuplicateMessage  = errors.New("messages must be distinct in the basic scheme")
+	errFastAggregate     = errors.New("fast aggregate verification is only defined for the ProofOfPossession scheme")
+)
+
+// nbBitsBatchVerify is the size of the random coefficients of the linear
+// combination in BatchVerify.
+const nbBitsBatchVerify = 128
+
+// AggregateSignatures aggregates signatures produced in the ciphersuite cs into
+// a single signature σ = ∑ σᵢ.
+//
+// draft-irtf-cfrg-bls-signature-05, section 2.8
+func AggregateSignatures(cs Ciphersuite, signatures ...[]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, errEmptyInput
+	}
+
+	if cs.Variant == MinSig {
+		var acc bls12378.G1Jac
+		for i := range signatures {
+			var sig bls12378.G1Affine
+			if _, err := sig.SetBytes(signatures[i]); err != nil {
+				return nil, err
+			}
+			acc.AddMixed(&sig)
+		}
+		var res bls12378.G1Affine
+		res.FromJacobian(&acc)
+		resBin := res.Bytes()
+		return resBin[:], nil
+	}
+
+	var acc bls12378.G2Jac
+	for i := range signatures {
+		var sig bls12378.G2Affine
+		if _, err := sig.SetBytes(signatures[i]); err != nil {
+			return nil, err
+		}
+		acc.AddMixed(&sig)
+	}
+	var res bls12378.G2Affine
+	res.FromJacobian(&acc)
+	resBin := res.Bytes()
+	return resBin[:], nil
+}
+
+// AggregatePublicKeys aggregates public keys into a single public key
+// PK = ∑ PKᵢ, which can be used to verify a signature aggregated from
+// signatures of the same message.
+//
+// In the basic and message augmentation schemes the aggregated public key
+// must not be used as a regular public key, since its owners did not prove
+// possession of the matching secret key.
+func AggregatePublicKeys(publicKeys []PublicKey) (*PublicKey, error) {
+	cs, err := commonCiphersuite(publicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PublicKey{Suite: cs}
+	if cs.Variant == MinSig {
+		var acc bls12378.G2Jac
+		for i := range publicKeys {
+			acc.AddMixed(&publicKeys[i].A2)
+		}
+		res.A2.FromJacobian(&acc)
+		return res, nil
+	}
+
+	var acc bls12378.G1Jac
+	for i := range publicKeys {
+		acc.AddMixed(&publicKeys[i].A1)
+	}
+	res.A1.FromJacobian(&acc)
+	return res, nil
+}
+
+// FastAggregateVerify validates an aggregated signature of the same message by
+// all the public keys. It is only available in the proof of possession scheme,
+// where the proofs of possession of the public keys must have been checked
+// beforehand with PopVerify.
+//
+// draft-irtf-cfrg-bls-signature-05, section 3.3.4
+func FastAggregateVerify(publicKeys []PublicKey, message, signature []byte) (bool, error) {
+	cs, err := commonCiphersuite(publicKeys)
+	if err != nil {
+		return false, err
+	}
+	if cs.Scheme != ProofOfPossession {
+		return false, errFastAggregate
+	}
+	for i := range publicKeys {
+		if !publicKeys[i].IsValid() {
+			return false, errInvalidPublicKey
+		}
+	}
+	aggregated, err := AggregatePublicKeys(publicKeys)
+	if err != nil {
+		return false, err
+	}
+	return aggregated.coreVerify(signature, message, cs.DST())
+}
+
+// AggregateVerify validates an aggregated signature of the messages, where
+// messages[i] was signed by publicKeys[i]. It verifies
+//
+// ∏ e(PKᵢ, hash_to_point(mᵢ)) ?= e(G, σ)
+//
+// with a single final exponentiation. In the basic scheme the messages must be
+// distinct, and in the message augmentation scheme each message is prefixed
+// with its public key.
+//
+// draft-irtf-cfrg-bls-signature-05, sections 2.9, 3.1.1 and 3.2.2
+func AggregateVerify(publicKeys []PublicKey, messages [][]byte, signature []byte) (bool, error) {
+	cs, err := commonCiphersuite(publicKeys)
+	if err != nil {
+		return false, err
+	}
+	if len(messages) != len(publicKeys) {
+		return false, errLengthMismatch
+	}
+	if cs.Scheme == Basic && !distinct(messages) {
+		return false, errDuplicateMessage
+	}
+	for i := range publicKeys {
+		if !publicKeys[i].IsValid() {
+			return false, errInvalidPublicKey
+		}
+	}
+
+	dst := cs.DST()
+	n := len(publicKeys)
+
+	if cs.Variant == MinSig {
+		P := make([]bls12378.G1Affine, n+1)
+		Q := make([]bls12378.G2Affine, n+1)
+		for i := 0; i < n; i++ {
+			if P[i], err = bls12378.HashToG1(publicKeys[i].augment(messages[i]), dst); err != nil {
+				return false, err
+			}
+			Q[i].Set(&publicKeys[i].A2)
+		}
+		if _, err = P[n].SetBytes(signature); err != nil {
+			return false, err
+		}
+		Q[n].Set(&g2GenNeg)
+		return bls12378.PairingCheck(P, Q)
+	}
+
+	P := make([]bls12378.G1Affine, n+1)
+	Q := make([]bls12378.G2Affine, n+1)
+	for i := 0; i < n; i++ {
+		if Q[i], err = bls12378.HashToG2(publicKeys[i].augment(messages[i]), dst); err != nil {
+			return false, err
+		}
+		P[i].Set(&publicKeys[i].A1)
+	}
+	if _, err = Q[n].SetBytes(signature); err != nil {
+		return false, err
+	}
+	P[n].Set(&g1GenNeg)
+	return bls12378.PairingCheck(P, Q)
+}
+
+// BatchVerify validates independent signatures, where signatures[i] is a
+// signature of messages[i] by publicKeys[i]. It samples random coefficients rᵢ
+// and verifies the random linear combination
+//
+// ∏ e(rᵢ ⋅ PKᵢ, hash_to_point(mᵢ)) ?= e(G, ∑ rᵢ ⋅ σᵢ)
+//
+// (with the roles of G1 and G2 swapped in the MinSig variant), so that
+// verifying the batch costs a single final exponentiation. If it fails, at
+// least one of the signatures is invalid.
+func BatchVerify(publicKeys []PublicKey, messages [][]byte, signatures [][]byte) (bool, error) {
+	cs, err := commonCiphersuite(publicKeys)
+	if err != nil {
+		return false, err
+	}
+	if len(messages) != len(publicKeys) || len(signatures) != len(publicKeys) {
+		return false, errLengthMismatch
+	}
+	for i := range publicKeys {
+		if !publicKeys[i].IsValid() {
+			return false, errInvalidPublicKey
+		}
+	}
+
+	n := len(publicKeys)
+	r, err := randomCoefficients(n)
+	if err != nil {
+		return false, err
+	}
+	coeffs := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		coeffs[i].SetBigInt(&r[i])
+	}
+
+	dst := cs.DST()
+	P := make([]bls12378.G1Affine, n+1)
+	Q := make([]bls12378.G2Affine, n+1)
+
+	if cs.Variant == MinSig {
+		sigs := make([]bls12378.G1Affine, n)
+		for i := 0; i < n; i++ {
+			if _, err = sigs[i].SetBytes(signatures[i]); err != nil {
+				return false, err
+			}
+			if P[i], err = bls12378.HashToG1(publicKeys[i].augment(messages[i]), dst); err != nil {
+				return false, err
+			}
+			P[i].ScalarMultiplication(&P[i], &r[i])
+			Q[i].Set(&publicKeys[i].A2)
+		}
+		if _, err = P[n].MultiExp(sigs, coeffs, ecc.MultiExpConfig{}); err != nil {
+			return false, err
+		}
+		Q[n].Set(&g2GenNeg)
+		return bls12378.PairingCheck(P, Q)
+	}
+
+	sigs := make([]bls12378.G2Affine, n)
+	for i := 0; i < n; i++ {
+		if _, err = sigs[i].SetBytes(signatures[i]); err != nil {
+			return false, err
+		}
+		if Q[i], err = bls12378.HashToG2(publicKeys[i].augment(messages[i]), dst); err != nil {
+			return false, err
+		}
+		P[i].ScalarMultiplication(&publicKeys[i].A1, &r[i])
+	}
+	if _, err = Q[n].MultiExp(sigs, coeffs, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+	P[n].Set(&g1GenNeg)
+	return bls12378.PairingCheck(P, Q)
+}
+
+// commonCiphersuite returns the ciphersuite shared by all the public keys.
+func commonCiphersuite(publicKeys []PublicKey) (Ciphersuite, error) {
+	if len(publicKeys) == 0 {
+		return Ciphersuite{}, errEmptyInput
+	}
+	cs := publicKeys[0].Suite
+	for i := 1; i < len(publicKeys); i++ {
+		if publicKeys[i].Suite != cs {
+			return Ciphersuite{}, errMixedCiphersuites
+		}
+	}
+	return cs, nil
+}
+
+// distinct reports whether all the messages are pairwise distinct.
+func distinct(messages [][]byte) bool {
+	seen := make(map[string]struct{}, len(messages))
+	for _, m := range messages {
+		if _, ok := seen[string(m)]; ok {
+			return false
+		}
+		seen[string(m)] = struct{}{}
+	}
+	return true
+}
+
+// randomCoefficients samples n non-zero coefficients of nbBitsBatchVerify bits.
+func randomCoefficients(n int) ([]big.Int, error) {
+	res := make([]big.Int, n)
+	buf := make([]byte, nbBitsBatchVerify/8)
+	for i := 0; i < n; i++ {
+		for res[i].Sign() == 0 {
+			if _, err := rand.Read(buf); err != nil {
+				return nil, err
+			}
+			res[i].SetBytes(buf)
+		}
+	}
+	return res, nil
+}
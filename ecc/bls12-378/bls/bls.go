@@ -0,0 +1,363 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/signature"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	sizeFr = fr.Bytes
+	sizeG1 = bls12378.SizeOfG1AffineCompressed
+	sizeG2 = bls12378.SizeOfG2AffineCompressed
+)
+
+var (
+	errInvalidPublicKey = errors.New("invalid public key")
+	errInvalidScheme    = errors.New("proof of possession is only defined for the ProofOfPossession scheme")
+	errShortIKM         = errors.New("input keying material must be at least 32 bytes")
+)
+
+// Variant selects the groups in which public keys and signatures live.
+type Variant uint8
+
+const (
+	// MinPk minimizes the size of public keys: public keys are in G1 and
+	// signatures in G2.
+	MinPk Variant = iota
+	// MinSig minimizes the size of signatures: signatures are in G1 and
+	// public keys in G2.
+	MinSig
+)
+
+// Scheme selects how rogue key attacks are prevented when signatures are aggregated.
+type Scheme uint8
+
+const (
+	// Basic requires all aggregated messages to be distinct (section 3.1).
+	Basic Scheme = iota
+	// MessageAugmentation prepends the public key to the message before signing (section 3.2).
+	MessageAugmentation
+	// ProofOfPossession requires each signer to publish a proof of possession of its secret key (section 3.3).
+	ProofOfPossession
+)
+
+// Ciphersuite fixes the variant and the scheme of a BLS signature.
+// The zero value is the basic scheme in the minimal-pubkey-size variant.
+type Ciphersuite struct {
+	Variant Variant
+	Scheme  Scheme
+}
+
+// h2cSuite returns the hash-to-curve suite ID of the signature group.
+func (cs Ciphersuite) h2cSuite() string {
+	if cs.Variant == MinSig {
+		return "BLS12378G1_XMD:SHA-256_SSWU_RO_"
+	}
+	return "BLS12378G2_XMD:SHA-256_SVDW_RO_"
+}
+
+// DST returns the domain separation tag used to hash messages to the
+// signature group, i.e. the ciphersuite ID (section 4.2).
+func (cs Ciphersuite) DST() []byte {
+	tag := "NUL_"
+	switch cs.Scheme {
+	case MessageAugmentation:
+		tag = "AUG_"
+	case ProofOfPossession:
+		tag = "POP_"
+	}
+	return []byte("BLS_SIG_" + cs.h2cSuite() + tag)
+}
+
+// PopDST returns the domain separation tag used to hash public keys when
+// computing proofs of possession (section 4.2.3).
+func (cs Ciphersuite) PopDST() []byte {
+	return []byte("BLS_POP_" + cs.h2cSuite() + "POP_")
+}
+
+// PublicKey represents a BLS public key
+type PublicKey struct {
+	Suite Ciphersuite
+	A1    bls12378.G1Affine // public key when Suite.Variant is MinPk
+	A2    bls12378.G2Affine // public key when Suite.Variant is MinSig
+}
+
+// PrivateKey represents a BLS private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+var (
+	g1Gen    bls12378.G1Affine
+	g2Gen    bls12378.G2Affine
+	g1GenNeg bls12378.G1Affine
+	g2GenNeg bls12378.G2Affine
+)
+
+func init() {
+	_, _, g1Gen, g2Gen = bls12378.Generators()
+	g1GenNeg.Neg(&g1Gen)
+	g2GenNeg.Neg(&g2Gen)
+}
+
+// keyGenL is the number of bytes expanded by KeyGen, ceil((3 * ceil(log2(r))) / 16).
+const keyGenL = (3*fr.Bits + 15) / 16
+
+// KeyGen derives a private key from the input keying material ikm, which
+// must be at least 32 bytes long, and the optional keyInfo.
+//
+// draft-irtf-cfrg-bls-signature-05, section 2.3
+func KeyGen(ikm, keyInfo []byte, cs Ciphersuite) (*PrivateKey, error) {
+	if len(ikm) < 32 {
+		return nil, errShortIKM
+	}
+
+	// IKM || I2OSP(0, 1)
+	secret := make([]byte, len(ikm)+1)
+	copy(secret, ikm)
+
+	// key_info || I2OSP(L, 2)
+	info := make([]byte, len(keyInfo)+2)
+	copy(info, keyInfo)
+	info[len(keyInfo)] = byte(keyGenL >> 8)
+	info[len(keyInfo)+1] = byte(keyGenL)
+
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	okm := make([]byte, keyGenL)
+	k := new(big.Int)
+	for k.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		prk := hkdf.Extract(sha256.New, secret, salt)
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, info), okm); err != nil {
+			return nil, err
+		}
+		k.SetBytes(okm)
+		k.Mod(k, fr.Modulus())
+	}
+
+	privateKey := new(PrivateKey)
+	k.FillBytes(privateKey.scalar[:sizeFr])
+	privateKey.PublicKey.Suite = cs
+	privateKey.PublicKey.fromScalar(k)
+	return privateKey, nil
+}
+
+// GenerateKey generates a public and private key pair for the ciphersuite cs,
+// using 32 bytes read from rand as input keying material.
+func GenerateKey(rand io.Reader, cs Ciphersuite) (*PrivateKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return nil, err
+	}
+	return KeyGen(ikm, nil, cs)
+}
+
+// fromScalar sets the public key to [k]G, where G is the generator of the
+// public key group.
+func (pub *PublicKey) fromScalar(k *big.Int) {
+	if pub.Suite.Variant == MinSig {
+		pub.A2.ScalarMultiplication(&g2Gen, k)
+		return
+	}
+	pub.A1.ScalarMultiplication(&g1Gen, k)
+}
+
+// IsValid reports whether the public key is a non-identity point of the
+// prime order subgroup (KeyValidate, section 2.5).
+func (pub *PublicKey) IsValid() bool {
+	if pub.Suite.Variant == MinSig {
+		return !pub.A2.IsInfinity() && pub.A2.IsInSubGroup()
+	}
+	return !pub.A1.IsInfinity() && pub.A1.IsInSubGroup()
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	if pub.Suite != xx.Suite {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.Suite = privKey.PublicKey.Suite
+	pub.A1.Set(&privKey.PublicKey.A1)
+	pub.A2.Set(&privKey.PublicKey.A2)
+	return &pub
+}
+
+// hashMessage returns hFunc(message) if hFunc is provided, and message
+// otherwise.
+func hashMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
+
+// augment prepends the serialized public key to the message when the
+// message augmentation scheme is used.
+func (pub *PublicKey) augment(message []byte) []byte {
+	if pub.Suite.Scheme != MessageAugmentation {
+		return message
+	}
+	pk := pub.Bytes()
+	res := make([]byte, len(pk)+len(message))
+	copy(res, pk)
+	copy(res[len(pk):], message)
+	return res
+}
+
+// Sign performs the BLS signature
+//
+// Q = hash_to_point(m)
+// signature = sk ⋅ Q
+//
+// If hFunc is provided, the message is first hashed with hFunc. In the message
+// augmentation scheme, the public key is prepended to the message.
+//
+// draft-irtf-cfrg-bls-signature-05, section 2.6
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	msg, err := hashMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	msg = privKey.PublicKey.augment(msg)
+	return privKey.coreSign(msg, privKey.PublicKey.Suite.DST())
+}
+
+func (privKey *PrivateKey) coreSign(msg, dst []byte) ([]byte, error) {
+	var s big.Int
+	s.SetBytes(privKey.scalar[:sizeFr])
+
+	if privKey.PublicKey.Suite.Variant == MinSig {
+		Q, err := bls12378.HashToG1(msg, dst)
+		if err != nil {
+			return nil, err
+		}
+		Q.ScalarMultiplication(&Q, &s)
+		sig := Q.Bytes()
+		return sig[:], nil
+	}
+
+	Q, err := bls12378.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	Q.ScalarMultiplication(&Q, &s)
+	sig := Q.Bytes()
+	return sig[:], nil
+}
+
+// Verify validates the BLS signature
+//
+// e(PK, hash_to_point(m)) ?= e(G, signature)
+//
+// where G is the generator of the public key group. If hFunc is provided, the
+// message is first hashed with hFunc.
+//
+// draft-irtf-cfrg-bls-signature-05, section 2.7
+func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	msg, err := hashMessage(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	msg = publicKey.augment(msg)
+	return publicKey.coreVerify(sigBin, msg, publicKey.Suite.DST())
+}
+
+func (publicKey *PublicKey) coreVerify(sigBin, msg, dst []byte) (bool, error) {
+	if !publicKey.IsValid() {
+		return false, errInvalidPublicKey
+	}
+
+	if publicKey.Suite.Variant == MinSig {
+		// SetBytes checks that the signature is in the prime order subgroup
+		var sig bls12378.G1Affine
+		if _, err := sig.SetBytes(sigBin); err != nil {
+			return false, err
+		}
+		Q, err := bls12378.HashToG1(msg, dst)
+		if err != nil {
+			return false, err
+		}
+		return bls12378.PairingCheck(
+			[]bls12378.G1Affine{Q, sig},
+			[]bls12378.G2Affine{publicKey.A2, g2GenNeg},
+		)
+	}
+
+	var sig bls12378.G2Affine
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	Q, err := bls12378.HashToG2(msg, dst)
+	if err != nil {
+		return false, err
+	}
+	return bls12378.PairingCheck(
+		[]bls12378.G1Affine{publicKey.A1, g1GenNeg},
+		[]bls12378.G2Affine{Q, sig},
+	)
+}
+
+// PopProve returns a proof of possession of the private key, i.e. a signature
+// of the serialized public key under the proof of possession tag.
+//
+// draft-irtf-cfrg-bls-signature-05, section 3.3.2
+func (privKey *PrivateKey) PopProve() ([]byte, error) {
+	if privKey.PublicKey.Suite.Scheme != ProofOfPossession {
+		return nil, errInvalidScheme
+	}
+	return privKey.coreSign(privKey.PublicKey.Bytes(), privKey.PublicKey.Suite.PopDST())
+}
+
+// PopVerify validates a proof of possession of the private key associated to
+// the public key.
+//
+// draft-irtf-cfrg-bls-signature-05, section 3.3.3
+func (publicKey *PublicKey) PopVerify(proof []byte) (bool, error) {
+	if publicKey.Suite.Scheme != ProofOfPossession {
+		return false, errInvalidScheme
+	}
+	return publicKey.coreVerify(proof, publicKey.Bytes(), publicKey.Suite.PopDST())
+}
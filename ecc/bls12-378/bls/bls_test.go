@@ -0,0 +1,216 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+var ciphersuites = []Ciphersuite{
+	{MinPk, Basic},
+	{MinPk, MessageAugmentation},
+	{MinPk, ProofOfPossession},
+	{MinSig, Basic},
+	{MinSig, MessageAugmentation},
+	{MinSig, ProofOfPossession},
+}
+
+func TestBLS(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+
+	for _, cs := range ciphersuites {
+		cs := cs
+
+		properties.Property("[BLS12-378] test the signing and verification", prop.ForAll(
+			func() bool {
+
+				privKey, _ := GenerateKey(rand.Reader, cs)
+				publicKey := privKey.PublicKey
+
+				msg := []byte("testing BLS")
+				hFunc := sha256.New()
+				sig, _ := privKey.Sign(msg, hFunc)
+				if len(sig) != cs.Variant.sizeSignature() {
+					return false
+				}
+				flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+				return flag
+			},
+		))
+
+		properties.Property("[BLS12-378] test the signing and verification (pre-hashed)", prop.ForAll(
+			func() bool {
+
+				privKey, _ := GenerateKey(rand.Reader, cs)
+				publicKey := privKey.PublicKey
+
+				msg := []byte("testing BLS")
+				sig, _ := privKey.Sign(msg, nil)
+				flag, _ := publicKey.Verify(sig, msg, nil)
+
+				return flag
+			},
+		))
+
+		properties.Property("[BLS12-378] verification should fail on a different message", prop.ForAll(
+			func() bool {
+
+				privKey, _ := GenerateKey(rand.Reader, cs)
+				publicKey := privKey.PublicKey
+
+				sig, _ := privKey.Sign([]byte("testing BLS"), nil)
+				flag, _ := publicKey.Verify(sig, []byte("testing BLS!"), nil)
+
+				return !flag
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestProofOfPossession(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []Variant{MinPk, MinSig} {
+		privKey, err := GenerateKey(rand.Reader, Ciphersuite{v, ProofOfPossession})
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := privKey.PopProve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := privKey.PublicKey.PopVerify(proof); err != nil || !ok {
+			t.Fatal("proof of possession should verify")
+		}
+
+		other, err := GenerateKey(rand.Reader, Ciphersuite{v, ProofOfPossession})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, _ := other.PublicKey.PopVerify(proof); ok {
+			t.Fatal("proof of possession should not verify for another key")
+		}
+
+		// a proof of possession is not a valid signature of the public key
+		if ok, _ := privKey.PublicKey.Verify(proof, privKey.PublicKey.Bytes(), nil); ok {
+			t.Fatal("proof of possession and signatures should be domain separated")
+		}
+
+		if _, err = (&PrivateKey{}).PopProve(); err == nil {
+			t.Fatal("proof of possession should only be available in the ProofOfPossession scheme")
+		}
+	}
+}
+
+func TestKeyGen(t *testing.T) {
+	t.Parallel()
+
+	ikm := make([]byte, 32)
+	if _, err := KeyGen(ikm[:31], nil, Ciphersuite{}); err == nil {
+		t.Fatal("KeyGen should reject short input keying material")
+	}
+
+	// KeyGen is deterministic
+	k1, err := KeyGen(ikm, []byte("info"), Ciphersuite{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2, err := KeyGen(ikm, []byte("info"), Ciphersuite{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k1.scalar != k2.scalar || !k1.PublicKey.Equal(&k2.PublicKey) {
+		t.Fatal("KeyGen should be deterministic")
+	}
+
+	// and depends on key_info
+	k3, err := KeyGen(ikm, []byte("other info"), Ciphersuite{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k1.scalar == k3.scalar {
+		t.Fatal("KeyGen should depend on key_info")
+	}
+}
+
+func TestInvalidPublicKey(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range ciphersuites {
+		privKey, _ := GenerateKey(rand.Reader, cs)
+		sig, _ := privKey.Sign([]byte("testing BLS"), nil)
+
+		// the identity point is rejected by KeyValidate
+		pk := PublicKey{Suite: cs}
+		if ok, err := pk.Verify(sig, []byte("testing BLS"), nil); ok || err == nil {
+			t.Fatal("verification should fail for the identity public key")
+		}
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkSignBLS(b *testing.B) {
+	for _, v := range []Variant{MinPk, MinSig} {
+		privKey, _ := GenerateKey(rand.Reader, Ciphersuite{Variant: v})
+		msg := []byte("benchmarking BLS sign()")
+
+		b.Run(variantName(v), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				privKey.Sign(msg, nil)
+			}
+		})
+	}
+}
+
+func BenchmarkVerifyBLS(b *testing.B) {
+	for _, v := range []Variant{MinPk, MinSig} {
+		privKey, _ := GenerateKey(rand.Reader, Ciphersuite{Variant: v})
+		msg := []byte("benchmarking BLS sign()")
+		sig, _ := privKey.Sign(msg, nil)
+
+		b.Run(variantName(v), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				privKey.PublicKey.Verify(sig, msg, nil)
+			}
+		})
+	}
+}
+
+func variantName(v Variant) string {
+	if v == MinSig {
+		return "MinSig"
+	}
+	return "MinPk"
+}
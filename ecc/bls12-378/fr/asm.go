@@ -22,6 +22,8 @@ package fr
 import "golang.org/x/sys/cpu"
 
 var (
-	supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
-	_          = supportAdx
+	supportAdx    = cpu.X86.HasADX && cpu.X86.HasBMI2
+	supportAvx512 = cpu.X86.HasAVX512F
+	supportAvx2   = cpu.X86.HasAVX2
+	_             = supportAdx
 )
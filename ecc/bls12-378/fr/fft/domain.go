@@ -71,7 +71,6 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(22)
 
 	if len(shift) != 0 {
@@ -102,7 +101,6 @@ func Generator(m uint64) (fr.Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity fr.Element
-
 	rootOfUnity.SetString("4045585818372166415418670827807793147093034396422209590578257013290761627990")
 	const maxOrderRoot uint64 = 42
 
@@ -0,0 +1,25 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package mpc implements a Powers of Tau multi-party computation
+// ceremony producing a KZG SRS.
+//
+// Each participant updates the SRS [τⁱ]G₁, [τ]G₂ of the previous one with a
+// secret s into [(sτ)ⁱ]G₁, [sτ]G₂ and publishes a proof of knowledge of s.
+// The SRS is secure as long as one of the participants erased its secret.
+//
+// See https://eprint.iacr.org/2017/1050.pdf (section 3).
+package mpc
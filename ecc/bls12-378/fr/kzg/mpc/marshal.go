@@ -0,0 +1,67 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mpc
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+)
+
+// WriteTo writes binary encoding of the Contribution
+func (c *Contribution) WriteTo(w io.Writer) (int64, error) {
+	n, err := c.SRS.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+
+	enc := bls12378.NewEncoder(w)
+	toEncode := []interface{}{
+		&c.Proof.Commitment,
+		&c.Proof.Pok,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return n + enc.BytesWritten(), err
+		}
+	}
+
+	return n + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes Contribution data from reader.
+func (c *Contribution) ReadFrom(r io.Reader) (int64, error) {
+	n, err := c.SRS.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	dec := bls12378.NewDecoder(r)
+	toDecode := []interface{}{
+		&c.Proof.Commitment,
+		&c.Proof.Pok,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return n + dec.BytesRead(), err
+		}
+	}
+
+	return n + dec.BytesRead(), nil
+}
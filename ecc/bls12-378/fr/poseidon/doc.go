@@ -0,0 +1,25 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package poseidon provides the Poseidon permutation and hash function over the scalar field of bls12-378.
+//
+// The permutation uses the s-box x ↦ x^5, round constants and MDS
+// matrices derived from the Grain LFSR as in the reference implementation,
+// and an arbitrary width and number of rounds. The hash function is a sponge
+// of rate width-1 and capacity 1 built on top of the permutation.
+//
+// See https://eprint.iacr.org/2019/458.pdf
+package poseidon
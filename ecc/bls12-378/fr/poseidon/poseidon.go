@@ -0,0 +1,211 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+const (
+	// Alpha is the degree of the s-box x ↦ xᵅ, the smallest integer such that
+	// gcd(α, r-1) = 1.
+	Alpha = 5
+
+	// DefaultNbFullRounds is the number of full rounds of the default parameters.
+	DefaultNbFullRounds = 8
+)
+
+// defaultNbPartialRounds[t-2] is the number of partial rounds of the default
+// parameters of width t. These are the values recommended in the reference
+// implementation for 128 bits of security with x⁵ over 254-bit fields, which
+// are conservative for larger fields or higher degrees.
+var defaultNbPartialRounds = [...]int{56, 57, 56, 60, 60, 63, 64, 63, 60, 66, 60, 65, 70, 60, 64, 68}
+
+var (
+	errInvalidWidth      = errors.New("width must be at least 2")
+	errInvalidFullRounds = errors.New("number of full rounds must be even and positive")
+	errStateSize         = errors.New("state size does not match the width of the permutation")
+)
+
+// Parameters describes an instance of the Poseidon permutation.
+type Parameters struct {
+	Width           int            // t, number of field elements in the state
+	NbFullRounds    int            // R_F, number of full rounds (even)
+	NbPartialRounds int            // R_P, number of partial rounds
+	RoundKeys       [][]fr.Element // (R_F+R_P) × t round constants
+	MDS             [][]fr.Element // t × t MDS matrix
+}
+
+// DefaultNbPartialRounds returns the number of partial rounds of the default
+// parameters for a state of the given width.
+func DefaultNbPartialRounds(width int) (int, error) {
+	if width < 2 || width-2 >= len(defaultNbPartialRounds) {
+		return 0, fmt.Errorf("no default number of partial rounds for width %d", width)
+	}
+	return defaultNbPartialRounds[width-2], nil
+}
+
+// NewDefaultParameters returns the parameters of the permutation with
+// DefaultNbFullRounds full rounds and DefaultNbPartialRounds(width) partial rounds.
+func NewDefaultParameters(width int) (*Parameters, error) {
+	nbPartialRounds, err := DefaultNbPartialRounds(width)
+	if err != nil {
+		return nil, err
+	}
+	return NewParameters(width, DefaultNbFullRounds, nbPartialRounds)
+}
+
+// NewParameters derives the round constants and the MDS matrix of a
+// permutation of the given width and number of rounds from the Grain LFSR.
+//
+// The round constants are the first (R_F+R_P)⋅t field elements output by the
+// LFSR. The MDS matrix is the Cauchy matrix Mᵢⱼ = 1/(xᵢ+yⱼ) where the 2t
+// distinct elements xᵢ, yⱼ are the next outputs of the LFSR.
+func NewParameters(width, nbFullRounds, nbPartialRounds int) (*Parameters, error) {
+	if width < 2 {
+		return nil, errInvalidWidth
+	}
+	if nbFullRounds <= 0 || nbFullRounds%2 != 0 {
+		return nil, errInvalidFullRounds
+	}
+
+	p := &Parameters{
+		Width:           width,
+		NbFullRounds:    nbFullRounds,
+		NbPartialRounds: nbPartialRounds,
+	}
+
+	grain := NewGrain(width, nbFullRounds, nbPartialRounds)
+
+	p.RoundKeys = make([][]fr.Element, nbFullRounds+nbPartialRounds)
+	for i := range p.RoundKeys {
+		p.RoundKeys[i] = make([]fr.Element, width)
+		for j := range p.RoundKeys[i] {
+			p.RoundKeys[i][j] = grain.Element()
+		}
+	}
+
+	p.MDS = cauchyMatrix(grain, width)
+
+	return p, nil
+}
+
+// cauchyMatrix samples a t × t Cauchy matrix Mᵢⱼ = 1/(xᵢ+yⱼ), resampling
+// until the xᵢ, yⱼ are pairwise distinct and xᵢ+yⱼ ≠ 0.
+func cauchyMatrix(grain *Grain, t int) [][]fr.Element {
+	res := make([][]fr.Element, t)
+	for i := range res {
+		res[i] = make([]fr.Element, t)
+	}
+	xy := make([]fr.Element, 2*t)
+
+	for {
+		for i := range xy {
+			xy[i].SetBigInt(grain.BigInt())
+		}
+		if !distinct(xy) {
+			continue
+		}
+		x, y := xy[:t], xy[t:]
+
+		ok := true
+		for i := 0; i < t && ok; i++ {
+			for j := 0; j < t; j++ {
+				res[i][j].Add(&x[i], &y[j])
+				if res[i][j].IsZero() {
+					ok = false
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		for i := range res {
+			res[i] = fr.BatchInvert(res[i])
+		}
+		return res
+	}
+}
+
+// distinct reports whether the elements of v are pairwise distinct.
+func distinct(v []fr.Element) bool {
+	seen := make(map[fr.Element]struct{}, len(v))
+	for i := range v {
+		if _, ok := seen[v[i]]; ok {
+			return false
+		}
+		seen[v[i]] = struct{}{}
+	}
+	return true
+}
+
+// sBox sets x to x^5
+func sBox(x *fr.Element) {
+	var tmp fr.Element
+	tmp.Square(x).
+		Square(&tmp)
+	x.Mul(x, &tmp)
+}
+
+// Permutation applies the Poseidon permutation to state, in place.
+//
+// Each round adds the round constants to the state, applies the s-box to
+// every element (full rounds) or to the first one (partial rounds), and
+// multiplies the state by the MDS matrix. The R_P partial rounds are
+// surrounded by R_F/2 full rounds.
+func (p *Parameters) Permutation(state []fr.Element) error {
+	if len(state) != p.Width {
+		return errStateSize
+	}
+
+	tmp := make([]fr.Element, p.Width)
+	halfFullRounds := p.NbFullRounds / 2
+	nbRounds := p.NbFullRounds + p.NbPartialRounds
+
+	for r := 0; r < nbRounds; r++ {
+		for i := range state {
+			state[i].Add(&state[i], &p.RoundKeys[r][i])
+		}
+		if r < halfFullRounds || r >= halfFullRounds+p.NbPartialRounds {
+			for i := range state {
+				sBox(&state[i])
+			}
+		} else {
+			sBox(&state[0])
+		}
+		p.mix(state, tmp)
+	}
+	return nil
+}
+
+// mix sets state to MDS ⋅ state, using tmp as a scratch buffer.
+func (p *Parameters) mix(state, tmp []fr.Element) {
+	var t fr.Element
+	for i := range tmp {
+		tmp[i].SetZero()
+		for j := range state {
+			t.Mul(&p.MDS[i][j], &state[j])
+			tmp[i].Add(&tmp[i], &t)
+		}
+	}
+	copy(state, tmp)
+}
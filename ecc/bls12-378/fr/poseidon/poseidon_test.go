@@ -0,0 +1,119 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+func TestParameters(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewParameters(1, 8, 57); err != errInvalidWidth {
+		t.Fatal("width 1 should be rejected")
+	}
+	if _, err := NewParameters(3, 7, 57); err != errInvalidFullRounds {
+		t.Fatal("odd number of full rounds should be rejected")
+	}
+	if _, err := NewDefaultParameters(1024); err == nil {
+		t.Fatal("there should be no default parameters for width 1024")
+	}
+
+	for width := 2; width <= 5; width++ {
+		p1, err := NewDefaultParameters(width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2, err := NewDefaultParameters(width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(p1.RoundKeys) != p1.NbFullRounds+p1.NbPartialRounds {
+			t.Fatal("wrong number of round keys")
+		}
+
+		// parameters are deterministic
+		for i := range p1.RoundKeys {
+			for j := range p1.RoundKeys[i] {
+				if !p1.RoundKeys[i][j].Equal(&p2.RoundKeys[i][j]) {
+					t.Fatal("round keys should be deterministic")
+				}
+			}
+		}
+
+		// the entries of a Cauchy matrix are non zero
+		for i := range p1.MDS {
+			if len(p1.MDS[i]) != width {
+				t.Fatal("wrong MDS matrix size")
+			}
+			for j := range p1.MDS[i] {
+				if p1.MDS[i][j].IsZero() {
+					t.Fatal("MDS matrix entries should be non zero")
+				}
+			}
+		}
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	t.Parallel()
+
+	params, err := NewDefaultParameters(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = params.Permutation(make([]fr.Element, 2)); err != errStateSize {
+		t.Fatal("state of the wrong size should be rejected")
+	}
+
+	var s1, s2 [3]fr.Element
+	s1[0].SetRandom()
+	s1[1].SetRandom()
+	s1[2].SetRandom()
+	s2 = s1
+	s2[2].SetOne().Add(&s2[2], &s1[2])
+
+	if err = params.Permutation(s1[:]); err != nil {
+		t.Fatal(err)
+	}
+	if err = params.Permutation(s2[:]); err != nil {
+		t.Fatal(err)
+	}
+	for i := range s1 {
+		if s1[i].Equal(&s2[i]) {
+			t.Fatal("a change in the input should change all the output elements")
+		}
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkPermutation(b *testing.B) {
+	params, err := NewDefaultParameters(3)
+	if err != nil {
+		b.Fatal(err)
+	}
+	state := make([]fr.Element, 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		params.Permutation(state)
+	}
+}
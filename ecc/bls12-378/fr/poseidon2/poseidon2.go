@@ -0,0 +1,276 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/poseidon"
+)
+
+const (
+	// DefaultNbFullRounds is the number of full rounds of the default parameters.
+	DefaultNbFullRounds = 8
+
+	// DefaultWidth is the width of the permutation used by NewPoseidon2
+	DefaultWidth = 3
+)
+
+var (
+	errInvalidWidth      = errors.New("width must be 2, 3 or a multiple of 4")
+	errInvalidFullRounds = errors.New("number of full rounds must be even and positive")
+	errStateSize         = errors.New("state size does not match the width of the permutation")
+)
+
+// Parameters describes an instance of the Poseidon2 permutation.
+type Parameters struct {
+	Width           int            // t, number of field elements in the state
+	NbFullRounds    int            // R_F, number of full rounds (even)
+	NbPartialRounds int            // R_P, number of partial rounds
+	RoundKeys       [][]fr.Element // t round constants per full round and 1 per partial round
+	InternalDiag    []fr.Element   // d such that the internal matrix is M_I = 𝟙 + diag(d), with 𝟙 the all-ones matrix
+}
+
+// DefaultNbPartialRounds returns the number of partial rounds of the default
+// parameters for a state of the given width, as recommended by the Poseidon2
+// paper for 128 bits of security over 254-bit fields.
+func DefaultNbPartialRounds(width int) (int, error) {
+	if !validWidth(width) || width > 24 {
+		return 0, fmt.Errorf("no default number of partial rounds for width %d", width)
+	}
+	if width <= 4 {
+		return 56, nil
+	}
+	return 57, nil
+}
+
+// NewDefaultParameters returns the parameters of the permutation with
+// DefaultNbFullRounds full rounds and DefaultNbPartialRounds(width) partial rounds.
+func NewDefaultParameters(width int) (*Parameters, error) {
+	nbPartialRounds, err := DefaultNbPartialRounds(width)
+	if err != nil {
+		return nil, err
+	}
+	return NewParameters(width, DefaultNbFullRounds, nbPartialRounds)
+}
+
+// NewParameters derives the round constants and the internal matrix of a
+// permutation of the given width and number of rounds from the Grain LFSR.
+//
+// The round constants are the first R_F⋅t+R_P field elements output by the
+// LFSR, in the order in which they are used. For t = 2 and t = 3 the internal matrices are the fixed matrices of the
+// paper. For larger widths, the diagonal is made of the next outputs of the
+// LFSR, resampled until M_I is invertible; the additional conditions of the
+// paper on the minimal polynomials of M_I are not checked, and vetted constants
+// can be set in Parameters directly instead.
+func NewParameters(width, nbFullRounds, nbPartialRounds int) (*Parameters, error) {
+	if !validWidth(width) {
+		return nil, errInvalidWidth
+	}
+	if nbFullRounds <= 0 || nbFullRounds%2 != 0 {
+		return nil, errInvalidFullRounds
+	}
+
+	p := &Parameters{
+		Width:           width,
+		NbFullRounds:    nbFullRounds,
+		NbPartialRounds: nbPartialRounds,
+	}
+
+	grain := poseidon.NewGrain(width, nbFullRounds, nbPartialRounds)
+
+	halfFullRounds := nbFullRounds / 2
+	p.RoundKeys = make([][]fr.Element, nbFullRounds+nbPartialRounds)
+	for i := range p.RoundKeys {
+		if i >= halfFullRounds && i < halfFullRounds+nbPartialRounds {
+			// partial rounds have a single round constant
+			p.RoundKeys[i] = make([]fr.Element, 1)
+		} else {
+			p.RoundKeys[i] = make([]fr.Element, width)
+		}
+		for j := range p.RoundKeys[i] {
+			p.RoundKeys[i][j] = grain.Element()
+		}
+	}
+
+	p.InternalDiag = make([]fr.Element, width)
+	switch width {
+	case 2:
+		// M_I = [[2, 1], [1, 3]]
+		p.InternalDiag[0].SetOne()
+		p.InternalDiag[1].SetUint64(2)
+	case 3:
+		// M_I = [[2, 1, 1], [1, 2, 1], [1, 1, 3]]
+		p.InternalDiag[0].SetOne()
+		p.InternalDiag[1].SetOne()
+		p.InternalDiag[2].SetUint64(2)
+	default:
+		for {
+			for i := range p.InternalDiag {
+				p.InternalDiag[i] = grain.Element()
+			}
+			if invertible(p.InternalDiag) {
+				break
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// validWidth reports whether the external matrix is defined for the width.
+func validWidth(width int) bool {
+	return width == 2 || width == 3 || (width >= 4 && width%4 == 0)
+}
+
+// invertible reports whether 𝟙 + diag(d) is invertible. By the matrix
+// determinant lemma, det(𝟙 + diag(d)) = ∏dᵢ ⋅ (1 + ∑1/dᵢ).
+func invertible(d []fr.Element) bool {
+	for i := range d {
+		if d[i].IsZero() {
+			return false
+		}
+	}
+	inv := fr.BatchInvert(d)
+	var sum fr.Element
+	sum.SetOne()
+	for i := range inv {
+		sum.Add(&sum, &inv[i])
+	}
+	return !sum.IsZero()
+}
+
+// sBox sets x to x^5
+func sBox(x *fr.Element) {
+	var tmp fr.Element
+	tmp.Square(x).
+		Square(&tmp)
+	x.Mul(x, &tmp)
+}
+
+// matMulM4 sets s to M4 ⋅ s, where
+//
+//	M4 = [[5, 7, 1, 3], [4, 6, 1, 1], [1, 3, 5, 7], [1, 1, 4, 6]]
+func matMulM4(s []fr.Element) {
+	var t0, t1, t2, t3, t4, t5, t6, t7 fr.Element
+	t0.Add(&s[0], &s[1])
+	t1.Add(&s[2], &s[3])
+	t2.Double(&s[1]).Add(&t2, &t1)
+	t3.Double(&s[3]).Add(&t3, &t0)
+	t4.Double(&t1).Double(&t4).Add(&t4, &t3)
+	t5.Double(&t0).Double(&t5).Add(&t5, &t2)
+	t6.Add(&t3, &t5)
+	t7.Add(&t2, &t4)
+	s[0] = t6
+	s[1] = t5
+	s[2] = t7
+	s[3] = t4
+}
+
+// matMulExternal sets state to M_E ⋅ state, where M_E is circ(2, 1) for t = 2,
+// circ(2, 1, 1) for t = 3, M4 for t = 4 and circ(2⋅M4, M4, …, M4) for t = 4k.
+func (p *Parameters) matMulExternal(state []fr.Element) {
+	switch p.Width {
+	case 2, 3:
+		var sum fr.Element
+		for i := range state {
+			sum.Add(&sum, &state[i])
+		}
+		for i := range state {
+			state[i].Add(&state[i], &sum)
+		}
+	default:
+		for i := 0; i < p.Width; i += 4 {
+			matMulM4(state[i : i+4])
+		}
+		if p.Width == 4 {
+			return
+		}
+		var sums [4]fr.Element
+		for i := 0; i < p.Width; i += 4 {
+			for j := range sums {
+				sums[j].Add(&sums[j], &state[i+j])
+			}
+		}
+		for i := range state {
+			state[i].Add(&state[i], &sums[i%4])
+		}
+	}
+}
+
+// matMulInternal sets state to M_I ⋅ state, i.e. stateᵢ = dᵢ⋅stateᵢ + ∑stateⱼ.
+func (p *Parameters) matMulInternal(state []fr.Element) {
+	var sum, tmp fr.Element
+	for i := range state {
+		sum.Add(&sum, &state[i])
+	}
+	for i := range state {
+		tmp.Mul(&state[i], &p.InternalDiag[i])
+		state[i].Add(&tmp, &sum)
+	}
+}
+
+// Permutation applies the Poseidon2 permutation to state, in place.
+//
+// The state is first multiplied by the external matrix. Then the R_P partial
+// rounds, which add a round constant to the first element, apply the s-box to
+// it and multiply the state by the internal matrix, are surrounded by R_F/2
+// full rounds, which add round constants to all the elements, apply the s-box
+// to all of them and multiply the state by the external matrix.
+func (p *Parameters) Permutation(state []fr.Element) error {
+	if len(state) != p.Width {
+		return errStateSize
+	}
+
+	halfFullRounds := p.NbFullRounds / 2
+
+	p.matMulExternal(state)
+
+	for r := 0; r < halfFullRounds; r++ {
+		p.fullRound(state, r)
+	}
+	for r := halfFullRounds; r < halfFullRounds+p.NbPartialRounds; r++ {
+		state[0].Add(&state[0], &p.RoundKeys[r][0])
+		sBox(&state[0])
+		p.matMulInternal(state)
+	}
+	for r := halfFullRounds + p.NbPartialRounds; r < p.NbFullRounds+p.NbPartialRounds; r++ {
+		p.fullRound(state, r)
+	}
+	return nil
+}
+
+func (p *Parameters) fullRound(state []fr.Element, r int) {
+	for i := range state {
+		state[i].Add(&state[i], &p.RoundKeys[r][i])
+		sBox(&state[i])
+	}
+	p.matMulExternal(state)
+}
+
+// NewPoseidon2 returns a sponge built on the Poseidon2 permutation of width
+// DefaultWidth with the default parameters.
+func NewPoseidon2() hash.Hash {
+	params, err := NewDefaultParameters(DefaultWidth)
+	if err != nil {
+		panic(err) // DefaultWidth has default parameters
+	}
+	return poseidon.NewSponge(params.Width, params.Permutation)
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+func TestParameters(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewParameters(5, 8, 57); err != errInvalidWidth {
+		t.Fatal("width 5 should be rejected")
+	}
+	if _, err := NewParameters(3, 7, 56); err != errInvalidFullRounds {
+		t.Fatal("odd number of full rounds should be rejected")
+	}
+
+	for _, width := range []int{2, 3, 4, 8, 12, 16, 20, 24} {
+		params, err := NewDefaultParameters(width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nbRoundKeys := 0
+		for i := range params.RoundKeys {
+			nbRoundKeys += len(params.RoundKeys[i])
+		}
+		if nbRoundKeys != params.NbFullRounds*width+params.NbPartialRounds {
+			t.Fatal("wrong number of round keys")
+		}
+		if !invertible(params.InternalDiag) {
+			t.Fatal("internal matrix should be invertible")
+		}
+	}
+}
+
+func TestMatMulExternal(t *testing.T) {
+	t.Parallel()
+
+	// compare the external layer with the product by the explicit matrix
+	// circ(2⋅M4, M4, M4)
+	m4 := [4][4]uint64{{5, 7, 1, 3}, {4, 6, 1, 1}, {1, 3, 5, 7}, {1, 1, 4, 6}}
+	params := &Parameters{Width: 12}
+
+	state := make([]fr.Element, params.Width)
+	for i := range state {
+		state[i].SetRandom()
+	}
+	expected := make([]fr.Element, params.Width)
+	var coeff, tmp fr.Element
+	for i := range expected {
+		for j := range state {
+			coeff.SetUint64(m4[i%4][j%4])
+			if i/4 == j/4 {
+				coeff.Double(&coeff)
+			}
+			tmp.Mul(&coeff, &state[j])
+			expected[i].Add(&expected[i], &tmp)
+		}
+	}
+
+	params.matMulExternal(state)
+	for i := range state {
+		if !state[i].Equal(&expected[i]) {
+			t.Fatal("external layer does not match circ(2⋅M4, M4, M4)")
+		}
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	t.Parallel()
+
+	for _, width := range []int{2, 3, 4, 8} {
+		params, err := NewDefaultParameters(width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = params.Permutation(make([]fr.Element, width+1)); err != errStateSize {
+			t.Fatal("state of the wrong size should be rejected")
+		}
+
+		s1 := make([]fr.Element, width)
+		for i := range s1 {
+			s1[i].SetRandom()
+		}
+		s2 := make([]fr.Element, width)
+		copy(s2, s1)
+		s2[width-1].SetOne().Add(&s2[width-1], &s1[width-1])
+
+		if err = params.Permutation(s1); err != nil {
+			t.Fatal(err)
+		}
+		if err = params.Permutation(s2); err != nil {
+			t.Fatal(err)
+		}
+		for i := range s1 {
+			if s1[i].Equal(&s2[i]) {
+				t.Fatal("a change in the input should change all the output elements")
+			}
+		}
+	}
+}
+
+func TestPoseidon2(t *testing.T) {
+	t.Parallel()
+
+	var a fr.Element
+	a.SetRandom()
+	ab := a.Bytes()
+
+	h1, h2 := NewPoseidon2(), NewPoseidon2()
+	h1.Write(ab[:])
+	h2.Write(ab[:])
+	if !bytes.Equal(h1.Sum(nil), h2.Sum(nil)) {
+		t.Fatal("Sum should be deterministic")
+	}
+	h2.Write(ab[:])
+	if bytes.Equal(h1.Sum(nil), h2.Sum(nil)) {
+		t.Fatal("different inputs should have different digests")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkPermutation(b *testing.B) {
+	params, err := NewDefaultParameters(3)
+	if err != nil {
+		b.Fatal(err)
+	}
+	state := make([]fr.Element, 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		params.Permutation(state)
+	}
+}
@@ -0,0 +1,462 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package shplonk
+
+import (
+	"errors"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/kzg"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidNumberOfPoints  = errors.New("number of digests should be equal to the number of points")
+	ErrVerifyOpeningProof     = errors.New("can't verify batch opening proof")
+	ErrInvalidNumberOfDigests = errors.New("number of digests should be equal to the number of polynomials")
+	ErrInvalidPolynomialSize  = errors.New("invalid polynomial size (larger than SRS or == 0)")
+	ErrEmptyPointSet          = errors.New("each polynomial should be opened at least at one point")
+	ErrDuplicatePoints        = errors.New("the points at which a polynomial is opened should be distinct")
+)
+
+// OpeningProof KZG proof for opening (fᵢ)_{i} at a different points (xᵢ)_{i}.
+//
+// implements io.ReaderFrom and io.WriterTo
+type OpeningProof struct {
+
+	// W = ∑ᵢ γⁱZ_{T\Sᵢ}(f_i(X)-rᵢ(X))/Z_T where Z_{T} is the vanishing polynomial on T = ∪ᵢSᵢ
+	// and r_i is the Lagrange interpolation polynomial of f_i on Sᵢ.
+	W kzg.Digest
+
+	// L(X)/(X-z) where L(X)=∑ᵢγⁱZ_{T\Sᵢ}(z)(fᵢ(X)-rᵢ(z))-Z_{T}(z)W(X)
+	WPrime kzg.Digest
+
+	// ClaimedValues[i][j] contains fᵢ(Sᵢ[j])
+	ClaimedValues [][]fr.Element
+}
+
+// BatchOpen opens the list of polynomials on points, where the i-th polynomial is opened at points[i].
+//
+// * polynomials list of polynomials to open, in canonical basis
+// * digests list of committed polynomials to open, needed to derive the challenges using Fiat Shamir
+// * points points[i] is the set Sᵢ of distinct points at which polynomials[i] is opened
+//
+// The proof consists of two G1 points whatever the number of polynomials and points.
+func BatchOpen(polynomials [][]fr.Element, digests []kzg.Digest, points [][]fr.Element, hf hash.Hash, srs *kzg.SRS) (OpeningProof, error) {
+
+	var res OpeningProof
+
+	nbPolynomials := len(polynomials)
+	if nbPolynomials != len(digests) {
+		return res, ErrInvalidNumberOfDigests
+	}
+	if nbPolynomials != len(points) {
+		return res, ErrInvalidNumberOfPoints
+	}
+	maxSize := 0
+	for i := range polynomials {
+		if len(polynomials[i]) == 0 || len(polynomials[i]) > len(srs.G1) {
+			return res, ErrInvalidPolynomialSize
+		}
+		if len(points[i]) == 0 {
+			return res, ErrEmptyPointSet
+		}
+		if len(polynomials[i]) > maxSize {
+			maxSize = len(polynomials[i])
+		}
+	}
+
+	// compute the claimed values fᵢ(Sᵢ[j])
+	res.ClaimedValues = make([][]fr.Element, nbPolynomials)
+	for i := range polynomials {
+		res.ClaimedValues[i] = make([]fr.Element, len(points[i]))
+		for j := range points[i] {
+			res.ClaimedValues[i][j] = eval(polynomials[i], points[i][j])
+		}
+	}
+
+	// derive γ, binded to the digests, the points and the claimed values
+	fs := fiatshamir.NewTranscript(hf, "gamma", "z")
+	gamma, err := deriveChallenge("gamma", points, digests, res.ClaimedValues, &fs)
+	if err != nil {
+		return res, err
+	}
+
+	// T = ∪ᵢSᵢ, a point shared by several Sᵢ appears once
+	t := union(points)
+
+	// W = ∑ᵢγⁱZ_{T\Sᵢ}(fᵢ-rᵢ)/Z_T
+	// the numerator has degree < maxSize + |T|, at most
+	numerator := make([]fr.Element, maxSize+len(t))
+	r := make([][]fr.Element, nbPolynomials)
+	var gammaI fr.Element
+	gammaI.SetOne()
+	for i := range polynomials {
+		if r[i], err = interpolate(points[i], res.ClaimedValues[i]); err != nil {
+			return res, err
+		}
+
+		// fᵢ-rᵢ
+		fMinusR := make([]fr.Element, max(len(polynomials[i]), len(r[i])))
+		copy(fMinusR, polynomials[i])
+		for j := range r[i] {
+			fMinusR[j].Sub(&fMinusR[j], &r[i][j])
+		}
+
+		// γⁱZ_{T\Sᵢ}(fᵢ-rᵢ)
+		zTMinusSi := vanishing(complement(t, points[i]))
+		mulScalar(zTMinusSi, gammaI)
+		term := mul(zTMinusSi, fMinusR)
+		for j := range term {
+			numerator[j].Add(&numerator[j], &term[j])
+		}
+
+		gammaI.Mul(&gammaI, &gamma)
+	}
+
+	// the division is exact, each Z_T root is a root of the numerator
+	w := numerator
+	for i := range t {
+		w = divideByXMinusA(w, t[i])
+	}
+	res.W, err = kzg.Commit(w, srs)
+	if err != nil {
+		return res, err
+	}
+
+	// derive z, binded to W
+	z, err := deriveZ(res.W, &fs)
+	if err != nil {
+		return res, err
+	}
+
+	// L(X) = ∑ᵢγⁱZ_{T\Sᵢ}(z)(fᵢ(X)-rᵢ(z)) - Z_T(z)W(X)
+	l := make([]fr.Element, max(maxSize, len(w)))
+	var c, ri, tmp fr.Element
+	gammaI.SetOne()
+	for i := range polynomials {
+		c = evalVanishing(complement(t, points[i]), z)
+		c.Mul(&c, &gammaI)
+		for j := range polynomials[i] {
+			tmp.Mul(&polynomials[i][j], &c)
+			l[j].Add(&l[j], &tmp)
+		}
+		ri = eval(r[i], z)
+		ri.Mul(&ri, &c)
+		l[0].Sub(&l[0], &ri)
+
+		gammaI.Mul(&gammaI, &gamma)
+	}
+	zT := evalVanishing(t, z)
+	for j := range w {
+		tmp.Mul(&w[j], &zT)
+		l[j].Sub(&l[j], &tmp)
+	}
+
+	// W' = L/(X-z), L(z) = 0
+	wPrime := divideByXMinusA(l, z)
+	if len(wPrime) == 0 {
+		wPrime = make([]fr.Element, 1)
+	}
+	res.WPrime, err = kzg.Commit(wPrime, srs)
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// BatchVerify uses proof to check that the commitments correctly open to proof.ClaimedValues
+// at points. The order matters: the proof validates that the i-th commitment is correctly
+// opened at the i-th list of points.
+//
+// It checks that F - (α-z)⋅W' = 0, where
+//
+// F = ∑ᵢγⁱZ_{T\Sᵢ}(z)([fᵢ(α)]G₁-[rᵢ(z)]G₁) - Z_T(z)[W(α)]G₁
+//
+// with the pairing check e(F + z[W'(α)]G₁, G₂)⋅e(-[W'(α)]G₁, [α]G₂) ?= 1
+func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element, hf hash.Hash, srs *kzg.SRS) error {
+
+	nbDigests := len(digests)
+	if nbDigests != len(points) || nbDigests != len(proof.ClaimedValues) {
+		return ErrInvalidNumberOfPoints
+	}
+	for i := range points {
+		if len(points[i]) == 0 {
+			return ErrEmptyPointSet
+		}
+		if len(points[i]) != len(proof.ClaimedValues[i]) {
+			return ErrInvalidNumberOfPoints
+		}
+	}
+
+	// derive γ and z
+	fs := fiatshamir.NewTranscript(hf, "gamma", "z")
+	gamma, err := deriveChallenge("gamma", points, digests, proof.ClaimedValues, &fs)
+	if err != nil {
+		return err
+	}
+	z, err := deriveZ(proof.W, &fs)
+	if err != nil {
+		return err
+	}
+
+	// F + z⋅W' = ∑ᵢcᵢ[fᵢ(α)]G₁ - [∑ᵢcᵢrᵢ(z)]G₁ - Z_T(z)[W(α)]G₁ + z[W'(α)]G₁
+	// with cᵢ = γⁱZ_{T\Sᵢ}(z)
+	bases := make([]bls12378.G1Affine, nbDigests+3)
+	scalars := make([]fr.Element, nbDigests+3)
+	copy(bases, digests)
+
+	var gammaI, ri, sumRi fr.Element
+	gammaI.SetOne()
+	t := union(points)
+	for i := range points {
+		scalars[i] = evalVanishing(complement(t, points[i]), z)
+		scalars[i].Mul(&scalars[i], &gammaI)
+
+		r, err := interpolate(points[i], proof.ClaimedValues[i])
+		if err != nil {
+			return err
+		}
+		ri = eval(r, z)
+		ri.Mul(&ri, &scalars[i])
+		sumRi.Add(&sumRi, &ri)
+
+		gammaI.Mul(&gammaI, &gamma)
+	}
+	zT := evalVanishing(t, z)
+
+	bases[nbDigests] = srs.G1[0]
+	scalars[nbDigests].Neg(&sumRi)
+	bases[nbDigests+1] = proof.W
+	scalars[nbDigests+1].Neg(&zT)
+	bases[nbDigests+2] = proof.WPrime
+	scalars[nbDigests+2] = z
+
+	var f bls12378.G1Affine
+	if _, err = f.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	var negWPrime bls12378.G1Affine
+	negWPrime.Neg(&proof.WPrime)
+
+	check, err := bls12378.PairingCheck(
+		[]bls12378.G1Affine{f, negWPrime},
+		[]bls12378.G2Affine{srs.G2[0], srs.G2[1]},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// deriveChallenge derives a challenge using Fiat Shamir, binded to the points,
+// the digests and the claimed values.
+func deriveChallenge(name string, points [][]fr.Element, digests []kzg.Digest, claimedValues [][]fr.Element, t *fiatshamir.Transcript) (fr.Element, error) {
+	for i := range points {
+		for j := range points[i] {
+			if err := t.Bind(name, points[i][j].Marshal()); err != nil {
+				return fr.Element{}, err
+			}
+		}
+	}
+	for i := range digests {
+		if err := t.Bind(name, digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range claimedValues {
+		for j := range claimedValues[i] {
+			if err := t.Bind(name, claimedValues[i][j].Marshal()); err != nil {
+				return fr.Element{}, err
+			}
+		}
+	}
+
+	b, err := t.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
+
+// deriveZ derives the evaluation point z using Fiat Shamir, binded to W.
+func deriveZ(w kzg.Digest, t *fiatshamir.Transcript) (fr.Element, error) {
+	if err := t.Bind("z", w.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	b, err := t.ComputeChallenge("z")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
+
+// union returns the distinct points of the sets of points, in order of first
+// appearance, that is T = ∪ᵢSᵢ.
+func union(points [][]fr.Element) []fr.Element {
+	seen := make(map[fr.Element]struct{})
+	res := make([]fr.Element, 0, len(points))
+	for i := range points {
+		for j := range points[i] {
+			if _, ok := seen[points[i][j]]; !ok {
+				seen[points[i][j]] = struct{}{}
+				res = append(res, points[i][j])
+			}
+		}
+	}
+	return res
+}
+
+// complement returns the points of t which are not in s, that is T\Sᵢ when t = T
+// and s = Sᵢ. Since the points of Sᵢ are distinct, Z_{T\Sᵢ}⋅Z_{Sᵢ} = Z_T.
+func complement(t, s []fr.Element) []fr.Element {
+	in := make(map[fr.Element]struct{}, len(s))
+	for i := range s {
+		in[s[i]] = struct{}{}
+	}
+	res := make([]fr.Element, 0, len(t))
+	for i := range t {
+		if _, ok := in[t[i]]; !ok {
+			res = append(res, t[i])
+		}
+	}
+	return res
+}
+
+// eval returns p(point) where p is interpreted as a polynomial
+// ∑_{i<len(p)}p[i]Xⁱ
+func eval(p []fr.Element, point fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &point).Add(&res, &p[i])
+	}
+	return res
+}
+
+// vanishing returns ∏ᵢ(X-xᵢ), in canonical basis
+func vanishing(x []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(x)+1)
+	res[0].SetOne()
+	var tmp fr.Element
+	for i := range x {
+		// res ← res⋅(X-xᵢ)
+		for j := i + 1; j > 0; j-- {
+			tmp.Mul(&res[j], &x[i])
+			res[j].Sub(&res[j-1], &tmp)
+		}
+		res[0].Mul(&res[0], &x[i]).Neg(&res[0])
+	}
+	return res
+}
+
+// evalVanishing returns ∏ᵢ(z-xᵢ)
+func evalVanishing(x []fr.Element, z fr.Element) fr.Element {
+	var res, tmp fr.Element
+	res.SetOne()
+	for i := range x {
+		tmp.Sub(&z, &x[i])
+		res.Mul(&res, &tmp)
+	}
+	return res
+}
+
+// interpolate returns the polynomial r of degree < len(x) such that r(xᵢ) = yᵢ, in canonical basis
+//
+// r = ∑ᵢyᵢ⋅Z/((X-xᵢ)⋅Z'(xᵢ)) where Z = ∏ᵢ(X-xᵢ)
+func interpolate(x, y []fr.Element) ([]fr.Element, error) {
+	z := vanishing(x)
+
+	// Z'(xᵢ) = ∏_{j≠i}(xᵢ-xⱼ)
+	denominators := make([]fr.Element, len(x))
+	var tmp fr.Element
+	for i := range x {
+		denominators[i].SetOne()
+		for j := range x {
+			if j != i {
+				tmp.Sub(&x[i], &x[j])
+				denominators[i].Mul(&denominators[i], &tmp)
+			}
+		}
+		if denominators[i].IsZero() {
+			return nil, ErrDuplicatePoints
+		}
+	}
+	denominators = fr.BatchInvert(denominators)
+
+	res := make([]fr.Element, len(x))
+	zi := make([]fr.Element, len(z))
+	for i := range x {
+		copy(zi, z)
+		q := divideByXMinusA(zi, x[i])
+		tmp.Mul(&y[i], &denominators[i])
+		for j := range q {
+			q[j].Mul(&q[j], &tmp)
+			res[j].Add(&res[j], &q[j])
+		}
+	}
+	return res, nil
+}
+
+// divideByXMinusA returns the quotient of the euclidean division of f by X-a, in canonical basis.
+// f memory is re-used for the result
+func divideByXMinusA(f []fr.Element, a fr.Element) []fr.Element {
+	var t fr.Element
+	for i := len(f) - 2; i >= 0; i-- {
+		t.Mul(&f[i+1], &a)
+		f[i].Add(&f[i], &t)
+	}
+	return f[1:]
+}
+
+// mul returns p⋅q, in canonical basis
+func mul(p, q []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(p)+len(q)-1)
+	var tmp fr.Element
+	for i := range p {
+		for j := range q {
+			tmp.Mul(&p[i], &q[j])
+			res[i+j].Add(&res[i+j], &tmp)
+		}
+	}
+	return res
+}
+
+// mulScalar sets p to c⋅p
+func mulScalar(p []fr.Element, c fr.Element) {
+	for i := range p {
+		p[i].Mul(&p[i], &c)
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
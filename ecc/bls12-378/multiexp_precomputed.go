@@ -0,0 +1,674 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/utils"
+)
+
+// MultiExpTableG1 stores multiples of a fixed set of bases Pᵢ, to speed up the
+// multi-exponentiations over these bases (see MultiExpPrecomputed).
+//
+// With c the window size and s the stride of the table, it stores [2^(c*s*m)]Pᵢ for all m such
+// that s*m is smaller than the number of c-bit windows of a scalar. A multi-exponentiation is then
+// computed with s bucket methods on a single window, instead of one per c-bit window of the
+// scalars; a table uses ⌈⌈fr.Bits/c⌉/s⌉ times the memory of the bases.
+type MultiExpTableG1 struct {
+	c       uint64 // window size
+	stride  uint64 // number of windows between two multiples of a base
+	nbBases int
+	points  []G1Affine // points[m*nbBases+i] = [2^(c*stride*m)]Pᵢ
+}
+
+// NewMultiExpTableG1 precomputes the multiples of the bases needed by
+// MultiExpPrecomputed, for windows of windowBits bits. If windowBits is 0, the window size is
+// chosen from the number of bases. config.TableStride sets the memory/time trade-off of the table.
+//
+// This call return an error if windowBits is not a supported window size or if provided config
+// is invalid.
+func NewMultiExpTableG1(points []G1Affine, windowBits uint64, config ecc.MultiExpConfig) (*MultiExpTableG1, error) {
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	if config.TableStride < 0 {
+		return nil, errors.New("invalid config: config.TableStride < 0")
+	}
+	stride := uint64(config.TableStride)
+	if stride == 0 {
+		stride = 1
+	}
+
+	implementedCs := multiExpTableWindowsG1()
+	c := windowBits
+	if c == 0 {
+		// approximate cost (in group operations)
+		// cost = nbPoints * nbChunks + stride * 2^{c}
+		minCost := uint64(0)
+		for _, cc := range implementedCs {
+			cost := uint64(len(points))*computeNbChunks(cc) + stride*(1<<cc)
+			if c == 0 || cost < minCost {
+				minCost = cost
+				c = cc
+			}
+		}
+	}
+	supported := false
+	for _, cc := range implementedCs {
+		supported = supported || cc == c
+	}
+	if !supported {
+		return nil, errors.New("unsupported window size")
+	}
+
+	nbChunks := computeNbChunks(c)
+	if stride > nbChunks {
+		stride = nbChunks
+	}
+	nbBlocks := int((nbChunks + stride - 1) / stride)
+
+	table := &MultiExpTableG1{
+		c:       c,
+		stride:  stride,
+		nbBases: len(points),
+		points:  make([]G1Affine, nbBlocks*len(points)),
+	}
+	copy(table.points, points)
+	utils.Parallelize(len(points), func(start, end int) {
+		var p G1Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&points[i])
+			for m := 1; m < nbBlocks; m++ {
+				for j := uint64(0); j < c*stride; j++ {
+					p.DoubleAssign()
+				}
+				table.points[m*len(points)+i].FromJacobian(&p)
+			}
+		}
+	}, config.NbTasks)
+
+	return table, nil
+}
+
+// multiExpTableWindowsG1 returns the window sizes supported by
+// MultiExpTableG1: the digits of the last window must fit in the buckets of the
+// other windows, that is lastC(c) <= c.
+func multiExpTableWindowsG1() []uint64 {
+	var cs []uint64
+	for _, c := range []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16} {
+		if lastC(c) <= c {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// WindowBits returns the window size of the table
+func (table *MultiExpTableG1) WindowBits() uint64 {
+	return table.c
+}
+
+// NbBases returns the number of bases of the table
+func (table *MultiExpTableG1) NbBases() int {
+	return table.nbBases
+}
+
+// WriteTo writes the binary encoding of the table: the window size, the stride and the number
+// of precomputed points (uint32), followed by the raw encoding of the points
+func (table *MultiExpTableG1) WriteTo(w io.Writer) (int64, error) {
+	var header [12]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(table.c))
+	binary.BigEndian.PutUint32(header[4:8], uint32(table.stride))
+	binary.BigEndian.PutUint32(header[8:], uint32(len(table.points)))
+	n, err := w.Write(header[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	// the points are encoded in parallel, multiExpTableIOChunk at a time
+	const size = SizeOfG1AffineUncompressed
+	buf := make([]byte, multiExpTableIOChunk*size)
+	for start := 0; start < len(table.points); start += multiExpTableIOChunk {
+		points := table.points[start:]
+		if len(points) > multiExpTableIOChunk {
+			points = points[:multiExpTableIOChunk]
+		}
+		utils.Parallelize(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				b := points[i].RawBytes()
+				copy(buf[i*size:(i+1)*size], b[:])
+			}
+		})
+		n, err = w.Write(buf[:len(points)*size])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ReadFrom decodes a table written by WriteTo; the points are checked to be in the subgroup.
+func (table *MultiExpTableG1) ReadFrom(r io.Reader) (int64, error) {
+	return table.readFrom(r, true)
+}
+
+// UnsafeReadFrom decodes a table written by WriteTo, without subgroup checks on the points.
+// It should only be used on trusted inputs.
+func (table *MultiExpTableG1) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return table.readFrom(r, false)
+}
+
+func (table *MultiExpTableG1) readFrom(r io.Reader, subGroupCheck bool) (int64, error) {
+	var header [12]byte
+	n, err := io.ReadFull(r, header[:])
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	c := uint64(binary.BigEndian.Uint32(header[:4]))
+	stride := uint64(binary.BigEndian.Uint32(header[4:8]))
+	nbPoints := int(binary.BigEndian.Uint32(header[8:]))
+
+	supported := false
+	for _, cc := range multiExpTableWindowsG1() {
+		supported = supported || cc == c
+	}
+	if !supported {
+		return read, errors.New("invalid table: unsupported window size")
+	}
+	if stride == 0 || stride > computeNbChunks(c) {
+		return read, errors.New("invalid table: invalid stride")
+	}
+	nbBlocks := int((computeNbChunks(c) + stride - 1) / stride)
+	if nbPoints%nbBlocks != 0 {
+		return read, errors.New("invalid table: wrong number of points")
+	}
+
+	// the points are decoded in parallel, multiExpTableIOChunk at a time; nbPoints is not
+	// trusted: the slice grows as the chunks are read, instead of being allocated upfront
+	const size = SizeOfG1AffineUncompressed
+	var points []G1Affine
+	buf := make([]byte, multiExpTableIOChunk*size)
+	var errLock sync.Mutex
+	for start := 0; start < nbPoints; start += multiExpTableIOChunk {
+		m := nbPoints - start
+		if m > multiExpTableIOChunk {
+			m = multiExpTableIOChunk
+		}
+		points = append(points, make([]G1Affine, m)...)
+		chunk := points[start:]
+		n, err = io.ReadFull(r, buf[:len(chunk)*size])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		utils.Parallelize(len(chunk), func(start, end int) {
+			for i := start; i < end; i++ {
+				if _, errSet := chunk[i].setBytes(buf[i*size:(i+1)*size], subGroupCheck); errSet != nil {
+					errLock.Lock()
+					err = errSet
+					errLock.Unlock()
+					return
+				}
+			}
+		})
+		if err != nil {
+			return read, err
+		}
+	}
+
+	table.c = c
+	table.stride = stride
+	table.nbBases = nbPoints / nbBlocks
+	table.points = points
+	return read, nil
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars,
+// using the precomputed multiples of the bases (see MultiExpTableG1).
+//
+// This call return an error if len(scalars) != table.NbBases() or if provided config is invalid.
+func (p *G1Affine) MultiExpPrecomputed(table *MultiExpTableG1, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpPrecomputed(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars,
+// using the precomputed multiples of the bases (see MultiExpTableG1).
+//
+// This call return an error if len(scalars) != table.NbBases() or if provided config is invalid.
+func (p *G1Jac) MultiExpPrecomputed(table *MultiExpTableG1, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	n := table.nbBases
+	if n != len(scalars) {
+		return nil, errors.New("len(scalars) != number of bases of the table")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// the digits of the c-bit windows of the scalars; digits[j*n+i] is the j-th digit of
+	// scalars[i], to be multiplied by [2^(c*j)]Pᵢ
+	c := table.c
+	digits, _ := partitionScalars(scalars, c, config.NbTasks)
+	nbChunks := int(computeNbChunks(c))
+	stride := int(table.stride)
+	nbBlocks := (nbChunks + stride - 1) / stride
+
+	// the windows r, r+stride, r+2*stride, ... are processed at once with the points of the
+	// table, then the result is multiplied by 2^c before the windows r-1, r-1+stride, ...
+	var _p g1JacExtended
+	_p.setInfinity()
+	blockDigits := make([]uint16, len(table.points))
+	for r := stride - 1; r >= 0; r-- {
+		if r != stride-1 {
+			for l := uint64(0); l < c; l++ {
+				_p.double(&_p)
+			}
+		}
+		for m := 0; m < nbBlocks; m++ {
+			j := m*stride + r
+			if j < nbChunks {
+				copy(blockDigits[m*n:(m+1)*n], digits[j*n:(j+1)*n])
+			} else {
+				for i := m * n; i < (m+1)*n; i++ {
+					blockDigits[i] = 0
+				}
+			}
+		}
+		total := msmSingleWindowG1(c, table.points, blockDigits, config.NbTasks)
+		_p.add(&total)
+	}
+
+	return p.unsafeFromJacExtended(&_p), nil
+}
+
+// msmSingleWindowG1 returns ∑ digits[i] * points[i], where the digits are signed
+// c-bit digits as returned by partitionScalars. The points are split between nbTasks bucket
+// methods running in parallel.
+func msmSingleWindowG1(c uint64, points []G1Affine, digits []uint16, nbTasks int) g1JacExtended {
+	// each task should fill its buckets
+	nbBuckets := 1 << (c - 1)
+	if maxTasks := len(points) / nbBuckets; nbTasks > maxTasks {
+		nbTasks = maxTasks
+	}
+	if nbTasks < 1 {
+		nbTasks = 1
+	}
+
+	chTotals := make(chan g1JacExtended, nbTasks)
+	for k := 0; k < nbTasks; k++ {
+		start := k * len(points) / nbTasks
+		end := (k + 1) * len(points) / nbTasks
+		stat := chunkStat{nbBucketFilled: end - start}
+		if stat.nbBucketFilled > nbBuckets {
+			stat.nbBucketFilled = nbBuckets
+		}
+		processChunk := getChunkProcessorG1(c, stat)
+		go processChunk(0, chTotals, c, points[start:end], digits[start:end])
+	}
+
+	var total g1JacExtended
+	total.setInfinity()
+	for k := 0; k < nbTasks; k++ {
+		t := <-chTotals
+		total.add(&t)
+	}
+	return total
+}
+
+// MultiExpTableG2 stores multiples of a fixed set of bases Pᵢ, to speed up the
+// multi-exponentiations over these bases (see MultiExpPrecomputed).
+//
+// With c the window size and s the stride of the table, it stores [2^(c*s*m)]Pᵢ for all m such
+// that s*m is smaller than the number of c-bit windows of a scalar. A multi-exponentiation is then
+// computed with s bucket methods on a single window, instead of one per c-bit window of the
+// scalars; a table uses ⌈⌈fr.Bits/c⌉/s⌉ times the memory of the bases.
+type MultiExpTableG2 struct {
+	c       uint64 // window size
+	stride  uint64 // number of windows between two multiples of a base
+	nbBases int
+	points  []G2Affine // points[m*nbBases+i] = [2^(c*stride*m)]Pᵢ
+}
+
+// NewMultiExpTableG2 precomputes the multiples of the bases needed by
+// MultiExpPrecomputed, for windows of windowBits bits. If windowBits is 0, the window size is
+// chosen from the number of bases. config.TableStride sets the memory/time trade-off of the table.
+//
+// This call return an error if windowBits is not a supported window size or if provided config
+// is invalid.
+func NewMultiExpTableG2(points []G2Affine, windowBits uint64, config ecc.MultiExpConfig) (*MultiExpTableG2, error) {
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	if config.TableStride < 0 {
+		return nil, errors.New("invalid config: config.TableStride < 0")
+	}
+	stride := uint64(config.TableStride)
+	if stride == 0 {
+		stride = 1
+	}
+
+	implementedCs := multiExpTableWindowsG2()
+	c := windowBits
+	if c == 0 {
+		// approximate cost (in group operations)
+		// cost = nbPoints * nbChunks + stride * 2^{c}
+		minCost := uint64(0)
+		for _, cc := range implementedCs {
+			cost := uint64(len(points))*computeNbChunks(cc) + stride*(1<<cc)
+			if c == 0 || cost < minCost {
+				minCost = cost
+				c = cc
+			}
+		}
+	}
+	supported := false
+	for _, cc := range implementedCs {
+		supported = supported || cc == c
+	}
+	if !supported {
+		return nil, errors.New("unsupported window size")
+	}
+
+	nbChunks := computeNbChunks(c)
+	if stride > nbChunks {
+		stride = nbChunks
+	}
+	nbBlocks := int((nbChunks + stride - 1) / stride)
+
+	table := &MultiExpTableG2{
+		c:       c,
+		stride:  stride,
+		nbBases: len(points),
+		points:  make([]G2Affine, nbBlocks*len(points)),
+	}
+	copy(table.points, points)
+	utils.Parallelize(len(points), func(start, end int) {
+		var p G2Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&points[i])
+			for m := 1; m < nbBlocks; m++ {
+				for j := uint64(0); j < c*stride; j++ {
+					p.DoubleAssign()
+				}
+				table.points[m*len(points)+i].FromJacobian(&p)
+			}
+		}
+	}, config.NbTasks)
+
+	return table, nil
+}
+
+// multiExpTableWindowsG2 returns the window sizes supported by
+// MultiExpTableG2: the digits of the last window must fit in the buckets of the
+// other windows, that is lastC(c) <= c.
+func multiExpTableWindowsG2() []uint64 {
+	var cs []uint64
+	for _, c := range []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16} {
+		if lastC(c) <= c {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// WindowBits returns the window size of the table
+func (table *MultiExpTableG2) WindowBits() uint64 {
+	return table.c
+}
+
+// NbBases returns the number of bases of the table
+func (table *MultiExpTableG2) NbBases() int {
+	return table.nbBases
+}
+
+// WriteTo writes the binary encoding of the table: the window size, the stride and the number
+// of precomputed points (uint32), followed by the raw encoding of the points
+func (table *MultiExpTableG2) WriteTo(w io.Writer) (int64, error) {
+	var header [12]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(table.c))
+	binary.BigEndian.PutUint32(header[4:8], uint32(table.stride))
+	binary.BigEndian.PutUint32(header[8:], uint32(len(table.points)))
+	n, err := w.Write(header[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	// the points are encoded in parallel, multiExpTableIOChunk at a time
+	const size = SizeOfG2AffineUncompressed
+	buf := make([]byte, multiExpTableIOChunk*size)
+	for start := 0; start < len(table.points); start += multiExpTableIOChunk {
+		points := table.points[start:]
+		if len(points) > multiExpTableIOChunk {
+			points = points[:multiExpTableIOChunk]
+		}
+		utils.Parallelize(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				b := points[i].RawBytes()
+				copy(buf[i*size:(i+1)*size], b[:])
+			}
+		})
+		n, err = w.Write(buf[:len(points)*size])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ReadFrom decodes a table written by WriteTo; the points are checked to be in the subgroup.
+func (table *MultiExpTableG2) ReadFrom(r io.Reader) (int64, error) {
+	return table.readFrom(r, true)
+}
+
+// UnsafeReadFrom decodes a table written by WriteTo, without subgroup checks on the points.
+// It should only be used on trusted inputs.
+func (table *MultiExpTableG2) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return table.readFrom(r, false)
+}
+
+func (table *MultiExpTableG2) readFrom(r io.Reader, subGroupCheck bool) (int64, error) {
+	var header [12]byte
+	n, err := io.ReadFull(r, header[:])
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	c := uint64(binary.BigEndian.Uint32(header[:4]))
+	stride := uint64(binary.BigEndian.Uint32(header[4:8]))
+	nbPoints := int(binary.BigEndian.Uint32(header[8:]))
+
+	supported := false
+	for _, cc := range multiExpTableWindowsG2() {
+		supported = supported || cc == c
+	}
+	if !supported {
+		return read, errors.New("invalid table: unsupported window size")
+	}
+	if stride == 0 || stride > computeNbChunks(c) {
+		return read, errors.New("invalid table: invalid stride")
+	}
+	nbBlocks := int((computeNbChunks(c) + stride - 1) / stride)
+	if nbPoints%nbBlocks != 0 {
+		return read, errors.New("invalid table: wrong number of points")
+	}
+
+	// the points are decoded in parallel, multiExpTableIOChunk at a time; nbPoints is not
+	// trusted: the slice grows as the chunks are read, instead of being allocated upfront
+	const size = SizeOfG2AffineUncompressed
+	var points []G2Affine
+	buf := make([]byte, multiExpTableIOChunk*size)
+	var errLock sync.Mutex
+	for start := 0; start < nbPoints; start += multiExpTableIOChunk {
+		m := nbPoints - start
+		if m > multiExpTableIOChunk {
+			m = multiExpTableIOChunk
+		}
+		points = append(points, make([]G2Affine, m)...)
+		chunk := points[start:]
+		n, err = io.ReadFull(r, buf[:len(chunk)*size])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		utils.Parallelize(len(chunk), func(start, end int) {
+			for i := start; i < end; i++ {
+				if _, errSet := chunk[i].setBytes(buf[i*size:(i+1)*size], subGroupCheck); errSet != nil {
+					errLock.Lock()
+					err = errSet
+					errLock.Unlock()
+					return
+				}
+			}
+		})
+		if err != nil {
+			return read, err
+		}
+	}
+
+	table.c = c
+	table.stride = stride
+	table.nbBases = nbPoints / nbBlocks
+	table.points = points
+	return read, nil
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars,
+// using the precomputed multiples of the bases (see MultiExpTableG2).
+//
+// This call return an error if len(scalars) != table.NbBases() or if provided config is invalid.
+func (p *G2Affine) MultiExpPrecomputed(table *MultiExpTableG2, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpPrecomputed(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars,
+// using the precomputed multiples of the bases (see MultiExpTableG2).
+//
+// This call return an error if len(scalars) != table.NbBases() or if provided config is invalid.
+func (p *G2Jac) MultiExpPrecomputed(table *MultiExpTableG2, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	n := table.nbBases
+	if n != len(scalars) {
+		return nil, errors.New("len(scalars) != number of bases of the table")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// the digits of the c-bit windows of the scalars; digits[j*n+i] is the j-th digit of
+	// scalars[i], to be multiplied by [2^(c*j)]Pᵢ
+	c := table.c
+	digits, _ := partitionScalars(scalars, c, config.NbTasks)
+	nbChunks := int(computeNbChunks(c))
+	stride := int(table.stride)
+	nbBlocks := (nbChunks + stride - 1) / stride
+
+	// the windows r, r+stride, r+2*stride, ... are processed at once with the points of the
+	// table, then the result is multiplied by 2^c before the windows r-1, r-1+stride, ...
+	var _p g2JacExtended
+	_p.setInfinity()
+	blockDigits := make([]uint16, len(table.points))
+	for r := stride - 1; r >= 0; r-- {
+		if r != stride-1 {
+			for l := uint64(0); l < c; l++ {
+				_p.double(&_p)
+			}
+		}
+		for m := 0; m < nbBlocks; m++ {
+			j := m*stride + r
+			if j < nbChunks {
+				copy(blockDigits[m*n:(m+1)*n], digits[j*n:(j+1)*n])
+			} else {
+				for i := m * n; i < (m+1)*n; i++ {
+					blockDigits[i] = 0
+				}
+			}
+		}
+		total := msmSingleWindowG2(c, table.points, blockDigits, config.NbTasks)
+		_p.add(&total)
+	}
+
+	return p.unsafeFromJacExtended(&_p), nil
+}
+
+// msmSingleWindowG2 returns ∑ digits[i] * points[i], where the digits are signed
+// c-bit digits as returned by partitionScalars. The points are split between nbTasks bucket
+// methods running in parallel.
+func msmSingleWindowG2(c uint64, points []G2Affine, digits []uint16, nbTasks int) g2JacExtended {
+	// each task should fill its buckets
+	nbBuckets := 1 << (c - 1)
+	if maxTasks := len(points) / nbBuckets; nbTasks > maxTasks {
+		nbTasks = maxTasks
+	}
+	if nbTasks < 1 {
+		nbTasks = 1
+	}
+
+	chTotals := make(chan g2JacExtended, nbTasks)
+	for k := 0; k < nbTasks; k++ {
+		start := k * len(points) / nbTasks
+		end := (k + 1) * len(points) / nbTasks
+		stat := chunkStat{nbBucketFilled: end - start}
+		if stat.nbBucketFilled > nbBuckets {
+			stat.nbBucketFilled = nbBuckets
+		}
+		processChunk := getChunkProcessorG2(c, stat)
+		go processChunk(0, chTotals, c, points[start:end], digits[start:end])
+	}
+
+	var total g2JacExtended
+	total.setInfinity()
+	for k := 0; k < nbTasks; k++ {
+		t := <-chTotals
+		total.add(&t)
+	}
+	return total
+}
+
+// multiExpTableIOChunk is the number of points (de)serialized at once by the tables
+const multiExpTableIOChunk = 1 << 14
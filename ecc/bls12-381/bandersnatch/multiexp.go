@@ -0,0 +1,299 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/utils"
+)
+
+// MultiExp computes the multi-exponentiation ∑ᵢ [scalars[i]]points[i] with the bucket method
+// (section 4 of https://eprint.iacr.org/2012/549.pdf), the buckets being in extended coordinates.
+//
+// If config.GLV is set, the scalars are split with the GLV decomposition sᵢ = k₁ᵢ + λk₂ᵢ and the
+// multi-exponentiation runs over the points and their images by the endomorphism ϕ, with half as
+// many windows.
+//
+// The additions are exception-free for points in the prime order subgroup.
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []big.Int, config ecc.MultiExpConfig) (*PointExtended, error) {
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	initOnce.Do(initCurveParams)
+	if config.GLV {
+		points, scalars = splitGLV(points, scalars, config.NbTasks)
+	}
+
+	// scalars are reduced modulo the order, and written as little-endian 64-bit words
+	words, nbBits := scalarsToWords(scalars, config.NbTasks)
+	if nbBits == 0 {
+		return p.setInfinity(), nil
+	}
+
+	// one more bit is needed for the carry in the last window
+	c := multiExpBestC(len(points), nbBits+1)
+	nbChunks := (nbBits + c) / c
+	digits := partitionScalars(words, c, nbChunks, config.NbTasks)
+
+	// each chunk is the weighted sum of its buckets
+	chunks := make([]PointExtended, nbChunks)
+	utils.Parallelize(nbChunks, func(start, end int) {
+		buckets := make([]PointExtended, 1<<(c-1))
+		for j := start; j < end; j++ {
+			processChunk(&chunks[j], buckets, points, digits[j*len(points):(j+1)*len(points)])
+		}
+	}, config.NbTasks)
+
+	// reduce the chunks into the result
+	p.Set(&chunks[nbChunks-1])
+	for j := nbChunks - 2; j >= 0; j-- {
+		for l := 0; l < c; l++ {
+			p.Double(p)
+		}
+		p.add(p, &chunks[j])
+	}
+
+	return p, nil
+}
+
+// splitGLV returns the points Pᵢ, ϕ(Pᵢ) and the scalars k₁ᵢ, k₂ᵢ such that sᵢ = k₁ᵢ + λk₂ᵢ,
+// ±Pᵢ, ±ϕ(Pᵢ) being negated so that the k's are non-negative.
+func splitGLV(points []PointAffine, scalars []big.Int, nbTasks int) ([]PointAffine, []big.Int) {
+	n := len(points)
+	glvPoints := make([]PointAffine, 2*n)
+	glvScalars := make([]big.Int, 2*n)
+
+	// ϕ is computed in extended coordinates, the images are then normalized with a batch inversion
+	phiPoints := make([]PointExtended, n)
+	zs := make([]fr.Element, n)
+	utils.Parallelize(n, func(start, end int) {
+		var s, zero big.Int
+		for i := start; i < end; i++ {
+			// ϕ doesn't map the neutral element (0:1:1:0) to a valid point
+			if points[i].IsZero() {
+				phiPoints[i].setInfinity()
+			} else {
+				phiPoints[i].FromAffine(&points[i])
+				phiPoints[i].phi(&phiPoints[i])
+			}
+			zs[i] = phiPoints[i].Z
+
+			s.Mod(&scalars[i], &curveParams.Order)
+			k := ecc.SplitScalar(&s, &curveParams.glvBasis)
+			glvPoints[i] = points[i]
+			if k[0].Cmp(&zero) == -1 {
+				k[0].Neg(&k[0])
+				glvPoints[i].Neg(&glvPoints[i])
+			}
+			if k[1].Cmp(&zero) == -1 {
+				k[1].Neg(&k[1])
+				phiPoints[i].Neg(&phiPoints[i])
+			}
+			glvScalars[i].Set(&k[0])
+			glvScalars[n+i].Set(&k[1])
+		}
+	}, nbTasks)
+
+	zs = fr.BatchInvert(zs)
+	utils.Parallelize(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			glvPoints[n+i].X.Mul(&phiPoints[i].X, &zs[i])
+			glvPoints[n+i].Y.Mul(&phiPoints[i].Y, &zs[i])
+		}
+	}, nbTasks)
+
+	return glvPoints, glvScalars
+}
+
+// scalarsToWords returns the scalars reduced modulo the order, as little-endian 64-bit words,
+// and the maximum bit length of the reduced scalars.
+func scalarsToWords(scalars []big.Int, nbTasks int) (words [][fr.Limbs]uint64, nbBits int) {
+	words = make([][fr.Limbs]uint64, len(scalars))
+	maxBits := make([]int, len(scalars))
+	utils.Parallelize(len(scalars), func(start, end int) {
+		var s big.Int
+		var buf [fr.Bytes]byte
+		for i := start; i < end; i++ {
+			k := &scalars[i]
+			if k.Sign() == -1 || k.Cmp(&curveParams.Order) >= 0 {
+				k = s.Mod(k, &curveParams.Order)
+			}
+			maxBits[i] = k.BitLen()
+			k.FillBytes(buf[:])
+			for j := 0; j < fr.Limbs; j++ {
+				for l := 0; l < 8; l++ {
+					words[i][j] |= uint64(buf[fr.Bytes-1-8*j-l]) << (8 * l)
+				}
+			}
+		}
+	}, nbTasks)
+	for _, b := range maxBits {
+		if b > nbBits {
+			nbBits = b
+		}
+	}
+	return
+}
+
+// multiExpBestC returns the window size minimizing the approximate cost
+// nbBits/c * (nbPoints + 2^{c-1}) of the bucket method.
+func multiExpBestC(nbPoints, nbBits int) int {
+	var C int
+	min := math.MaxFloat64
+	for c := 2; c <= 16; c++ {
+		cost := float64(nbBits) * float64(nbPoints+(1<<(c-1))) / float64(c)
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
+// partitionScalars computes, for each scalar, nbChunks signed digits in c-bit windows:
+// if a digit is larger than 2^{c-1}, then we borrow 2^c from the next window and subtract
+// 2^{c} to the current digit, making it negative. The last window must have room for the
+// carry. digits[j*len(words)+i] is the j-th digit of the i-th scalar.
+func partitionScalars(words [][fr.Limbs]uint64, c, nbChunks, nbTasks int) []int32 {
+	digits := make([]int32, len(words)*nbChunks)
+	max := 1<<(c-1) - 1
+	mask := uint64(1)<<c - 1
+
+	utils.Parallelize(len(words), func(start, end int) {
+		for i := start; i < end; i++ {
+			carry := 0
+			for j := 0; j < nbChunks; j++ {
+				// digit = value of the c-bit window, which may span 2 words
+				offset := j * c
+				index, shift := offset/64, uint(offset%64)
+				digit := carry
+				if index < fr.Limbs {
+					w := words[i][index] >> shift
+					if shift+uint(c) > 64 && index+1 < fr.Limbs {
+						w |= words[i][index+1] << (64 - shift)
+					}
+					digit += int(w & mask)
+				}
+				carry = 0
+				if digit > max && j != nbChunks-1 {
+					digit -= 1 << c
+					carry = 1
+				}
+				digits[j*len(words)+i] = int32(digit)
+			}
+		}
+	}, nbTasks)
+
+	return digits
+}
+
+// processChunk sets res to the weighted sum of the buckets in which the points are added
+// according to their digit, negative digits adding the opposite point.
+func processChunk(res *PointExtended, buckets []PointExtended, points []PointAffine, digits []int32) {
+	for i := range buckets {
+		buckets[i].setInfinity()
+	}
+
+	var neg PointAffine
+	for i, digit := range digits {
+		if digit > 0 {
+			buckets[digit-1].mixedAdd(&buckets[digit-1], &points[i])
+		} else if digit < 0 {
+			neg.Neg(&points[i])
+			buckets[-digit-1].mixedAdd(&buckets[-digit-1], &neg)
+		}
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var runningSum PointExtended
+	runningSum.setInfinity()
+	res.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		runningSum.add(&runningSum, &buckets[k])
+		res.add(res, &runningSum)
+	}
+}
+
+// add sets p to p1+p2 with the unified addition formulas in extended coordinates: unlike
+// Add, they handle doublings and opposite points, and are exception-free for points of odd order.
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-add-2008-hwcd
+func (p *PointExtended) add(p1, p2 *PointExtended) *PointExtended {
+	var A, B, C, D, E, F, G, H, tmp fr.Element
+	A.Mul(&p1.X, &p2.X)
+	B.Mul(&p1.Y, &p2.Y)
+	C.Mul(&p1.T, &p2.T).Mul(&C, &curveParams.D)
+	D.Mul(&p1.Z, &p2.Z)
+	tmp.Add(&p2.X, &p2.Y)
+	E.Add(&p1.X, &p1.Y).
+		Mul(&E, &tmp).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	F.Sub(&D, &C)
+	G.Add(&D, &C)
+	mulByA(&A)
+	H.Sub(&B, &A)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// mixedAdd sets p to p1+p2 with the unified addition formulas, p2 being in affine coordinates.
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-madd-2008-hwcd
+func (p *PointExtended) mixedAdd(p1 *PointExtended, p2 *PointAffine) *PointExtended {
+	var A, B, C, E, F, G, H, tmp fr.Element
+	A.Mul(&p1.X, &p2.X)
+	B.Mul(&p1.Y, &p2.Y)
+	C.Mul(&p2.X, &p2.Y).Mul(&C, &p1.T).Mul(&C, &curveParams.D)
+	tmp.Add(&p2.X, &p2.Y)
+	E.Add(&p1.X, &p1.Y).
+		Mul(&E, &tmp).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	F.Sub(&p1.Z, &C)
+	G.Add(&p1.Z, &C)
+	mulByA(&A)
+	H.Sub(&B, &A)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+func TestMultiExp(t *testing.T) {
+	t.Parallel()
+	initOnce.Do(initCurveParams)
+
+	nbSamples := 1 << 8
+	if testing.Short() {
+		nbSamples = 1 << 5
+	}
+
+	// points [i]Base, with repetitions and the neutral element
+	points := make([]PointAffine, nbSamples)
+	points[0].Set(&curveParams.Base)
+	for i := 1; i < nbSamples; i++ {
+		points[i].Add(&points[i-1], &curveParams.Base)
+	}
+	points[1].Set(&points[0])
+	points[rand.Intn(nbSamples)].setInfinity()
+
+	// random scalars, with zeros, repetitions, and scalars larger than the order or negative
+	scalars := make([]big.Int, nbSamples)
+	for i := range scalars {
+		scalars[i].Rand(rand.New(rand.NewSource(int64(i))), &curveParams.Order)
+	}
+	scalars[2].SetUint64(0)
+	scalars[3].Set(&scalars[4])
+	scalars[5].Add(&scalars[5], &curveParams.Order)
+	scalars[6].Neg(&scalars[6])
+
+	var expected, tmp PointExtended
+	var s big.Int
+	expected.setInfinity()
+	for i := range points {
+		if points[i].IsZero() {
+			continue
+		}
+		tmp.FromAffine(&points[i])
+		tmp.ScalarMultiplication(&tmp, s.Mod(&scalars[i], &curveParams.Order))
+		expected.Add(&expected, &tmp)
+	}
+
+	configs := []ecc.MultiExpConfig{{}, {NbTasks: 1}, {NbTasks: 5}}
+	configs = append(configs, ecc.MultiExpConfig{GLV: true}, ecc.MultiExpConfig{GLV: true, NbTasks: 3})
+	for _, config := range configs {
+		var got PointExtended
+		if _, err := got.MultiExp(points, scalars, config); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("MultiExp failed with config %+v", config)
+		}
+	}
+
+	// all scalars are zero
+	var got PointExtended
+	if _, err := got.MultiExp(points, make([]big.Int, nbSamples), ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsZero() {
+		t.Fatal("MultiExp with zero scalars should be the neutral element")
+	}
+
+	if _, err := got.MultiExp(points[1:], scalars, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("MultiExp should fail when len(points) != len(scalars)")
+	}
+}
+
+func TestMultiExpWindows(t *testing.T) {
+	t.Parallel()
+	initOnce.Do(initCurveParams)
+
+	// the digits in c-bit windows should recompose the scalars
+	const nbSamples = 1 << 4
+	scalars := make([]big.Int, nbSamples)
+	for i := range scalars {
+		scalars[i].Rand(rand.New(rand.NewSource(int64(i))), &curveParams.Order)
+	}
+	scalars[0].Sub(&curveParams.Order, big.NewInt(1))
+	words, nbBits := scalarsToWords(scalars, 1)
+
+	for c := 2; c <= 16; c++ {
+		nbChunks := (nbBits + c) / c
+		digits := partitionScalars(words, c, nbChunks, 1)
+		for i := range scalars {
+			var s, d big.Int
+			for j := nbChunks - 1; j >= 0; j-- {
+				s.Lsh(&s, uint(c))
+				s.Add(&s, d.SetInt64(int64(digits[j*nbSamples+i])))
+			}
+			if s.Cmp(&scalars[i]) != 0 {
+				t.Fatalf("wrong decomposition of scalar %d with c=%d", i, c)
+			}
+		}
+	}
+}
+
+func BenchmarkMultiExp(b *testing.B) {
+	initOnce.Do(initCurveParams)
+
+	const nbSamples = 1 << 14
+	points := make([]PointAffine, nbSamples)
+	points[0].Set(&curveParams.Base)
+	for i := 1; i < nbSamples; i++ {
+		points[i].Add(&points[i-1], &curveParams.Base)
+	}
+	scalars := make([]big.Int, nbSamples)
+	r := rand.New(rand.NewSource(0))
+	for i := range scalars {
+		scalars[i].Rand(r, &curveParams.Order)
+	}
+
+	var res PointExtended
+	b.Run("extended", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+		}
+	})
+
+	b.Run("glv", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.MultiExp(points, scalars, ecc.MultiExpConfig{GLV: true})
+		}
+	})
+}
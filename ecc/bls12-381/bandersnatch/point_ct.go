@@ -0,0 +1,123 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// ScalarMultiplicationCT computes and returns p = p1 ⋅ s
+//
+// It runs in constant time with respect to s, which is reduced modulo the order of the
+// subgroup: a fixed 4-bit window processes all the bits of the order, the multiples of p1 are
+// read with a constant-time table lookup, and added with the unified addition formulas and
+// the constant-time operations of fr. It is much slower than ScalarMultiplication, and should
+// be used when s is secret.
+//
+// Note that the reduction of s doesn't run in constant time with respect to its bit length.
+func (p *PointProj) ScalarMultiplicationCT(p1 *PointProj, s *big.Int) *PointProj {
+	initOnce.Do(initCurveParams)
+
+	var e big.Int
+	e.Mod(s, &curveParams.Order)
+	buf := make([]byte, (curveParams.Order.BitLen()+7)/8)
+	e.FillBytes(buf)
+
+	// table[i] = i⋅p1
+	var table [16]PointProj
+	table[0].setInfinity()
+	table[1].Set(p1)
+	for i := 2; i < len(table); i++ {
+		table[i].addCT(&table[i-1], p1)
+	}
+
+	var res, t PointProj
+	res.setInfinity()
+	for _, b := range buf {
+		for _, w := range [2]byte{b >> 4, b & 0xf} {
+			res.addCT(&res, &res)
+			res.addCT(&res, &res)
+			res.addCT(&res, &res)
+			res.addCT(&res, &res)
+			t.lookupCT(&table, w)
+			res.addCT(&res, &t)
+		}
+	}
+
+	p.Set(&res)
+	return p
+}
+
+// ScalarMultiplicationCT computes and returns p = p1 ⋅ s, in constant time with respect to s.
+//
+// See PointProj.ScalarMultiplicationCT.
+func (p *PointAffine) ScalarMultiplicationCT(p1 *PointAffine, s *big.Int) *PointAffine {
+	var _p PointProj
+	_p.FromAffine(p1)
+	_p.ScalarMultiplicationCT(&_p, s)
+
+	var zInv fr.Element
+	zInv.InverseCT(&_p.Z)
+	p.X.MulCT(&_p.X, &zInv)
+	p.Y.MulCT(&_p.Y, &zInv)
+	return p
+}
+
+// addCT sets p = p1 + p2 and returns p, with the unified addition formulas, which also
+// double a point; it uses the constant-time operations of fr.
+// cf https://hyperelliptic.org/EFD/g1p/auto-twisted-projective.html#addition-add-2008-bbjlp
+func (p *PointProj) addCT(p1, p2 *PointProj) *PointProj {
+	var A, B, C, D, E, F, G, H, I, X, Y fr.Element
+	A.MulCT(&p1.Z, &p2.Z)
+	B.MulCT(&A, &A)
+	C.MulCT(&p1.X, &p2.X)
+	D.MulCT(&p1.Y, &p2.Y)
+	E.MulCT(&curveParams.D, &C).MulCT(&E, &D)
+	F.SubCT(&B, &E)
+	G.AddCT(&B, &E)
+	H.AddCT(&p1.X, &p1.Y)
+	I.AddCT(&p2.X, &p2.Y)
+	X.MulCT(&H, &I).
+		SubCT(&X, &C).
+		SubCT(&X, &D).
+		MulCT(&X, &A).
+		MulCT(&X, &F)
+	C.MulCT(&curveParams.A, &C)
+	Y.SubCT(&D, &C).
+		MulCT(&Y, &A).
+		MulCT(&Y, &G)
+	p.Z.MulCT(&F, &G)
+	p.X, p.Y = X, Y
+
+	return p
+}
+
+// lookupCT sets p = table[i], reading all the entries of the table
+func (p *PointProj) lookupCT(table *[16]PointProj, i byte) {
+	p.X.SetZero()
+	p.Y.SetZero()
+	p.Z.SetZero()
+	for j := range table {
+		c := subtle.ConstantTimeByteEq(byte(j), i)
+		p.X.Select(c, &p.X, &table[j].X)
+		p.Y.Select(c, &p.Y, &table[j].Y)
+		p.Z.Select(c, &p.Z, &table[j].Z)
+	}
+}
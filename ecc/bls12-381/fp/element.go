@@ -30,6 +30,7 @@ import (
 	"github.com/bits-and-blooms/bitset"
 	"github.com/consensys/gnark-crypto/field/hash"
 	"github.com/consensys/gnark-crypto/field/pool"
+	"github.com/consensys/gnark-crypto/utils"
 )
 
 // Element represents a field element stored on 6 words (uint64)
@@ -44,6 +45,8 @@ import (
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// The methods with a CT suffix (InverseCT, ExpCT, ...) run in constant time with respect to their
+// inputs, and should be used instead of their variable-time counterparts on secret values.
 type Element [6]uint64
 
 const (
@@ -1347,6 +1350,114 @@ func (z *Element) Sqrt(x *Element) *Element {
 	return nil
 }
 
+// BatchSqrt returns a new slice with the square roots of the elements of a, computed in parallel.
+// isSquare[i] is false if a[i] is not a square, in which case res[i] is 0.
+func BatchSqrt(a []Element) (res []Element, isSquare []bool) {
+	res = make([]Element, len(a))
+	isSquare = make([]bool, len(a))
+	utils.Parallelize(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			isSquare[i] = res[i].Sqrt(&a[i]) != nil
+		}
+	})
+	return
+}
+
+var _bCbrtExponentElement *big.Int
+
+func init() {
+	_bCbrtExponentElement, _ = new(big.Int).SetString("f68ee2bfc25ddfc9e4946f0bf95240ddcb8789aa34560716c8eb5b4b81d03a3a065ed08b4ef684bb0971c71c71c3f3", 16)
+}
+
+// Cbrt z = ∛x (mod q)
+// if the cube root doesn't exist (x is not a cube mod q)
+// Cbrt leaves z unchanged and returns nil
+func (z *Element) Cbrt(x *Element) *Element {
+	// q ≡ 1 (mod 3), q-1 = 3ᵉ * s with s prime to 3
+	// using the cube root variant of Tonelli-Shanks: y is a cube root of x * b where b is
+	// a 3ᵉ-th root of unity, which is corrected by powers of g.
+	var y, b, w, t, t2 Element
+	// w = x^⌊s/3⌋
+	w.Exp(*x, _bCbrtExponentElement)
+
+	// s ≡ 1 (mod 3)
+	// y = x^((2s+1)/3) = w² * x
+	y.Square(&w).Mul(&y, x)
+
+	// b = x²ˢ = (w³ * x)² = (y * w)²
+	b.Mul(&y, &w).Square(&b)
+
+	// g = nonCubicResidue ^ s
+	var g = Element{
+		13616190144799058984,
+		9227582506135211912,
+		4426607408274926740,
+		7455198167498346307,
+		10794825842164118204,
+		335101026345095675,
+	}
+	// ω = g^(3ᵉ⁻¹), a primitive cube root of unity
+	var omega = Element{
+		3526659474838938856,
+		17562030475567847978,
+		1632777218702014455,
+		14009062335050482331,
+		3906511377122991214,
+		368068849512964448,
+	}
+	r := uint64(2)
+
+	// x is a cube iff b^(3ᵉ⁻¹) = 1
+	t = b
+	for i := uint64(0); i < r-1; i++ {
+		t2.Square(&t)
+		t.Mul(&t, &t2)
+	}
+	if t.IsZero() {
+		return z.SetZero()
+	}
+	if !t.IsOne() {
+		return nil
+	}
+	for {
+		// b is of order 3ᵐ, and β = b^(3ᵐ⁻¹) ∈ {ω, ω²} if m > 0
+		var m uint64
+		var beta Element
+		t = b
+		for !t.IsOne() {
+			beta = t
+			t2.Square(&t)
+			t.Mul(&t, &t2)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(3^(r-m-1)), with t^(3ᵐ) = ω
+		t = g
+		for i := r - m - 1; i > 0; i-- {
+			t2.Square(&t)
+			t.Mul(&t, &t2)
+		}
+		// choose d ∈ {t, t²} such that d^(3ᵐ) = β², then b * d³ is of order < 3ᵐ
+		if beta.Equal(&omega) {
+			t.Square(&t)
+		}
+		y.Mul(&y, &t)
+		t2.Square(&t)
+		t.Mul(&t, &t2)
+		b.Mul(&b, &t)
+
+		// g = g^(3^(r-m)) is of order 3ᵐ
+		for i := r - m; i > 0; i-- {
+			t2.Square(&g)
+			g.Mul(&g, &t2)
+		}
+		r = m
+	}
+}
+
 const (
 	k               = 32 // word size / 2
 	signBitSelector = uint64(1) << 63
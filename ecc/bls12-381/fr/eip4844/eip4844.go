@@ -0,0 +1,541 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eip4844 implements the KZG commitments to blobs of EIP-4844, as
+// specified in the polynomial commitments of the Deneb consensus specs:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/polynomial-commitments.md
+//
+// A blob is a polynomial given by its evaluations on the 4096-th roots of
+// unity, in bit-reversed order. Commitments and proofs are compressed G1
+// points, and field elements are encoded in big endian.
+package eip4844
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+const (
+	// ScalarsPerBlob is the number of field elements in a blob
+	ScalarsPerBlob = 4096
+
+	// SizeBlob is the size of a blob in bytes
+	SizeBlob = ScalarsPerBlob * SizeScalar
+
+	// SizeScalar is the size of a field element in bytes
+	SizeScalar = fr.Bytes
+
+	// SizeCommitment is the size of a commitment (or a proof) in bytes
+	SizeCommitment = bls12381.SizeOfG1AffineCompressed
+
+	// primitiveRootOfUnity generates the multiplicative group of fr, the
+	// domain being generated by primitiveRootOfUnity^((r-1)/ScalarsPerBlob)
+	primitiveRootOfUnity = 7
+)
+
+// domain separators of the Fiat-Shamir challenges
+const (
+	fiatShamirProtocolDomain      = "FSBLOBVERIFY_V1_"
+	randomChallengeKZGBatchDomain = "RCKZGBATCH___V1_"
+)
+
+var (
+	ErrInvalidSRSSize       = errors.New("srs must hold at least 4096 powers")
+	ErrInvalidScalar        = errors.New("scalar is not canonical")
+	ErrInvalidPoint         = errors.New("invalid compressed point encoding")
+	ErrLengthMismatch       = errors.New("blobs, commitments and proofs must have the same length")
+	ErrVerifyOpeningProof   = errors.New("can't verify opening proof")
+	ErrVerifyBlobProofBatch = errors.New("can't verify batch of blob proofs")
+)
+
+// Blob is a polynomial in evaluation form, encoded as ScalarsPerBlob big
+// endian field elements
+type Blob [SizeBlob]byte
+
+// Scalar is a big endian encoded field element
+type Scalar [SizeScalar]byte
+
+// Commitment is a compressed KZG commitment
+type Commitment [SizeCommitment]byte
+
+// Proof is a compressed KZG opening proof
+type Proof [SizeCommitment]byte
+
+// Context holds the trusted setup in the form needed by the EIP-4844
+// functions.
+type Context struct {
+	// g1Lagrange[i] = [L_{brp(i)}(τ)]G₁, where Lⱼ is the Lagrange polynomial of the
+	// j-th root of unity, and brp the bit reversal permutation
+	g1Lagrange []bls12381.G1Affine
+
+	// g2 = [G₂, [τ]G₂]
+	g2 [2]bls12381.G2Affine
+
+	// roots of unity, in bit reversed order
+	roots []fr.Element
+}
+
+// NewContext returns a context from an SRS in monomial form, as read for
+// instance from the Ethereum KZG ceremony with kzg.ReadEthereumCeremony.
+func NewContext(srs *kzg.SRS) (*Context, error) {
+	if len(srs.G1) < ScalarsPerBlob {
+		return nil, ErrInvalidSRSSize
+	}
+
+	// kzg.ToLagrangeG1 indexes the Lagrange basis with the roots of unity
+	// generated by fft.Generator, which differs from the spec's one
+	lagrange, err := kzg.ToLagrangeG1(srs.G1[:ScalarsPerBlob])
+	if err != nil {
+		return nil, err
+	}
+	omega, err := fft.Generator(ScalarsPerBlob)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[fr.Element]int, ScalarsPerBlob)
+	var w fr.Element
+	w.SetOne()
+	for i := 0; i < ScalarsPerBlob; i++ {
+		index[w] = i
+		w.Mul(&w, &omega)
+	}
+
+	roots := rootsOfUnity()
+	g1Lagrange := make([]bls12381.G1Affine, ScalarsPerBlob)
+	for i := range roots {
+		g1Lagrange[i] = lagrange[index[roots[i]]]
+	}
+	return newContext(g1Lagrange, srs.G2, roots), nil
+}
+
+// NewContextFromLagrange returns a context from the Lagrange form of the
+// trusted setup, g1Lagrange[i] = [Lᵢ(τ)]G₁ for the i-th root of unity in
+// natural order, and g2 = [G₂, [τ]G₂].
+func NewContextFromLagrange(g1Lagrange []bls12381.G1Affine, g2 [2]bls12381.G2Affine) (*Context, error) {
+	if len(g1Lagrange) != ScalarsPerBlob {
+		return nil, ErrInvalidSRSSize
+	}
+	return newContext(g1Lagrange, g2, rootsOfUnity()), nil
+}
+
+// newContext bit reverses the Lagrange basis and the roots of unity, given in
+// natural order.
+func newContext(g1Lagrange []bls12381.G1Affine, g2 [2]bls12381.G2Affine, roots []fr.Element) *Context {
+	ctx := &Context{
+		g1Lagrange: make([]bls12381.G1Affine, ScalarsPerBlob),
+		g2:         g2,
+		roots:      make([]fr.Element, ScalarsPerBlob),
+	}
+	for i := 0; i < ScalarsPerBlob; i++ {
+		ctx.g1Lagrange[i] = g1Lagrange[bitReverse(i)]
+		ctx.roots[i] = roots[bitReverse(i)]
+	}
+	return ctx
+}
+
+// rootsOfUnity returns the ScalarsPerBlob roots of unity of the spec, in
+// natural order.
+func rootsOfUnity() []fr.Element {
+	var exponent big.Int
+	exponent.Sub(fr.Modulus(), big.NewInt(1))
+	exponent.Div(&exponent, big.NewInt(ScalarsPerBlob))
+	var omega fr.Element
+	omega.SetUint64(primitiveRootOfUnity).Exp(omega, &exponent)
+
+	res := make([]fr.Element, ScalarsPerBlob)
+	res[0].SetOne()
+	for i := 1; i < ScalarsPerBlob; i++ {
+		res[i].Mul(&res[i-1], &omega)
+	}
+	return res
+}
+
+// bitReverse returns i with its log₂(ScalarsPerBlob) bits reversed
+func bitReverse(i int) int {
+	return int(bits.Reverse64(uint64(i)) >> (64 - bits.TrailingZeros64(ScalarsPerBlob)))
+}
+
+// BlobToKZGCommitment returns the commitment to the polynomial of blob.
+//
+// blob_to_kzg_commitment
+func (ctx *Context) BlobToKZGCommitment(blob *Blob) (Commitment, error) {
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return Commitment{}, err
+	}
+	c, err := ctx.commit(p)
+	if err != nil {
+		return Commitment{}, err
+	}
+	return c.Bytes(), nil
+}
+
+// ComputeKZGProof returns the proof that the polynomial of blob evaluates to
+// y at z, and y.
+//
+// compute_kzg_proof
+func (ctx *Context) ComputeKZGProof(blob *Blob, z Scalar) (Proof, Scalar, error) {
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return Proof{}, Scalar{}, err
+	}
+	var _z fr.Element
+	if err = _z.SetBytesCanonical(z[:]); err != nil {
+		return Proof{}, Scalar{}, ErrInvalidScalar
+	}
+	proof, y, err := ctx.computeProof(p, _z)
+	if err != nil {
+		return Proof{}, Scalar{}, err
+	}
+	return proof, y.Bytes(), nil
+}
+
+// ComputeBlobKZGProof returns the proof that the polynomial of blob, of
+// commitment commitment, evaluates to its value at the Fiat-Shamir challenge
+// derived from the blob and the commitment.
+//
+// compute_blob_kzg_proof
+func (ctx *Context) ComputeBlobKZGProof(blob *Blob, commitment Commitment) (Proof, error) {
+	if _, err := decodeG1(commitment); err != nil {
+		return Proof{}, err
+	}
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return Proof{}, err
+	}
+	z := computeChallenge(blob, commitment)
+	proof, _, err := ctx.computeProof(p, z)
+	return proof, err
+}
+
+// VerifyKZGProof verifies the proof that the polynomial of commitment
+// evaluates to y at z.
+//
+// verify_kzg_proof
+func (ctx *Context) VerifyKZGProof(commitment Commitment, z, y Scalar, proof Proof) error {
+	c, err := decodeG1(commitment)
+	if err != nil {
+		return err
+	}
+	pi, err := decodeG1(Commitment(proof))
+	if err != nil {
+		return err
+	}
+	var _z, _y fr.Element
+	if err = _z.SetBytesCanonical(z[:]); err != nil {
+		return ErrInvalidScalar
+	}
+	if err = _y.SetBytesCanonical(y[:]); err != nil {
+		return ErrInvalidScalar
+	}
+	return ctx.verifyProof(&c, _z, _y, &pi)
+}
+
+// VerifyBlobKZGProof verifies the proof of the evaluation of the polynomial
+// of blob at the Fiat-Shamir challenge derived from the blob and commitment.
+//
+// verify_blob_kzg_proof
+func (ctx *Context) VerifyBlobKZGProof(blob *Blob, commitment Commitment, proof Proof) error {
+	c, err := decodeG1(commitment)
+	if err != nil {
+		return err
+	}
+	pi, err := decodeG1(Commitment(proof))
+	if err != nil {
+		return err
+	}
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return err
+	}
+	z := computeChallenge(blob, commitment)
+	y := ctx.evaluate(p, z)
+	return ctx.verifyProof(&c, z, y, &pi)
+}
+
+// VerifyBlobKZGProofBatch verifies the proofs of many blobs at once, with a
+// random linear combination derived by Fiat-Shamir and a single pairing check.
+//
+// verify_blob_kzg_proof_batch
+func (ctx *Context) VerifyBlobKZGProofBatch(blobs []Blob, commitments []Commitment, proofs []Proof) error {
+	n := len(blobs)
+	if len(commitments) != n || len(proofs) != n {
+		return ErrLengthMismatch
+	}
+	if n == 0 {
+		return nil
+	}
+
+	c := make([]bls12381.G1Affine, n)
+	pi := make([]bls12381.G1Affine, n)
+	zs := make([]fr.Element, n)
+	ys := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		var err error
+		if c[i], err = decodeG1(commitments[i]); err != nil {
+			return err
+		}
+		if pi[i], err = decodeG1(Commitment(proofs[i])); err != nil {
+			return err
+		}
+		p, err := blobToPolynomial(&blobs[i])
+		if err != nil {
+			return err
+		}
+		zs[i] = computeChallenge(&blobs[i], commitments[i])
+		ys[i] = ctx.evaluate(p, zs[i])
+	}
+
+	// r = hash_to_bls_field(domain ∥ degree ∥ n ∥ (commitmentᵢ ∥ zᵢ ∥ yᵢ ∥ proofᵢ)ᵢ)
+	h := sha256.New()
+	h.Write([]byte(randomChallengeKZGBatchDomain))
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], ScalarsPerBlob)
+	h.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], uint64(n))
+	h.Write(buf[:])
+	for i := 0; i < n; i++ {
+		zBytes, yBytes := zs[i].Bytes(), ys[i].Bytes()
+		h.Write(commitments[i][:])
+		h.Write(zBytes[:])
+		h.Write(yBytes[:])
+		h.Write(proofs[i][:])
+	}
+	var r fr.Element
+	r.SetBytes(h.Sum(nil))
+
+	// rᵢ = rⁱ
+	rPowers := make([]fr.Element, n)
+	rPowers[0].SetOne()
+	for i := 1; i < n; i++ {
+		rPowers[i].Mul(&rPowers[i-1], &r)
+	}
+
+	// ∑ᵢrⁱ(Cᵢ - [yᵢ]G₁ + zᵢπᵢ) and ∑ᵢrⁱπᵢ, in a single multi-exponentiation
+	// ∑ᵢrⁱCᵢ - [∑ᵢrⁱyᵢ]G₁ + ∑ᵢrⁱzᵢπᵢ
+	_, _, g1, _ := bls12381.Generators()
+	bases := make([]bls12381.G1Affine, 0, 2*n+1)
+	scalars := make([]fr.Element, 0, 2*n+1)
+	var sumY, tmp fr.Element
+	for i := 0; i < n; i++ {
+		bases = append(bases, c[i], pi[i])
+		tmp.Mul(&rPowers[i], &zs[i])
+		scalars = append(scalars, rPowers[i], tmp)
+		tmp.Mul(&rPowers[i], &ys[i])
+		sumY.Add(&sumY, &tmp)
+	}
+	sumY.Neg(&sumY)
+	bases = append(bases, g1)
+	scalars = append(scalars, sumY)
+
+	config := ecc.MultiExpConfig{}
+	var lhs, proofLincomb bls12381.G1Affine
+	if _, err := lhs.MultiExp(bases, scalars, config); err != nil {
+		return err
+	}
+	if _, err := proofLincomb.MultiExp(pi, rPowers, config); err != nil {
+		return err
+	}
+	proofLincomb.Neg(&proofLincomb)
+
+	// e(∑ᵢrⁱπᵢ, [τ]G₂) ?= e(∑ᵢrⁱ(Cᵢ - [yᵢ]G₁ + zᵢπᵢ), G₂)
+	check, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{lhs, proofLincomb},
+		[]bls12381.G2Affine{ctx.g2[0], ctx.g2[1]},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyBlobProofBatch
+	}
+	return nil
+}
+
+// commit returns the commitment to the polynomial p in evaluation form
+func (ctx *Context) commit(p []fr.Element) (bls12381.G1Affine, error) {
+	var res bls12381.G1Affine
+	_, err := res.MultiExp(ctx.g1Lagrange, p, ecc.MultiExpConfig{})
+	return res, err
+}
+
+// computeProof returns the proof that p evaluates to y at z, and y.
+//
+// compute_kzg_proof_impl
+func (ctx *Context) computeProof(p []fr.Element, z fr.Element) (Proof, fr.Element, error) {
+	y := ctx.evaluate(p, z)
+
+	// qᵢ = (pᵢ - y)/(ωᵢ - z)
+	q := make([]fr.Element, ScalarsPerBlob)
+	denominators := make([]fr.Element, ScalarsPerBlob)
+	inDomain := -1
+	for i := range ctx.roots {
+		denominators[i].Sub(&ctx.roots[i], &z)
+		if denominators[i].IsZero() {
+			inDomain = i
+		}
+	}
+	denominators = fr.BatchInvert(denominators)
+	for i := range q {
+		if i != inDomain {
+			q[i].Sub(&p[i], &y).Mul(&q[i], &denominators[i])
+		}
+	}
+
+	// if z = ωₘ, qₘ = ∑_{i≠m}(pᵢ - y)ωᵢ/(z(z - ωᵢ)) = -∑_{i≠m}qᵢωᵢ/z
+	if inDomain != -1 {
+		var tmp, zInv fr.Element
+		for i := range q {
+			if i != inDomain {
+				tmp.Mul(&q[i], &ctx.roots[i])
+				q[inDomain].Add(&q[inDomain], &tmp)
+			}
+		}
+		zInv.Inverse(&z).Neg(&zInv)
+		q[inDomain].Mul(&q[inDomain], &zInv)
+	}
+
+	proof, err := ctx.commit(q)
+	if err != nil {
+		return Proof{}, fr.Element{}, err
+	}
+	return proof.Bytes(), y, nil
+}
+
+// verifyProof checks e(C - [y]G₁, -G₂)⋅e(π, [τ]G₂ - [z]G₂) ?= 1
+//
+// verify_kzg_proof_impl
+func (ctx *Context) verifyProof(commitment *bls12381.G1Affine, z, y fr.Element, proof *bls12381.G1Affine) error {
+	// C - [y]G₁ + [z]π, so that e(C - [y]G₁ + [z]π, G₂) ?= e(π, [τ]G₂)
+	_, _, g1, _ := bls12381.Generators()
+	var lhs bls12381.G1Affine
+	y.Neg(&y)
+	if _, err := lhs.MultiExp(
+		[]bls12381.G1Affine{*commitment, g1, *proof},
+		[]fr.Element{fr.One(), y, z},
+		ecc.MultiExpConfig{},
+	); err != nil {
+		return err
+	}
+	var negProof bls12381.G1Affine
+	negProof.Neg(proof)
+
+	check, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{lhs, negProof},
+		[]bls12381.G2Affine{ctx.g2[0], ctx.g2[1]},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// evaluate returns p(z), where p is given by its evaluations on the roots of
+// unity in bit-reversed order:
+//
+// p(z) = (zⁿ - 1)/n ∑ᵢpᵢωᵢ/(z - ωᵢ)
+//
+// evaluate_polynomial_in_evaluation_form
+func (ctx *Context) evaluate(p []fr.Element, z fr.Element) fr.Element {
+	denominators := make([]fr.Element, ScalarsPerBlob)
+	for i := range ctx.roots {
+		denominators[i].Sub(&z, &ctx.roots[i])
+		if denominators[i].IsZero() {
+			return p[i]
+		}
+	}
+	denominators = fr.BatchInvert(denominators)
+
+	var res, tmp fr.Element
+	for i := range p {
+		tmp.Mul(&p[i], &ctx.roots[i]).Mul(&tmp, &denominators[i])
+		res.Add(&res, &tmp)
+	}
+
+	var zn, nInv fr.Element
+	zn.Exp(z, big.NewInt(ScalarsPerBlob))
+	zn.Sub(&zn, nInv.SetOne())
+	nInv.SetUint64(ScalarsPerBlob).Inverse(&nInv)
+	res.Mul(&res, &zn).Mul(&res, &nInv)
+	return res
+}
+
+// blobToPolynomial decodes the canonical field elements of blob.
+//
+// blob_to_polynomial
+func blobToPolynomial(blob *Blob) ([]fr.Element, error) {
+	p := make([]fr.Element, ScalarsPerBlob)
+	for i := range p {
+		if err := p[i].SetBytesCanonical(blob[i*SizeScalar : (i+1)*SizeScalar]); err != nil {
+			return nil, ErrInvalidScalar
+		}
+	}
+	return p, nil
+}
+
+// computeChallenge returns the Fiat-Shamir challenge of the evaluation of
+// blob, of commitment commitment:
+//
+// hash_to_bls_field(domain ∥ degree ∥ blob ∥ commitment)
+//
+// compute_challenge
+func computeChallenge(blob *Blob, commitment Commitment) fr.Element {
+	h := sha256.New()
+	h.Write([]byte(fiatShamirProtocolDomain))
+	var degree [16]byte
+	binary.BigEndian.PutUint64(degree[8:], ScalarsPerBlob)
+	h.Write(degree[:])
+	h.Write(blob[:])
+	h.Write(commitment[:])
+
+	var res fr.Element
+	res.SetBytes(h.Sum(nil))
+	return res
+}
+
+// decodeG1 decodes a compressed point, and checks it is in the prime order
+// subgroup.
+//
+// validate_kzg_g1
+func decodeG1(b Commitment) (bls12381.G1Affine, error) {
+	// the compression flag must be set, the infinity flag and the sign flag
+	// may not be both set
+	const (
+		compressed         = 0b100 << 5
+		compressedLargest  = 0b101 << 5
+		compressedInfinity = 0b110 << 5
+	)
+	switch b[0] & (0b111 << 5) {
+	case compressed, compressedLargest, compressedInfinity:
+	default:
+		return bls12381.G1Affine{}, ErrInvalidPoint
+	}
+	var p bls12381.G1Affine
+	if _, err := p.SetBytes(b[:]); err != nil {
+		return bls12381.G1Affine{}, err
+	}
+	return p, nil
+}
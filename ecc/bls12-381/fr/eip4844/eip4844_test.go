@@ -0,0 +1,440 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eip4844
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+// testTau is the secret of the test SRS
+var testTau = big.NewInt(42)
+
+var (
+	testCtx     *Context
+	testCtxOnce sync.Once
+)
+
+// testContext returns a context built from an insecure SRS with a known
+// secret τ.
+func testContext(t testing.TB) *Context {
+	testCtxOnce.Do(func() {
+		srs, err := kzg.NewSRS(ScalarsPerBlob, testTau)
+		if err != nil {
+			panic(err)
+		}
+		if testCtx, err = NewContext(srs); err != nil {
+			panic(err)
+		}
+	})
+	return testCtx
+}
+
+func randomBlob(t testing.TB) *Blob {
+	var blob Blob
+	var e fr.Element
+	for i := 0; i < ScalarsPerBlob; i++ {
+		_, err := e.SetRandom()
+		require.NoError(t, err)
+		b := e.Bytes()
+		copy(blob[i*SizeScalar:], b[:])
+	}
+	return &blob
+}
+
+func randomScalar(t testing.TB) Scalar {
+	var e fr.Element
+	_, err := e.SetRandom()
+	require.NoError(t, err)
+	return e.Bytes()
+}
+
+func TestBlobToKZGCommitment(t *testing.T) {
+	assert := require.New(t)
+	ctx := testContext(t)
+
+	// the commitment must be [p(τ)]G₁
+	blob := randomBlob(t)
+	commitment, err := ctx.BlobToKZGCommitment(blob)
+	assert.NoError(err)
+
+	p, err := blobToPolynomial(blob)
+	assert.NoError(err)
+	var tau fr.Element
+	tau.SetBigInt(testTau)
+	pTau := ctx.evaluate(p, tau)
+
+	_, _, g1, _ := bls12381.Generators()
+	var expected bls12381.G1Affine
+	var pTauBigInt big.Int
+	expected.ScalarMultiplication(&g1, pTau.BigInt(&pTauBigInt))
+	assert.Equal(Commitment(expected.Bytes()), commitment)
+
+	// the zero blob commits to the point at infinity
+	commitment, err = ctx.BlobToKZGCommitment(&Blob{})
+	assert.NoError(err)
+	var infinity Commitment
+	infinity[0] = 0xc0
+	assert.Equal(infinity, commitment)
+}
+
+func TestKZGProof(t *testing.T) {
+	assert := require.New(t)
+	ctx := testContext(t)
+
+	blob := randomBlob(t)
+	commitment, err := ctx.BlobToKZGCommitment(blob)
+	assert.NoError(err)
+
+	// random point
+	z := randomScalar(t)
+	proof, y, err := ctx.ComputeKZGProof(blob, z)
+	assert.NoError(err)
+	assert.NoError(ctx.VerifyKZGProof(commitment, z, y, proof))
+
+	// wrong claimed value
+	wrongY := randomScalar(t)
+	assert.ErrorIs(ctx.VerifyKZGProof(commitment, z, wrongY, proof), ErrVerifyOpeningProof)
+
+	// point of the domain, the evaluation is the corresponding scalar of the blob
+	for _, i := range []int{0, 1, 5, ScalarsPerBlob - 1} {
+		z = ctx.roots[i].Bytes()
+		proof, y, err = ctx.ComputeKZGProof(blob, z)
+		assert.NoError(err)
+		assert.Equal(blob[i*SizeScalar:(i+1)*SizeScalar], y[:])
+		assert.NoError(ctx.VerifyKZGProof(commitment, z, y, proof))
+	}
+
+	// the zero polynomial opens to zero with the proof at infinity
+	var infinity Commitment
+	infinity[0] = 0xc0
+	assert.NoError(ctx.VerifyKZGProof(infinity, z, Scalar{}, Proof(infinity)))
+}
+
+func TestBlobKZGProof(t *testing.T) {
+	assert := require.New(t)
+	ctx := testContext(t)
+
+	const nbBlobs = 4
+	blobs := make([]Blob, nbBlobs)
+	commitments := make([]Commitment, nbBlobs)
+	proofs := make([]Proof, nbBlobs)
+	for i := range blobs {
+		var err error
+		blobs[i] = *randomBlob(t)
+		commitments[i], err = ctx.BlobToKZGCommitment(&blobs[i])
+		assert.NoError(err)
+		proofs[i], err = ctx.ComputeBlobKZGProof(&blobs[i], commitments[i])
+		assert.NoError(err)
+		assert.NoError(ctx.VerifyBlobKZGProof(&blobs[i], commitments[i], proofs[i]))
+	}
+	assert.NoError(ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+	assert.NoError(ctx.VerifyBlobKZGProofBatch(nil, nil, nil))
+
+	// swapped proofs
+	assert.ErrorIs(ctx.VerifyBlobKZGProof(&blobs[0], commitments[0], proofs[1]), ErrVerifyOpeningProof)
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	assert.ErrorIs(ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs), ErrVerifyBlobProofBatch)
+
+	assert.ErrorIs(ctx.VerifyBlobKZGProofBatch(blobs, commitments[1:], proofs), ErrLengthMismatch)
+}
+
+func TestInvalidInputs(t *testing.T) {
+	assert := require.New(t)
+	ctx := testContext(t)
+
+	// non canonical scalar
+	var blob Blob
+	modulus := fr.Modulus().Bytes()
+	copy(blob[SizeScalar:], modulus)
+	_, err := ctx.BlobToKZGCommitment(&blob)
+	assert.ErrorIs(err, ErrInvalidScalar)
+
+	var z Scalar
+	copy(z[:], modulus)
+	_, _, err = ctx.ComputeKZGProof(&Blob{}, z)
+	assert.ErrorIs(err, ErrInvalidScalar)
+
+	// infinity with the sign flag set
+	var commitment Commitment
+	commitment[0] = 0xe0
+	_, err = ctx.ComputeBlobKZGProof(&Blob{}, commitment)
+	assert.ErrorIs(err, ErrInvalidPoint)
+
+	// uncompressed flag
+	assert.NoError(ctx.VerifyBlobKZGProof(&Blob{}, Commitment{0xc0}, Proof{0xc0}))
+	_, _, g1, _ := bls12381.Generators()
+	commitment = g1.Bytes()
+	commitment[0] &^= 0x80
+	assert.ErrorIs(ctx.VerifyBlobKZGProof(&Blob{}, commitment, Proof{0xc0}), ErrInvalidPoint)
+}
+
+func TestReadTrustedSetup(t *testing.T) {
+	assert := require.New(t)
+	ctx := testContext(t)
+
+	// write the Lagrange form of the test SRS in natural order
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d\n%d\n", ScalarsPerBlob, 2)
+	for i := 0; i < ScalarsPerBlob; i++ {
+		b := ctx.g1Lagrange[bitReverse(i)].Bytes()
+		fmt.Fprintln(&buf, hex.EncodeToString(b[:]))
+	}
+	for i := range ctx.g2 {
+		b := ctx.g2[i].Bytes()
+		fmt.Fprintln(&buf, hex.EncodeToString(b[:]))
+	}
+
+	read, err := ReadTrustedSetup(&buf)
+	assert.NoError(err)
+	assert.Equal(ctx, read)
+
+	_, err = ReadTrustedSetup(strings.NewReader("4096\n65\n"))
+	assert.ErrorIs(err, ErrInvalidTrustedSetup)
+	_, err = ReadTrustedSetup(strings.NewReader("4095\n65\n"))
+	assert.ErrorIs(err, ErrInvalidTrustedSetup)
+}
+
+// TestConsensusSpecVectors runs the KZG test vectors of the consensus specs
+// (tests/general/deneb/kzg of https://github.com/ethereum/consensus-spec-tests)
+// against the mainnet trusted setup. The vectors are stored in
+// testdata/kzg_vectors.json, converted from the data.yaml files of each case,
+// the blobs being listed once and referenced by their index.
+func TestConsensusSpecVectors(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "trusted_setup.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+	ctx, err := ReadTrustedSetup(f)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join("testdata", "kzg_vectors.json"))
+	require.NoError(t, err)
+	type testCase struct {
+		Name  string `json:"name"`
+		Input struct {
+			Blob        *int     `json:"blob"`
+			Blobs       []int    `json:"blobs"`
+			Commitment  string   `json:"commitment"`
+			Commitments []string `json:"commitments"`
+			Proof       string   `json:"proof"`
+			Proofs      []string `json:"proofs"`
+			Z           string   `json:"z"`
+			Y           string   `json:"y"`
+		} `json:"input"`
+		Output json.RawMessage `json:"output"`
+	}
+	var vectors struct {
+		Blobs                   []string   `json:"blobs"`
+		BlobToKZGCommitment     []testCase `json:"blob_to_kzg_commitment"`
+		ComputeKZGProof         []testCase `json:"compute_kzg_proof"`
+		ComputeBlobKZGProof     []testCase `json:"compute_blob_kzg_proof"`
+		VerifyKZGProof          []testCase `json:"verify_kzg_proof"`
+		VerifyBlobKZGProof      []testCase `json:"verify_blob_kzg_proof"`
+		VerifyBlobKZGProofBatch []testCase `json:"verify_blob_kzg_proof_batch"`
+	}
+	require.NoError(t, json.Unmarshal(data, &vectors))
+
+	blob := func(i int) (*Blob, error) {
+		var blob Blob
+		return &blob, decodeHex(vectors.Blobs[i], blob[:])
+	}
+
+	// run checks the output of f against the expected one, a null output
+	// meaning that the inputs are invalid
+	run := func(handler string, cases []testCase, f func(tc *testCase) (interface{}, error)) {
+		require.NotEmpty(t, cases, handler)
+		for i := range cases {
+			tc := &cases[i]
+			t.Run(handler+"/"+tc.Name, func(t *testing.T) {
+				assert := require.New(t)
+				output, err := f(tc)
+				if string(tc.Output) == "null" {
+					assert.Error(err)
+					return
+				}
+				assert.NoError(err)
+				b, err := json.Marshal(output)
+				assert.NoError(err)
+				assert.JSONEq(string(tc.Output), string(b))
+			})
+		}
+	}
+
+	run("blob_to_kzg_commitment", vectors.BlobToKZGCommitment, func(tc *testCase) (interface{}, error) {
+		blob, err := blob(*tc.Input.Blob)
+		if err != nil {
+			return nil, err
+		}
+		c, err := ctx.BlobToKZGCommitment(blob)
+		return encodeHex(c[:]), err
+	})
+	run("compute_kzg_proof", vectors.ComputeKZGProof, func(tc *testCase) (interface{}, error) {
+		blob, err := blob(*tc.Input.Blob)
+		if err != nil {
+			return nil, err
+		}
+		var z Scalar
+		if err := decodeHex(tc.Input.Z, z[:]); err != nil {
+			return nil, err
+		}
+		proof, y, err := ctx.ComputeKZGProof(blob, z)
+		return []string{encodeHex(proof[:]), encodeHex(y[:])}, err
+	})
+	run("compute_blob_kzg_proof", vectors.ComputeBlobKZGProof, func(tc *testCase) (interface{}, error) {
+		blob, err := blob(*tc.Input.Blob)
+		if err != nil {
+			return nil, err
+		}
+		var commitment Commitment
+		if err := decodeHex(tc.Input.Commitment, commitment[:]); err != nil {
+			return nil, err
+		}
+		proof, err := ctx.ComputeBlobKZGProof(blob, commitment)
+		return encodeHex(proof[:]), err
+	})
+	run("verify_kzg_proof", vectors.VerifyKZGProof, func(tc *testCase) (interface{}, error) {
+		var commitment Commitment
+		var proof Proof
+		var z, y Scalar
+		if err := decodeHex(tc.Input.Commitment, commitment[:]); err != nil {
+			return nil, err
+		}
+		if err := decodeHex(tc.Input.Proof, proof[:]); err != nil {
+			return nil, err
+		}
+		if err := decodeHex(tc.Input.Z, z[:]); err != nil {
+			return nil, err
+		}
+		if err := decodeHex(tc.Input.Y, y[:]); err != nil {
+			return nil, err
+		}
+		return verificationResult(ctx.VerifyKZGProof(commitment, z, y, proof))
+	})
+	run("verify_blob_kzg_proof", vectors.VerifyBlobKZGProof, func(tc *testCase) (interface{}, error) {
+		blob, err := blob(*tc.Input.Blob)
+		if err != nil {
+			return nil, err
+		}
+		var commitment Commitment
+		var proof Proof
+		if err := decodeHex(tc.Input.Commitment, commitment[:]); err != nil {
+			return nil, err
+		}
+		if err := decodeHex(tc.Input.Proof, proof[:]); err != nil {
+			return nil, err
+		}
+		return verificationResult(ctx.VerifyBlobKZGProof(blob, commitment, proof))
+	})
+	run("verify_blob_kzg_proof_batch", vectors.VerifyBlobKZGProofBatch, func(tc *testCase) (interface{}, error) {
+		blobs := make([]Blob, len(tc.Input.Blobs))
+		commitments := make([]Commitment, len(tc.Input.Commitments))
+		proofs := make([]Proof, len(tc.Input.Proofs))
+		for i, b := range tc.Input.Blobs {
+			if err := decodeHex(vectors.Blobs[b], blobs[i][:]); err != nil {
+				return nil, err
+			}
+		}
+		for i, c := range tc.Input.Commitments {
+			if err := decodeHex(c, commitments[i][:]); err != nil {
+				return nil, err
+			}
+		}
+		for i, p := range tc.Input.Proofs {
+			if err := decodeHex(p, proofs[i][:]); err != nil {
+				return nil, err
+			}
+		}
+		return verificationResult(ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+	})
+}
+
+// verificationResult maps a failed verification to false, and other errors
+// to invalid inputs
+func verificationResult(err error) (interface{}, error) {
+	if errors.Is(err, ErrVerifyOpeningProof) || errors.Is(err, ErrVerifyBlobProofBatch) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// decodeHex decodes a 0x prefixed hex string of exactly len(dst) bytes
+func decodeHex(s string, dst []byte) error {
+	if !strings.HasPrefix(s, "0x") {
+		return errors.New("invalid hex string")
+	}
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return err
+	}
+	if len(b) != len(dst) {
+		return errors.New("invalid length")
+	}
+	copy(dst, b)
+	return nil
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func BenchmarkBlobToKZGCommitment(b *testing.B) {
+	ctx := testContext(b)
+	blob := randomBlob(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.BlobToKZGCommitment(blob)
+	}
+}
+
+func BenchmarkComputeBlobKZGProof(b *testing.B) {
+	ctx := testContext(b)
+	blob := randomBlob(b)
+	commitment, _ := ctx.BlobToKZGCommitment(blob)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.ComputeBlobKZGProof(blob, commitment)
+	}
+}
+
+func BenchmarkVerifyBlobKZGProofBatch(b *testing.B) {
+	ctx := testContext(b)
+	const nbBlobs = 16
+	blobs := make([]Blob, nbBlobs)
+	commitments := make([]Commitment, nbBlobs)
+	proofs := make([]Proof, nbBlobs)
+	for i := range blobs {
+		blobs[i] = *randomBlob(b)
+		commitments[i], _ = ctx.BlobToKZGCommitment(&blobs[i])
+		proofs[i], _ = ctx.ComputeBlobKZGProof(&blobs[i], commitments[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eip4844
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+// ErrInvalidTrustedSetup is returned when the trusted setup file is malformed
+var ErrInvalidTrustedSetup = errors.New("invalid trusted setup")
+
+// ReadTrustedSetup reads the trusted setup in the text format of the
+// reference implementation (trusted_setup.txt of c-kzg-4844): the number of
+// G1 points (4096), the number of G2 points (65), then the hex encoded
+// compressed points, one per line, the G1 points being in Lagrange form over
+// the roots of unity in natural order. Only the first two G2 points are used.
+func ReadTrustedSetup(r io.Reader) (*Context, error) {
+	scanner := bufio.NewScanner(r)
+	next := func() (string, error) {
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				return line, nil
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("%w: unexpected end of file", ErrInvalidTrustedSetup)
+	}
+
+	var sizes [2]int
+	for i := range sizes {
+		line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if sizes[i], err = strconv.Atoi(line); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+		}
+	}
+	if sizes[0] != ScalarsPerBlob || sizes[1] < 2 {
+		return nil, fmt.Errorf("%w: %d G1 points and %d G2 points", ErrInvalidTrustedSetup, sizes[0], sizes[1])
+	}
+
+	g1Lagrange := make([]bls12381.G1Affine, sizes[0])
+	for i := range g1Lagrange {
+		line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+		}
+		if _, err = g1Lagrange[i].SetBytes(b); err != nil {
+			return nil, err
+		}
+	}
+
+	var g2 [2]bls12381.G2Affine
+	for i := range g2 {
+		line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+		}
+		if _, err = g2[i].SetBytes(b); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewContextFromLagrange(g1Lagrange, g2)
+}
@@ -203,6 +203,29 @@ func BenchmarkElementSqrt(b *testing.B) {
 	}
 }
 
+func BenchmarkElementBatchSqrt(b *testing.B) {
+	const n = 1 << 10
+	a := make([]Element, n)
+	for i := range a {
+		a[i].SetRandom()
+		a[i].Square(&a[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchSqrt(a)
+	}
+}
+
+func BenchmarkElementCbrt(b *testing.B) {
+	var a Element
+	a.SetUint64(8)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Cbrt(&a)
+	}
+}
+
 func BenchmarkElementMul(b *testing.B) {
 	x := Element{
 		14526898881837571181,
@@ -615,6 +638,103 @@ func TestElementLegendre(t *testing.T) {
 
 }
 
+func TestElementBatchSqrt(t *testing.T) {
+	t.Parallel()
+
+	a := make([]Element, 0, len(staticTestValues)+64)
+	a = append(a, staticTestValues...)
+	for i := 0; i < 64; i++ {
+		var e Element
+		e.SetRandom()
+		if i%2 == 0 {
+			e.Square(&e)
+		}
+		a = append(a, e)
+	}
+
+	res, isSquare := BatchSqrt(a)
+	for i := range a {
+		var expected Element
+		if (expected.Sqrt(&a[i]) != nil) != isSquare[i] {
+			t.Fatal("BatchSqrt and Sqrt disagree on quadratic residuosity")
+		}
+		if !res[i].Equal(&expected) {
+			t.Fatal("BatchSqrt and Sqrt results don't match")
+		}
+	}
+}
+
+func TestElementCbrt(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	// x is a cube iff x = 0 or x^((q-1)/gcd(3, q-1)) = 1
+	isCube := func(x *big.Int) bool {
+		var e big.Int
+		e.Sub(Modulus(), big.NewInt(1))
+		e.Div(&e, big.NewInt(3))
+		return x.Sign() == 0 || e.Exp(x, &e, Modulus()).Cmp(big.NewInt(1)) == 0
+	}
+
+	properties.Property("Cbrt: z³ must be equal to x when x is a cube, Cbrt must return nil otherwise", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d Element
+			if c.Cbrt(&a.element) == nil {
+				return !isCube(&a.bigint)
+			}
+			d.Square(&c).Mul(&d, &c)
+			return isCube(&a.bigint) && d.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("Cbrt: must find a cube root of x³", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d, e Element
+			d.Square(&a.element).Mul(&d, &a.element)
+			if c.Cbrt(&d) == nil {
+				return false
+			}
+			e.Square(&c).Mul(&e, &c)
+			return e.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("Cbrt: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPairElement) bool {
+			b := a.element
+			b.Cbrt(&a.element)
+			a.element.Cbrt(&a.element)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, a := range staticTestValues {
+		var c, d, e Element
+		d.Square(&a).Mul(&d, &a)
+		if c.Cbrt(&d) == nil {
+			t.Fatal("Cbrt failed special test values")
+		}
+		e.Square(&c).Mul(&e, &c)
+		if !e.Equal(&d) {
+			t.Fatal("Cbrt failed special test values")
+		}
+	}
+}
+
 func TestElementBitLen(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -2871,3 +2991,116 @@ func approximateRef(x *Element) uint64 {
 	hi.Add(&hi, &lo)
 	return hi.Uint64()
 }
+
+// ctOpsElement are the constant-time operations and their variable-time counterparts
+var ctOpsElement = []struct {
+	name    string
+	ct, ref func(z, x, y *Element) *Element
+}{
+	{"AddCT", (*Element).AddCT, (*Element).Add},
+	{"SubCT", (*Element).SubCT, (*Element).Sub},
+	{"MulCT", (*Element).MulCT, (*Element).Mul},
+}
+
+func TestElementInverseCT(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var z, expected Element
+	z.InverseCT(&Element{})
+	assert.True(z.IsZero(), "0⁻¹ should be 0")
+
+	values := append([]Element{}, staticTestValues...)
+	for i := 0; i < 10; i++ {
+		var r Element
+		r.SetRandom()
+		values = append(values, r)
+	}
+	for _, x := range values {
+		z.InverseCT(&x)
+		expected.Inverse(&x)
+		assert.True(z.Equal(&expected), "InverseCT and Inverse should match")
+	}
+}
+
+func TestElementExpCT(t *testing.T) {
+	t.Parallel()
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("ExpCT(x, k) == Exp(x, k)", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.ExpCT(a.element, &b.bigint)
+			d.Exp(a.element, &b.bigint)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("ExpCT(x, -k) == Exp(x, -k)", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var nb big.Int
+			nb.Neg(&b.bigint)
+			var c, d Element
+			c.ExpCT(a.element, &nb)
+			d.Exp(a.element, &nb)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("AddCT, SubCT and MulCT match Add, Sub and Mul", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			for _, op := range ctOpsElement {
+				op.ct(&c, &a.element, &b.element)
+				op.ref(&d, &a.element, &b.element)
+				if !c.Equal(&d) {
+					return false
+				}
+			}
+			return true
+		},
+		genA, genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	assert := require.New(t)
+	for _, x := range staticTestValues {
+		for _, y := range staticTestValues {
+			for _, op := range ctOpsElement {
+				var c, d Element
+				op.ct(&c, &x, &y)
+				op.ref(&d, &x, &y)
+				assert.True(c.Equal(&d), op.name+" should match its variable-time counterpart")
+			}
+		}
+	}
+	var c, d Element
+	c.ExpCT(One(), big.NewInt(0))
+	assert.True(c.IsOne(), "x⁰ should be 1")
+
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 8*Bytes)
+	assert.Panics(func() { c.ExpCT(d, tooLarge) }, "ExpCT should panic on a too large exponent")
+}
+
+func BenchmarkElementInverseCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseCT(&x)
+	}
+}
@@ -71,7 +71,6 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(7)
 
 	if len(shift) != 0 {
@@ -102,7 +101,6 @@ func Generator(m uint64) (fr.Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity fr.Element
-
 	rootOfUnity.SetString("10238227357739495823651030575849232062558860180284477541189508159991286009131")
 	const maxOrderRoot uint64 = 32
 
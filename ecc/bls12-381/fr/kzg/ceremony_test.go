@@ -0,0 +1,274 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+)
+
+// g2NotInSubGroup returns a random point of the twist which is not in G2
+func g2NotInSubGroup(t *testing.T) bls12381.G2Affine {
+	_, _, _, g2 := bls12381.Generators()
+
+	// b = y² - x³ on the generator
+	b, x3 := g2.Y, g2.X
+	b.Square(&b)
+	x3.Square(&x3).Mul(&x3, &g2.X)
+	b.Sub(&b, &x3)
+
+	var p bls12381.G2Affine
+	for {
+		if _, err := p.X.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		rhs := p.X
+		rhs.Square(&rhs).Mul(&rhs, &p.X).Add(&rhs, &b)
+		if rhs.Legendre() == 1 {
+			p.Y.Sqrt(&rhs)
+			break
+		}
+	}
+	if !p.IsOnCurve() || p.IsInSubGroup() {
+		t.Fatal("expected a point of the twist out of G2")
+	}
+	return p
+}
+
+// writePtau writes srs in the snarkjs .ptau format, as a transcript of
+// 2ᵖᵒʷᵉʳ powers.
+func writePtau(t *testing.T, srs *SRS, power uint32) []byte {
+	var buf bytes.Buffer
+	write := func(v interface{}) {
+		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFp := func(e *fp.Element) {
+		// little endian Montgomery form
+		for i := range e {
+			write(e[i])
+		}
+	}
+
+	buf.WriteString("ptau")
+	write(uint32(1)) // version
+	write(uint32(3)) // number of sections
+
+	// header
+	q := fp.Modulus().Bytes()
+	qLE := make([]byte, fp.Bytes)
+	for i := range q {
+		qLE[i] = q[len(q)-1-i]
+	}
+	write(uint32(ptauSectionHeader))
+	write(uint64(4 + fp.Bytes + 4 + 4))
+	write(uint32(fp.Bytes))
+	buf.Write(qLE)
+	write(power)
+	write(power) // ceremony power
+
+	// tauG1
+	write(uint32(ptauSectionTauG1))
+	write(uint64(len(srs.G1) * 2 * fp.Bytes))
+	for i := range srs.G1 {
+		writeFp(&srs.G1[i].X)
+		writeFp(&srs.G1[i].Y)
+	}
+
+	// tauG2
+	write(uint32(ptauSectionTauG2))
+	write(uint64(len(srs.G2) * 4 * fp.Bytes))
+	for i := range srs.G2 {
+		writeFp(&srs.G2[i].X.A0)
+		writeFp(&srs.G2[i].X.A1)
+		writeFp(&srs.G2[i].Y.A0)
+		writeFp(&srs.G2[i].Y.A1)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReadPtau(t *testing.T) {
+	const power = 4
+	srs, err := NewSRS(2<<power-1, new(big.Int).SetInt64(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ptau := writePtau(t, srs, power)
+
+	// the first 2ᵖᵒʷᵉʳ powers are read by default
+	res, err := ReadPtau(bytes.NewReader(ptau), WithPowersCheck())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srs.G1 = srs.G1[:1<<power]
+	if !reflect.DeepEqual(srs, res) {
+		t.Fatal("srs read from the ptau file differs")
+	}
+
+	// truncation
+	res, err = ReadPtau(bytes.NewReader(ptau), WithMaxSize(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(srs.G1[:5], res.G1) {
+		t.Fatal("truncated srs differs")
+	}
+
+	if _, err = ReadPtau(bytes.NewReader(ptau), WithMaxSize(2<<power)); err != ErrTranscriptSize {
+		t.Fatal("should reject truncation to more powers than the transcript holds")
+	}
+	if _, err = ReadPtau(bytes.NewReader(ptau[1:])); err == nil {
+		t.Fatal("should reject wrong magic string")
+	}
+
+	// the header is not trusted: its power is bounded, and the tauG1 section
+	// must hold the powers it announces
+	offset := 4 + 4 + 4 + 4 + 8 + 4 + fp.Bytes
+	for _, p := range []uint32{64, ptauMaxPower + 1} {
+		invalid := append([]byte{}, ptau...)
+		binary.LittleEndian.PutUint32(invalid[offset:], p)
+		if _, err = ReadPtau(bytes.NewReader(invalid)); !errors.Is(err, ErrInvalidPtau) {
+			t.Fatalf("should reject power %d", p)
+		}
+	}
+	invalid := append([]byte{}, ptau...)
+	binary.LittleEndian.PutUint32(invalid[offset:], power+1)
+	if _, err = ReadPtau(bytes.NewReader(invalid)); !errors.Is(err, ErrInvalidPtau) {
+		t.Fatal("should reject a tauG1 section smaller than announced")
+	}
+
+	// the points are checked to be in the subgroups, unless disabled
+	srs.G2[1] = g2NotInSubGroup(t)
+	ptau = writePtau(t, srs, power)
+	if _, err = ReadPtau(bytes.NewReader(ptau)); !errors.Is(err, ErrInvalidPtau) {
+		t.Fatal("should reject a point out of G2")
+	}
+	if _, err = ReadPtau(bytes.NewReader(ptau), NoSubgroupChecks()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifyPowers(t *testing.T) {
+	srs, err := NewSRS(16, new(big.Int).SetInt64(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = srs.VerifyPowers(); err != nil {
+		t.Fatal(err)
+	}
+
+	// swap two powers
+	srs.G1[3], srs.G1[4] = srs.G1[4], srs.G1[3]
+	if err = srs.VerifyPowers(); err != ErrInvalidSRS {
+		t.Fatal("should reject srs with powers out of order")
+	}
+
+	// α = 0
+	for i := 1; i < len(srs.G1); i++ {
+		srs.G1[i].X.SetZero()
+		srs.G1[i].Y.SetZero()
+	}
+	srs.G2[1].X.SetZero()
+	srs.G2[1].Y.SetZero()
+	if err = srs.VerifyPowers(); err != ErrInvalidSRS {
+		t.Fatal("should reject srs with all the powers at infinity")
+	}
+}
+
+func TestReadEthereumCeremony(t *testing.T) {
+	var ceremony ethereumCeremony
+	var srs []*SRS
+	for _, size := range []uint64{8, 16} {
+		s, err := NewSRS(size, new(big.Int).SetUint64(size))
+		if err != nil {
+			t.Fatal(err)
+		}
+		srs = append(srs, s)
+
+		var transcript ethereumTranscript
+		transcript.NumG1Powers = size
+		transcript.NumG2Powers = 2
+		for i := range s.G1 {
+			b := s.G1[i].Bytes()
+			transcript.PowersOfTau.G1Powers = append(transcript.PowersOfTau.G1Powers, "0x"+hex.EncodeToString(b[:]))
+		}
+		for i := range s.G2 {
+			b := s.G2[i].Bytes()
+			transcript.PowersOfTau.G2Powers = append(transcript.PowersOfTau.G2Powers, "0x"+hex.EncodeToString(b[:]))
+		}
+		ceremony.Transcripts = append(ceremony.Transcripts, transcript)
+	}
+	transcript, err := json.Marshal(&ceremony)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the largest sub-ceremony is read by default
+	res, err := ReadEthereumCeremony(bytes.NewReader(transcript), WithPowersCheck())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(srs[1], res) {
+		t.Fatal("srs read from the transcript differs")
+	}
+
+	// the smallest sub-ceremony large enough is selected, and truncated
+	res, err = ReadEthereumCeremony(bytes.NewReader(transcript), WithMaxSize(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srs[0].G1 = srs[0].G1[:5]
+	if !reflect.DeepEqual(srs[0], res) {
+		t.Fatal("truncated srs differs")
+	}
+
+	if _, err = ReadEthereumCeremony(bytes.NewReader(transcript), WithMaxSize(17)); err != ErrTranscriptSize {
+		t.Fatal("should reject truncation to more powers than the transcript holds")
+	}
+
+	// the points are checked to be in the subgroups, unless disabled
+	p := g2NotInSubGroup(t)
+	b := p.Bytes()
+	ceremony.Transcripts[1].PowersOfTau.G2Powers[1] = "0x" + hex.EncodeToString(b[:])
+	if transcript, err = json.Marshal(&ceremony); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ReadEthereumCeremony(bytes.NewReader(transcript)); err == nil {
+		t.Fatal("should reject a point out of G2")
+	}
+	if _, err = ReadEthereumCeremony(bytes.NewReader(transcript), NoSubgroupChecks()); err != nil {
+		t.Fatal(err)
+	}
+	ceremony.Transcripts[1].PowersOfTau.G2Powers[1] += "00"
+	if transcript, err = json.Marshal(&ceremony); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ReadEthereumCeremony(bytes.NewReader(transcript), NoSubgroupChecks()); err == nil {
+		t.Fatal("should reject trailing bytes")
+	}
+}
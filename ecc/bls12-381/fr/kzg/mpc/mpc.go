@@ -0,0 +1,203 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mpc
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrNoContribution      = errors.New("no contribution to verify")
+	ErrSRSSize             = errors.New("contributions must keep the size of the srs")
+	ErrInvalidContribution = errors.New("invalid contribution")
+)
+
+// dst of the hash to G2 of the proofs of knowledge
+var dstProofOfKnowledge = []byte("KZG_POWERS_OF_TAU_POK_")
+
+// UpdateProof is a proof of knowledge of the secret s of a contribution
+type UpdateProof struct {
+	Commitment bls12381.G1Affine // [s]G₁
+	Pok        bls12381.G2Affine // [s]R where R = hash_to_G2([s]G₁ ∥ [τ]G₁ ∥ [sτ]G₁)
+}
+
+// Contribution is the SRS after an update, together with the proof of the update
+//
+// implements io.ReaderFrom and io.WriterTo
+type Contribution struct {
+	SRS   kzg.SRS
+	Proof UpdateProof
+}
+
+// Initialize returns the SRS of size powers of τ = 1, from which the first
+// participant starts.
+func Initialize(size uint64) (*kzg.SRS, error) {
+	if size < 2 {
+		return nil, kzg.ErrMinSRSSize
+	}
+	_, _, gen1Aff, gen2Aff := bls12381.Generators()
+
+	var srs kzg.SRS
+	srs.G1 = make([]bls12381.G1Affine, size)
+	for i := range srs.G1 {
+		srs.G1[i] = gen1Aff
+	}
+	srs.G2[0] = gen2Aff
+	srs.G2[1] = gen2Aff
+	return &srs, nil
+}
+
+// Contribute updates srs with a fresh random secret s, and returns the updated
+// SRS [(sτ)ⁱ]G₁, [sτ]G₂ together with a proof of knowledge of s. srs is not
+// modified, and s is erased once the contribution is computed.
+func Contribute(srs *kzg.SRS) (*Contribution, error) {
+	var s fr.Element
+	for s.IsZero() {
+		if _, err := s.SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	c := contribute(srs, &s)
+	s.SetZero()
+	return c, nil
+}
+
+// contribute updates srs with the secret s.
+func contribute(srs *kzg.SRS, s *fr.Element) *Contribution {
+	var res Contribution
+	res.SRS.G1 = make([]bls12381.G1Affine, len(srs.G1))
+
+	// [(sτ)ⁱ]G₁ = sⁱ[τⁱ]G₁
+	parallel.Execute(len(srs.G1), func(start, end int) {
+		var sPower fr.Element
+		var sPowerBigInt big.Int
+		sPower.Exp(*s, big.NewInt(int64(start)))
+		for i := start; i < end; i++ {
+			sPower.BigInt(&sPowerBigInt)
+			res.SRS.G1[i].ScalarMultiplication(&srs.G1[i], &sPowerBigInt)
+			sPower.Mul(&sPower, s)
+		}
+	})
+
+	var sBigInt big.Int
+	s.BigInt(&sBigInt)
+	res.SRS.G2[0] = srs.G2[0]
+	res.SRS.G2[1].ScalarMultiplication(&srs.G2[1], &sBigInt)
+
+	// proof of knowledge of s
+	_, _, gen1Aff, _ := bls12381.Generators()
+	res.Proof.Commitment.ScalarMultiplication(&gen1Aff, &sBigInt)
+	r := challenge(&res.Proof.Commitment, &srs.G1[1], &res.SRS.G1[1])
+	res.Proof.Pok.ScalarMultiplication(&r, &sBigInt)
+	sBigInt.SetUint64(0)
+
+	return &res
+}
+
+// Verify verifies the chain of contributions from the initial SRS: each
+// contribution must prove the knowledge of its secret, and the resulting SRS
+// must be made of consecutive powers of the same secret.
+//
+// For each contribution j with secret sⱼ, commitment Cⱼ = [sⱼ]G₁ and proof of
+// knowledge πⱼ = [sⱼ]Rⱼ, it checks that e(Cⱼ, Rⱼ) = e(G₁, πⱼ) and that τⱼ = sⱼτⱼ₋₁, that is
+// e([τⱼ]G₁, Rⱼ) = e([τⱼ₋₁]G₁, πⱼ). These checks are folded with random coefficients
+// λⱼ, μⱼ into the single pairing check
+//
+// ∏ⱼ e(λⱼCⱼ + μⱼ[τⱼ]G₁, Rⱼ)⋅e(-λⱼG₁ - μⱼ[τⱼ₋₁]G₁, πⱼ) ?= 1
+//
+// Only the first powers [τⱼ]G₁ of the intermediate SRS are used, the last one
+// being fully checked with kzg.SRS.VerifyPowers.
+func Verify(initial *kzg.SRS, contributions ...*Contribution) error {
+	m := len(contributions)
+	if m == 0 {
+		return ErrNoContribution
+	}
+	if len(initial.G1) < 2 {
+		return kzg.ErrMinSRSSize
+	}
+	for _, c := range contributions {
+		if len(c.SRS.G1) != len(initial.G1) {
+			return ErrSRSSize
+		}
+		if c.Proof.Commitment.IsInfinity() || !c.Proof.Commitment.IsInSubGroup() || !c.Proof.Pok.IsInSubGroup() || !c.SRS.G1[1].IsInSubGroup() {
+			return ErrInvalidContribution
+		}
+	}
+
+	coeffs := make([]fr.Element, 2*m)
+	for i := range coeffs {
+		if _, err := coeffs[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+
+	_, _, gen1Aff, _ := bls12381.Generators()
+	P := make([]bls12381.G1Affine, 2*m)
+	Q := make([]bls12381.G2Affine, 2*m)
+	config := ecc.MultiExpConfig{}
+	prev := initial
+	for j, c := range contributions {
+		lambdaMu := coeffs[2*j : 2*j+2]
+
+		// λⱼCⱼ + μⱼ[τⱼ]G₁
+		if _, err := P[2*j].MultiExp([]bls12381.G1Affine{c.Proof.Commitment, c.SRS.G1[1]}, lambdaMu, config); err != nil {
+			return err
+		}
+		Q[2*j] = challenge(&c.Proof.Commitment, &prev.G1[1], &c.SRS.G1[1])
+
+		// -λⱼG₁ - μⱼ[τⱼ₋₁]G₁
+		if _, err := P[2*j+1].MultiExp([]bls12381.G1Affine{gen1Aff, prev.G1[1]}, lambdaMu, config); err != nil {
+			return err
+		}
+		P[2*j+1].Neg(&P[2*j+1])
+		Q[2*j+1] = c.Proof.Pok
+
+		prev = &c.SRS
+	}
+
+	check, err := bls12381.PairingCheck(P, Q)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrInvalidContribution
+	}
+
+	return contributions[m-1].SRS.VerifyPowers()
+}
+
+// challenge returns R = hash_to_G2([s]G₁ ∥ [τ]G₁ ∥ [sτ]G₁)
+func challenge(commitment, prevTau, tau *bls12381.G1Affine) bls12381.G2Affine {
+	msg := make([]byte, 0, 3*bls12381.SizeOfG1AffineCompressed)
+	for _, p := range []*bls12381.G1Affine{commitment, prevTau, tau} {
+		b := p.Bytes()
+		msg = append(msg, b[:]...)
+	}
+	r, err := bls12381.HashToG2(msg, dstProofOfKnowledge)
+	if err != nil {
+		panic(err) // the dst is not empty
+	}
+	return r
+}
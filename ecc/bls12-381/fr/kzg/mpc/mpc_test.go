@@ -0,0 +1,126 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mpc
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+const nbContributors = 3
+
+// ceremony runs a local ceremony of nbContributors participants
+func ceremony(t *testing.T, size uint64) (*kzg.SRS, []*Contribution) {
+	initial, err := Initialize(size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contributions := make([]*Contribution, nbContributors)
+	prev := initial
+	for i := range contributions {
+		if contributions[i], err = Contribute(prev); err != nil {
+			t.Fatal(err)
+		}
+		prev = &contributions[i].SRS
+	}
+	return initial, contributions
+}
+
+func TestCeremony(t *testing.T) {
+	initial, contributions := ceremony(t, 16)
+
+	if err := Verify(initial, contributions...); err != nil {
+		t.Fatal(err)
+	}
+
+	// the resulting srs is usable with kzg
+	srs := &contributions[nbContributors-1].SRS
+	p := make([]fr.Element, 16)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	digest, err := kzg.Commit(p, srs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var point fr.Element
+	point.SetRandom()
+	proof, err := kzg.Open(p, point, srs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = kzg.Verify(&digest, &proof, point, srs); err != nil {
+		t.Fatal(err)
+	}
+
+	// the ceremony can go on from an existing srs
+	next, err := Contribute(srs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(srs, next); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCeremonyInvalid(t *testing.T) {
+	initial, contributions := ceremony(t, 8)
+
+	// missing contribution
+	if err := Verify(initial, contributions[0], contributions[2]); err != ErrInvalidContribution {
+		t.Fatal("missing contribution should be detected")
+	}
+
+	// proof of another contribution
+	proof := contributions[1].Proof
+	contributions[1].Proof = contributions[2].Proof
+	if err := Verify(initial, contributions...); err != ErrInvalidContribution {
+		t.Fatal("wrong proof of knowledge should be detected")
+	}
+	contributions[1].Proof = proof
+
+	// powers of the last srs out of order
+	last := &contributions[nbContributors-1].SRS
+	last.G1[3], last.G1[4] = last.G1[4], last.G1[3]
+	if err := Verify(initial, contributions...); err != kzg.ErrInvalidSRS {
+		t.Fatal("invalid srs should be detected")
+	}
+
+	if err := Verify(initial); err != ErrNoContribution {
+		t.Fatal("empty chain should be rejected")
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	_, contributions := ceremony(t, 8)
+
+	var buf bytes.Buffer
+	if _, err := contributions[0].WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var c Contribution
+	if _, err := c.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(contributions[0], &c) {
+		t.Fatal("reading back doesn't yield same content")
+	}
+}
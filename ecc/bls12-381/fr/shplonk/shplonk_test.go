@@ -0,0 +1,212 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package shplonk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+// testSRS re-used accross tests of the shplonk scheme
+var testSRS *kzg.SRS
+
+func init() {
+	const srsSize = 230
+	testSRS, _ = kzg.NewSRS(ecc.NextPowerOfTwo(srsSize), new(big.Int).SetInt64(42))
+}
+
+func randomPolynomial(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+// testOpening commits to nbPolynomials random polynomials, the i-th one
+// being opened at nbPoints[i] random points.
+func testOpening(t *testing.T, nbPoints []int) ([][]fr.Element, []kzg.Digest, [][]fr.Element, OpeningProof) {
+	polynomials := make([][]fr.Element, len(nbPoints))
+	digests := make([]kzg.Digest, len(nbPoints))
+	points := make([][]fr.Element, len(nbPoints))
+	var err error
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(20 + 3*i)
+		if digests[i], err = kzg.Commit(polynomials[i], testSRS); err != nil {
+			t.Fatal(err)
+		}
+		points[i] = randomPolynomial(nbPoints[i])
+	}
+
+	proof, err := BatchOpen(polynomials, digests, points, sha256.New(), testSRS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return polynomials, digests, points, proof
+}
+
+func TestOpening(t *testing.T) {
+	_, digests, points, proof := testOpening(t, []int{1, 2, 3, 1, 4})
+
+	if err := BatchVerify(proof, digests, points, sha256.New(), testSRS); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong claimed value
+	proof.ClaimedValues[1][0].SetRandom()
+	if err := BatchVerify(proof, digests, points, sha256.New(), testSRS); err == nil {
+		t.Fatal("verifying wrong proof should have failed")
+	}
+}
+
+func TestOpeningSamePoints(t *testing.T) {
+	// PLONK like opening of several polynomials at ζ and ωζ
+	var zeta, omegaZeta fr.Element
+	zeta.SetRandom()
+	omegaZeta.SetRandom()
+
+	polynomials := make([][]fr.Element, 4)
+	digests := make([]kzg.Digest, 4)
+	points := make([][]fr.Element, 4)
+	var err error
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(32)
+		if digests[i], err = kzg.Commit(polynomials[i], testSRS); err != nil {
+			t.Fatal(err)
+		}
+		points[i] = []fr.Element{zeta}
+	}
+	points[0] = append(points[0], omegaZeta)
+
+	// ζ is shared, T = {ζ, ωζ}
+	if set := union(points); len(set) != 2 {
+		t.Fatalf("T should contain 2 points, got %d", len(set))
+	}
+	if set := complement(union(points), points[1]); len(set) != 1 || !set[0].Equal(&omegaZeta) {
+		t.Fatal("T\\S₁ should be {ωζ}")
+	}
+
+	proof, err := BatchOpen(polynomials, digests, points, sha256.New(), testSRS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = BatchVerify(proof, digests, points, sha256.New(), testSRS); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong digest
+	digests[2] = digests[3]
+	if err = BatchVerify(proof, digests, points, sha256.New(), testSRS); err == nil {
+		t.Fatal("verifying wrong proof should have failed")
+	}
+}
+
+func TestOpeningErrors(t *testing.T) {
+	polynomials, digests, points, proof := testOpening(t, []int{2, 1})
+
+	if _, err := BatchOpen(polynomials, digests[1:], points, sha256.New(), testSRS); err != ErrInvalidNumberOfDigests {
+		t.Fatal("should reject inconsistent number of digests")
+	}
+	if _, err := BatchOpen(polynomials, digests, points[1:], sha256.New(), testSRS); err != ErrInvalidNumberOfPoints {
+		t.Fatal("should reject inconsistent number of points")
+	}
+	duplicate := [][]fr.Element{
+		{points[0][0], points[0][0]},
+		points[1],
+	}
+	if _, err := BatchOpen(polynomials, digests, duplicate, sha256.New(), testSRS); err != ErrDuplicatePoints {
+		t.Fatal("should reject duplicate points")
+	}
+	if err := BatchVerify(proof, digests[1:], points, sha256.New(), testSRS); err != ErrInvalidNumberOfPoints {
+		t.Fatal("should reject inconsistent number of digests")
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	x := randomPolynomial(5)
+	y := randomPolynomial(5)
+	r, err := interpolate(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range x {
+		ri := eval(r, x[i])
+		if !ri.Equal(&y[i]) {
+			t.Fatal("interpolation polynomial does not match")
+		}
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	_, _, _, proof := testOpening(t, []int{1, 2, 3})
+
+	var buf bytes.Buffer
+	if _, err := proof.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded OpeningProof
+	if _, err := decoded.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&proof, &decoded) {
+		t.Fatal("reading back doesn't yield same content")
+	}
+
+	// a truncated proof claiming 2³²-1 claimed values is rejected
+	buf.Reset()
+	if _, err := proof.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	offset := 2 * bls12381.SizeOfG1AffineCompressed
+	truncated := buf.Bytes()[:offset+4]
+	truncated[offset], truncated[offset+1], truncated[offset+2], truncated[offset+3] = 0xff, 0xff, 0xff, 0xff
+	if _, err := decoded.ReadFrom(bytes.NewReader(truncated)); err == nil {
+		t.Fatal("reading a truncated proof should fail")
+	}
+}
+
+func BenchmarkOpening(b *testing.B) {
+	const nbPolynomials = 10
+	polynomials := make([][]fr.Element, nbPolynomials)
+	digests := make([]kzg.Digest, nbPolynomials)
+	points := make([][]fr.Element, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(200)
+		digests[i], _ = kzg.Commit(polynomials[i], testSRS)
+		points[i] = randomPolynomial(2)
+	}
+	proof, _ := BatchOpen(polynomials, digests, points, sha256.New(), testSRS)
+
+	b.Run("open", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchOpen(polynomials, digests, points, sha256.New(), testSRS)
+		}
+	})
+	b.Run("verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchVerify(proof, digests, points, sha256.New(), testSRS)
+		}
+	})
+}
@@ -20,7 +20,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
-	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils"
 	"math/big"
 	"runtime"
 )
@@ -936,7 +936,7 @@ func BatchJacobianToAffineG1(points []G1Jac) []G1Affine {
 	}
 
 	// batch convert to affine.
-	parallel.Execute(len(points), func(start, end int) {
+	utils.Parallelize(len(points), func(start, end int) {
 		for i := start; i < end; i++ {
 			if zeroes[i] {
 				// do nothing, (X=0, Y=0) is infinity point in affine
@@ -1000,7 +1000,7 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
 
 	// for each digit, take value in the base table, double it c time, voilà.
-	parallel.Execute(len(scalars), func(start, end int) {
+	utils.Parallelize(len(scalars), func(start, end int) {
 		var p G1Jac
 		for i := start; i < end; i++ {
 			p.Set(&g1Infinity)
@@ -20,9 +20,11 @@ import (
 	"errors"
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
-	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils"
 	"math"
+	"math/big"
 	"runtime"
+	"sync"
 )
 
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
@@ -80,36 +82,30 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	// with the GLV decomposition, the multiexp runs over twice as many points (Pᵢ and ϕ(Pᵢ)),
+	// with scalars about half the size
+	nbBits, nbPointsFactor := fr.Bits, 1
+	if config.GLV {
+		nbBits, nbPointsFactor = glvNbBits, 2
+	}
+	nbChunksFor := func(c uint64) int {
+		return (nbBits + int(c) - 1) / int(c)
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
-		// implemented msmC methods (the c we use must be in this slice)
-		implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
-		var C uint64
-		// approximate cost (in group operations)
-		// cost = bits/c * (nbPoints + 2^{c})
-		// this needs to be verified empirically.
-		// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
-		min := math.MaxFloat64
-		for _, c := range implementedCs {
-			cc := (fr.Bits + 1) * (nbPoints + (1 << c))
-			cost := float64(cc) / float64(c)
-			if cost < min {
-				min = cost
-				C = c
-			}
-		}
-		return C
+		return bestCG1(nbPointsFactor*nbPoints, nbBits)
 	}
 
 	C := bestC(nbPoints)
-	nbChunks := int(computeNbChunks(C))
+	nbChunks := nbChunksFor(C)
 
 	// if we don't utilise all the tasks (CPU in the default case) that we could, let's see if it's worth it to split
 	if config.NbTasks > 1 && nbChunks < config.NbTasks {
 		// before spliting, let's see if we endup with more tasks than thread;
 		cSplit := bestC(nbPoints / 2)
-		nbChunksPostSplit := int(computeNbChunks(cSplit))
+		nbChunksPostSplit := nbChunksFor(cSplit)
 		nbTasksPostSplit := nbChunksPostSplit * 2
 		if (nbTasksPostSplit <= config.NbTasks/2) || (nbTasksPostSplit-config.NbTasks/2) <= (config.NbTasks-nbChunks) {
 			// if postSplit we still have less tasks than available CPU
@@ -127,17 +123,103 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 			return p, nil
 		}
 	}
-
+	if config.GLV {
+		_innerMsmGLVG1(p, C, points, scalars, config)
+		return p, nil
+	}
 	_innerMsmG1(p, C, points, scalars, config)
 
 	return p, nil
 }
 
+// bestCG1 returns the window size minimizing the approximate cost of a multiexp
+// of nbPoints points by scalars of nbBits bits.
+func bestCG1(nbPoints, nbBits int) uint64 {
+	// implemented msmC methods (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var C uint64
+	// approximate cost (in group operations)
+	// cost = bits/c * (nbPoints + 2^{c})
+	// this needs to be verified empirically.
+	// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
+	min := math.MaxFloat64
+	for _, c := range implementedCs {
+		cc := (nbBits + 1) * (nbPoints + (1 << c))
+		cost := float64(cc) / float64(c)
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
 func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
 	// partition the scalars
 	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
 
-	nbChunks := computeNbChunks(c)
+	return msmProcessChunksG1(p, c, lastC(c), points, digits, chunkStats)
+}
+
+// _innerMsmGLVG1 splits the scalars with the GLV decomposition sᵢ = k₁ᵢ + λk₂ᵢ and computes
+// ∑ [k₁ᵢ]Pᵢ + [k₂ᵢ]ϕ(Pᵢ), a multiexp over twice as many points but with half as many windows.
+func _innerMsmGLVG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
+	n := len(points)
+	glvPoints := make([]G1Affine, 2*n)
+	glvScalars := make([]fr.Element, 2*n)
+
+	// the bound on k₁, k₂ depends on the lattice basis, we use the actual max bit length
+	maxBits := 0
+	var lock sync.Mutex
+	utils.Parallelize(n, func(start, end int) {
+		var s big.Int
+		localMaxBits := 0
+		for i := start; i < end; i++ {
+			glvPoints[i] = points[i]
+			glvPoints[n+i].Y = points[i].Y
+			glvPoints[n+i].X.Mul(&points[i].X, &thirdRootOneG1)
+
+			// split the scalar, modifies ±Pᵢ, ±ϕ(Pᵢ) accordingly
+			k := ecc.SplitScalar(scalars[i].BigInt(&s), &glvBasis)
+			if k[0].Sign() == -1 {
+				k[0].Neg(&k[0])
+				glvPoints[i].Neg(&glvPoints[i])
+			}
+			if k[1].Sign() == -1 {
+				k[1].Neg(&k[1])
+				glvPoints[n+i].Neg(&glvPoints[n+i])
+			}
+			glvScalars[i].SetBigInt(&k[0])
+			glvScalars[n+i].SetBigInt(&k[1])
+			if l := k[0].BitLen(); l > localMaxBits {
+				localMaxBits = l
+			}
+			if l := k[1].BitLen(); l > localMaxBits {
+				localMaxBits = l
+			}
+		}
+		lock.Lock()
+		if localMaxBits > maxBits {
+			maxBits = localMaxBits
+		}
+		lock.Unlock()
+	}, config.NbTasks)
+	if maxBits == 0 {
+		// all the scalars are 0
+		return p.Set(&g1Infinity)
+	}
+
+	// one more bit is needed for the carry in the last window, which is then processed with c bits.
+	nbChunks := (uint64(maxBits) + c) / c
+	digits, chunkStats := partitionScalarsWindows(glvScalars, c, nbChunks, config.NbTasks)
+
+	return msmProcessChunksG1(p, c, c, glvPoints, digits, chunkStats)
+}
+
+// msmProcessChunksG1 computes the multiexp from the digits of the scalars (see partitionScalars),
+// the last window being processed with lastC bits.
+func msmProcessChunksG1(p *G1Jac, c, lastC uint64, points []G1Affine, digits []uint16, chunkStats []chunkStat) *G1Jac {
+	nbChunks := uint64(len(chunkStats))
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
 	// corresponding bit-window
@@ -154,7 +236,7 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := getChunkProcessorG1(c, chunkStats[j])
 		if j == int(nbChunks-1) {
-			processChunk = getChunkProcessorG1(lastC(c), chunkStats[j])
+			processChunk = getChunkProcessorG1(lastC, chunkStats[j])
 		}
 		if chunkStats[j].weight >= 115 {
 			// we split this in more go routines since this chunk has more work to do than the others.
@@ -337,36 +419,30 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	// with the GLV decomposition, the multiexp runs over twice as many points (Pᵢ and ϕ(Pᵢ)),
+	// with scalars about half the size
+	nbBits, nbPointsFactor := fr.Bits, 1
+	if config.GLV {
+		nbBits, nbPointsFactor = glvNbBits, 2
+	}
+	nbChunksFor := func(c uint64) int {
+		return (nbBits + int(c) - 1) / int(c)
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
-		// implemented msmC methods (the c we use must be in this slice)
-		implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
-		var C uint64
-		// approximate cost (in group operations)
-		// cost = bits/c * (nbPoints + 2^{c})
-		// this needs to be verified empirically.
-		// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
-		min := math.MaxFloat64
-		for _, c := range implementedCs {
-			cc := (fr.Bits + 1) * (nbPoints + (1 << c))
-			cost := float64(cc) / float64(c)
-			if cost < min {
-				min = cost
-				C = c
-			}
-		}
-		return C
+		return bestCG2(nbPointsFactor*nbPoints, nbBits)
 	}
 
 	C := bestC(nbPoints)
-	nbChunks := int(computeNbChunks(C))
+	nbChunks := nbChunksFor(C)
 
 	// if we don't utilise all the tasks (CPU in the default case) that we could, let's see if it's worth it to split
 	if config.NbTasks > 1 && nbChunks < config.NbTasks {
 		// before spliting, let's see if we endup with more tasks than thread;
 		cSplit := bestC(nbPoints / 2)
-		nbChunksPostSplit := int(computeNbChunks(cSplit))
+		nbChunksPostSplit := nbChunksFor(cSplit)
 		nbTasksPostSplit := nbChunksPostSplit * 2
 		if (nbTasksPostSplit <= config.NbTasks/2) || (nbTasksPostSplit-config.NbTasks/2) <= (config.NbTasks-nbChunks) {
 			// if postSplit we still have less tasks than available CPU
@@ -384,17 +460,103 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 			return p, nil
 		}
 	}
-
+	if config.GLV {
+		_innerMsmGLVG2(p, C, points, scalars, config)
+		return p, nil
+	}
 	_innerMsmG2(p, C, points, scalars, config)
 
 	return p, nil
 }
 
+// bestCG2 returns the window size minimizing the approximate cost of a multiexp
+// of nbPoints points by scalars of nbBits bits.
+func bestCG2(nbPoints, nbBits int) uint64 {
+	// implemented msmC methods (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var C uint64
+	// approximate cost (in group operations)
+	// cost = bits/c * (nbPoints + 2^{c})
+	// this needs to be verified empirically.
+	// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
+	min := math.MaxFloat64
+	for _, c := range implementedCs {
+		cc := (nbBits + 1) * (nbPoints + (1 << c))
+		cost := float64(cc) / float64(c)
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
 func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
 	// partition the scalars
 	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
 
-	nbChunks := computeNbChunks(c)
+	return msmProcessChunksG2(p, c, lastC(c), points, digits, chunkStats)
+}
+
+// _innerMsmGLVG2 splits the scalars with the GLV decomposition sᵢ = k₁ᵢ + λk₂ᵢ and computes
+// ∑ [k₁ᵢ]Pᵢ + [k₂ᵢ]ϕ(Pᵢ), a multiexp over twice as many points but with half as many windows.
+func _innerMsmGLVG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
+	n := len(points)
+	glvPoints := make([]G2Affine, 2*n)
+	glvScalars := make([]fr.Element, 2*n)
+
+	// the bound on k₁, k₂ depends on the lattice basis, we use the actual max bit length
+	maxBits := 0
+	var lock sync.Mutex
+	utils.Parallelize(n, func(start, end int) {
+		var s big.Int
+		localMaxBits := 0
+		for i := start; i < end; i++ {
+			glvPoints[i] = points[i]
+			glvPoints[n+i].Y = points[i].Y
+			glvPoints[n+i].X.MulByElement(&points[i].X, &thirdRootOneG2)
+
+			// split the scalar, modifies ±Pᵢ, ±ϕ(Pᵢ) accordingly
+			k := ecc.SplitScalar(scalars[i].BigInt(&s), &glvBasis)
+			if k[0].Sign() == -1 {
+				k[0].Neg(&k[0])
+				glvPoints[i].Neg(&glvPoints[i])
+			}
+			if k[1].Sign() == -1 {
+				k[1].Neg(&k[1])
+				glvPoints[n+i].Neg(&glvPoints[n+i])
+			}
+			glvScalars[i].SetBigInt(&k[0])
+			glvScalars[n+i].SetBigInt(&k[1])
+			if l := k[0].BitLen(); l > localMaxBits {
+				localMaxBits = l
+			}
+			if l := k[1].BitLen(); l > localMaxBits {
+				localMaxBits = l
+			}
+		}
+		lock.Lock()
+		if localMaxBits > maxBits {
+			maxBits = localMaxBits
+		}
+		lock.Unlock()
+	}, config.NbTasks)
+	if maxBits == 0 {
+		// all the scalars are 0
+		return p.Set(&g2Infinity)
+	}
+
+	// one more bit is needed for the carry in the last window, which is then processed with c bits.
+	nbChunks := (uint64(maxBits) + c) / c
+	digits, chunkStats := partitionScalarsWindows(glvScalars, c, nbChunks, config.NbTasks)
+
+	return msmProcessChunksG2(p, c, c, glvPoints, digits, chunkStats)
+}
+
+// msmProcessChunksG2 computes the multiexp from the digits of the scalars (see partitionScalars),
+// the last window being processed with lastC bits.
+func msmProcessChunksG2(p *G2Jac, c, lastC uint64, points []G2Affine, digits []uint16, chunkStats []chunkStat) *G2Jac {
+	nbChunks := uint64(len(chunkStats))
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
 	// corresponding bit-window
@@ -411,7 +573,7 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := getChunkProcessorG2(c, chunkStats[j])
 		if j == int(nbChunks-1) {
-			processChunk = getChunkProcessorG2(lastC(c), chunkStats[j])
+			processChunk = getChunkProcessorG2(lastC, chunkStats[j])
 		}
 		if chunkStats[j].weight >= 115 {
 			// we split this in more go routines since this chunk has more work to do than the others.
@@ -551,6 +713,10 @@ type selector struct {
 	shiftHigh       uint64 // same than shift, for index+1
 }
 
+// glvNbBits estimates the bit length of the scalars after the GLV decomposition, including the carry
+// of the last window (k₁, k₂ are len(r)/2 or len(r)/2+1 bits long)
+const glvNbBits = fr.Bits/2 + 2
+
 // return number of chunks for a given window size c
 // the last chunk may be bigger to accomodate a potential carry from the NAF decomposition
 func computeNbChunks(c uint64) uint64 {
@@ -583,8 +749,12 @@ type chunkStat struct {
 // (computing -G is cheap, and this saves us half of the buckets in the MultiExp or BatchScalarMultiplication)
 func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []chunkStat) {
 	// number of c-bit radixes in a scalar
-	nbChunks := computeNbChunks(c)
+	return partitionScalarsWindows(scalars, c, computeNbChunks(c), nbTasks)
+}
 
+// partitionScalarsWindows is partitionScalars with the number of c-bit windows set by the caller;
+// the scalars must fit in nbChunks windows, with room for the carry in the last one.
+func partitionScalarsWindows(scalars []fr.Element, c, nbChunks uint64, nbTasks int) ([]uint16, []chunkStat) {
 	digits := make([]uint16, len(scalars)*int(nbChunks))
 
 	mask := uint64((1 << c) - 1) // low c bits are 1
@@ -608,7 +778,7 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 		selectors[chunk] = d
 	}
 
-	parallel.Execute(len(scalars), func(start, end int) {
+	utils.Parallelize(len(scalars), func(start, end int) {
 		for i := start; i < end; i++ {
 			if scalars[i].IsZero() {
 				// everything is 0, no need to process this scalar
@@ -678,7 +848,7 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 		// no need to compute stats for small window sizes
 		return digits, chunkStats
 	}
-	parallel.Execute(len(chunkStats), func(start, end int) {
+	utils.Parallelize(len(chunkStats), func(start, end int) {
 		// for each chunk compute the statistics
 		for chunkID := start; chunkID < end; chunkID++ {
 			// indicates if a bucket is hit.
@@ -0,0 +1,73 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestScalarMultiplicationCT(t *testing.T) {
+	t.Parallel()
+
+	params := GetEdwardsCurve()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(-3),
+		new(big.Int).Sub(&params.Order, big.NewInt(1)),
+		new(big.Int).Set(&params.Order),
+	}
+	nbRandom := 20
+	if testing.Short() {
+		nbRandom = 5
+	}
+	for i := 0; i < nbRandom; i++ {
+		s, err := rand.Int(rand.Reader, &params.Order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scalars = append(scalars, s)
+	}
+
+	for _, s := range scalars {
+		// the variable-time multiplication expects a non-negative scalar
+		var e big.Int
+		e.Mod(s, &params.Order)
+
+		var expected, got PointAffine
+		expected.ScalarMultiplication(&params.Base, &e)
+		got.ScalarMultiplicationCT(&params.Base, s)
+		if !got.Equal(&expected) {
+			t.Fatalf("ScalarMultiplicationCT and ScalarMultiplication differ for s = %s", s.String())
+		}
+	}
+}
+
+func BenchmarkScalarMultiplicationCT(b *testing.B) {
+	params := GetEdwardsCurve()
+	var s big.Int
+	s.SetString("52435875175126190479447705081859658376581184513", 10)
+
+	var res PointAffine
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		res.ScalarMultiplicationCT(&params.Base, &s)
+	}
+}
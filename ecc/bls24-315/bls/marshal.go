@@ -0,0 +1,101 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/subtle"
+	"io"
+)
+
+// sizePublicKey returns the size in bytes of a compressed public key of the variant.
+func (v Variant) sizePublicKey() int {
+	if v == MinSig {
+		return sizeG2
+	}
+	return sizeG1
+}
+
+// sizeSignature returns the size in bytes of a compressed signature of the variant.
+func (v Variant) sizeSignature() int {
+	if v == MinSig {
+		return sizeG1
+	}
+	return sizeG2
+}
+
+// Bytes returns the binary representation of the public key
+// as a compressed point of G1 (MinPk) or G2 (MinSig).
+func (pk *PublicKey) Bytes() []byte {
+	if pk.Suite.Variant == MinSig {
+		pkBin := pk.A2.Bytes()
+		return pkBin[:]
+	}
+	pkBin := pk.A1.Bytes()
+	return pkBin[:]
+}
+
+// SetBytes sets pk from binary representation in buf.
+// buf represents a public key as a compressed point of the group
+// determined by pk.Suite. It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	size := pk.Suite.Variant.sizePublicKey()
+	if len(buf) < size {
+		return 0, io.ErrShortBuffer
+	}
+	var err error
+	if pk.Suite.Variant == MinSig {
+		_, err = pk.A2.SetBytes(buf[:size])
+	} else {
+		_, err = pk.A1.SetBytes(buf[:size])
+	}
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Bytes returns the binary representation of pk,
+// as byte array publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+func (privKey *PrivateKey) Bytes() []byte {
+	pubkBin := privKey.PublicKey.Bytes()
+	res := make([]byte, len(pubkBin)+sizeFr)
+	subtle.ConstantTimeCopy(1, res[:len(pubkBin)], pubkBin)
+	subtle.ConstantTimeCopy(1, res[len(pubkBin):], privKey.scalar[:])
+	return res
+}
+
+// SetBytes sets pk from buf, where buf is interpreted
+// as  publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+// The ciphersuite of privKey.PublicKey must be set beforehand.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	sizePublicKey := privKey.PublicKey.Suite.Variant.sizePublicKey()
+	if len(buf) < sizePublicKey+sizeFr {
+		return 0, io.ErrShortBuffer
+	}
+	n, err := privKey.PublicKey.SetBytes(buf[:sizePublicKey])
+	if err != nil {
+		return 0, err
+	}
+	subtle.ConstantTimeCopy(1, privKey.scalar[:], buf[n:n+sizeFr])
+	n += sizeFr
+	return n, nil
+}
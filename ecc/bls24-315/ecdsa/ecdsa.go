@@ -76,6 +76,13 @@ func randFieldElement(rand io.Reader) (k *big.Int, err error) {
 	return
 }
 
+// inverseCT sets z = k⁻¹ (mod r) and returns z, in constant time with respect to k
+func inverseCT(z, k *big.Int) *big.Int {
+	var e fr.Element
+	e.SetBigInt(k).InverseCT(&e)
+	return e.BigInt(z)
+}
+
 // GenerateKey generates a public and private key pair.
 func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 
@@ -88,7 +95,7 @@ func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 
 	privateKey := new(PrivateKey)
 	k.FillBytes(privateKey.scalar[:sizeFr])
-	privateKey.PublicKey.A.ScalarMultiplication(&g, k)
+	privateKey.PublicKey.A.ScalarMultiplicationCT(&g, k)
 	return privateKey, nil
 }
 
@@ -206,8 +213,8 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			}
 
 			var P bls24315.G1Affine
-			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+			P.ScalarMultiplicationBaseCT(k)
+			inverseCT(kInv, k)
 
 			P.X.BigInt(r)
 
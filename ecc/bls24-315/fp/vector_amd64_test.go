@@ -0,0 +1,71 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestVectorSumAVX2 checks Sum and InnerProduct with the AVX2 kernels, which are not used
+// on the CPUs supporting AVX-512, against the generic implementation.
+func TestVectorSumAVX2(t *testing.T) {
+	if !supportAvx2 {
+		t.Skip("AVX2 is not supported")
+	}
+	assert := require.New(t)
+
+	defer func(avx512 bool) {
+		supportAvx512 = avx512
+	}(supportAvx512)
+	supportAvx512 = false
+
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 15, 17, 257, 1025} {
+		a, b := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+
+		// the generic implementations accumulate in their result
+		var expectedSum, expectedInnerProduct Element
+		sumVecGeneric(&expectedSum, a)
+		innerProductVecGeneric(&expectedInnerProduct, a, b)
+		s := a.Sum()
+		assert.True(s.Equal(&expectedSum), "Sum mismatch (n = %d)", n)
+		s = a.InnerProduct(b)
+		assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch (n = %d)", n)
+	}
+
+	// the largest limbs, q - 1 in Montgomery form, maximize the accumulators and the carries
+	const n = 1025
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i] = Element{q0 - 1, q1, q2, q3, q4}
+	}
+	var expectedSum, expectedInnerProduct Element
+	sumVecGeneric(&expectedSum, a)
+	innerProductVecGeneric(&expectedInnerProduct, a, a)
+	s := a.Sum()
+	assert.True(s.Equal(&expectedSum), "Sum mismatch on the largest limbs")
+	s = a.InnerProduct(a)
+	assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch on the largest limbs")
+}
@@ -71,7 +71,6 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(7)
 
 	if len(shift) != 0 {
@@ -102,7 +101,6 @@ func Generator(m uint64) (fr.Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity fr.Element
-
 	rootOfUnity.SetString("1792993287828780812362846131493071959406149719416102105453370749552622525216")
 	const maxOrderRoot uint64 = 22
 
@@ -36,6 +36,7 @@ var (
 	ErrVerifyOpeningProof            = errors.New("can't verify opening proof")
 	ErrVerifyBatchOpeningSinglePoint = errors.New("can't verify batch opening proof at single point")
 	ErrMinSRSSize                    = errors.New("minimum srs size is 2")
+	ErrInvalidSRS                    = errors.New("srs is not made of consecutive powers of the same secret")
 )
 
 // Digest commitment of a polynomial.
@@ -92,6 +93,79 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	return &srs, nil
 }
 
+// VerifyPowers checks that srs is well formed: G1[0] and G2[0] are the
+// generators, G1[1] and G2[1] are not the point at infinity, all the points
+// are in the prime order subgroups, and
+// G1[i] = [αⁱ]G₁ where G2[1] = [α]G₂. The powers are checked at once with
+// random λᵢ:
+//
+// e(∑ᵢλᵢG1[i], [α]G₂) ?= e(∑ᵢλᵢG1[i+1], G₂)
+func (srs *SRS) VerifyPowers() error {
+	if len(srs.G1) < 2 {
+		return ErrMinSRSSize
+	}
+
+	_, _, gen1Aff, gen2Aff := bls24315.Generators()
+	if !srs.G1[0].Equal(&gen1Aff) || !srs.G2[0].Equal(&gen2Aff) {
+		return ErrInvalidSRS
+	}
+	// α = 0 would satisfy the pairing equation with all the powers at infinity
+	if srs.G2[1].IsInfinity() || srs.G1[1].IsInfinity() {
+		return ErrInvalidSRS
+	}
+	if !srs.G2[1].IsInSubGroup() || !g1InSubGroup(srs.G1) {
+		return ErrInvalidSRS
+	}
+
+	n := len(srs.G1) - 1
+	lambda := make([]fr.Element, n)
+	for i := range lambda {
+		if _, err := lambda[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+
+	var left, right bls24315.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err := left.MultiExp(srs.G1[:n], lambda, config); err != nil {
+		return err
+	}
+	if _, err := right.MultiExp(srs.G1[1:], lambda, config); err != nil {
+		return err
+	}
+	left.Neg(&left)
+
+	// e(∑ᵢλᵢG1[i+1], G₂)⋅e(-∑ᵢλᵢG1[i], [α]G₂) ?= 1
+	check, err := bls24315.PairingCheck(
+		[]bls24315.G1Affine{right, left},
+		[]bls24315.G2Affine{srs.G2[0], srs.G2[1]},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrInvalidSRS
+	}
+	return nil
+}
+
+// g1InSubGroup returns true if all the points are in the prime order subgroup of G1
+func g1InSubGroup(points []bls24315.G1Affine) bool {
+	subgroupCheck := true
+	var lock sync.Mutex
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if !points[i].IsInSubGroup() {
+				lock.Lock()
+				subgroupCheck = false
+				lock.Unlock()
+				return
+			}
+		}
+	})
+	return subgroupCheck
+}
+
 // OpeningProof KZG proof for opening at a single point.
 //
 // implements io.ReaderFrom and io.WriterTo
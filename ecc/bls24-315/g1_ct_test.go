@@ -0,0 +1,145 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+func TestG1JacScalarMultiplicationCT(t *testing.T) {
+	t.Parallel()
+
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(-3),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Set(r),
+		new(big.Int).Add(r, big.NewInt(5)),
+	}
+	nbRandom := 20
+	if testing.Short() {
+		nbRandom = 5
+	}
+	for i := 0; i < nbRandom; i++ {
+		var s fr.Element
+		s.SetRandom()
+		scalars = append(scalars, s.BigInt(new(big.Int)))
+	}
+
+	var base G1Jac
+	base.ScalarMultiplication(&g1Gen, big.NewInt(7))
+	var baseAff G1Affine
+	baseAff.FromJacobian(&base)
+
+	for _, s := range scalars {
+		// the reference is computed on s mod r, since the scalar multiplication of some
+		// curves doesn't handle negative scalars
+		sMod := new(big.Int).Mod(s, r)
+		var expected, got G1Jac
+		expected.ScalarMultiplication(&base, sMod)
+		got.ScalarMultiplicationCT(&base, s)
+		if !got.Equal(&expected) {
+			t.Fatalf("ScalarMultiplicationCT and ScalarMultiplication differ for s = %s", s.String())
+		}
+
+		var expectedAff, gotAff G1Affine
+		expectedAff.FromJacobian(&expected)
+		gotAff.ScalarMultiplicationCT(&baseAff, s)
+		if !gotAff.Equal(&expectedAff) {
+			t.Fatalf("affine ScalarMultiplicationCT and ScalarMultiplication differ for s = %s", s.String())
+		}
+
+		expectedAff.ScalarMultiplicationBase(sMod)
+		gotAff.ScalarMultiplicationBaseCT(s)
+		if !gotAff.Equal(&expectedAff) {
+			t.Fatalf("ScalarMultiplicationBaseCT and ScalarMultiplicationBase differ for s = %s", s.String())
+		}
+	}
+
+	// the point at infinity
+	var inf G1Jac
+	inf.Set(&g1Infinity)
+	var got G1Jac
+	got.ScalarMultiplicationCT(&inf, scalars[len(scalars)-1])
+	if !got.Z.IsZero() {
+		t.Fatal("ScalarMultiplicationCT of the point at infinity should be the point at infinity")
+	}
+	var infAff, gotAff G1Affine
+	gotAff.ScalarMultiplicationCT(&infAff, scalars[len(scalars)-1])
+	if !gotAff.IsInfinity() {
+		t.Fatal("affine ScalarMultiplicationCT of the point at infinity should be the point at infinity")
+	}
+}
+
+func TestG1CompleteFormulas(t *testing.T) {
+	t.Parallel()
+
+	// the formulas must handle a + a, a + (-a) and additions of the point at infinity
+	var a, b, inf, res g1ProjCT
+	a.fromJacobian(&g1Gen)
+	inf.setInfinity()
+
+	var twoG, expected G1Jac
+	twoG.Double(&g1Gen)
+	res.add(&a, &a)
+	if !res.toJacobian(&expected).Equal(&twoG) {
+		t.Fatal("a + a should be 2a")
+	}
+	res.double(&a)
+	if !res.toJacobian(&expected).Equal(&twoG) {
+		t.Fatal("double(a) should be 2a")
+	}
+
+	var negG G1Jac
+	negG.Neg(&g1Gen)
+	b.fromJacobian(&negG)
+	res.add(&a, &b)
+	if !res.Z.IsZero() {
+		t.Fatal("a + (-a) should be the point at infinity")
+	}
+
+	res.add(&a, &inf)
+	if !res.toJacobian(&expected).Equal(&g1Gen) {
+		t.Fatal("a + 0 should be a")
+	}
+	res.add(&inf, &inf)
+	if !res.Z.IsZero() {
+		t.Fatal("0 + 0 should be the point at infinity")
+	}
+	res.double(&inf)
+	if !res.Z.IsZero() {
+		t.Fatal("2⋅0 should be the point at infinity")
+	}
+}
+
+func BenchmarkG1JacScalarMultiplicationCT(b *testing.B) {
+	var scalar fr.Element
+	scalar.SetRandom()
+	s := scalar.BigInt(new(big.Int))
+
+	var res G1Jac
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		res.ScalarMultiplicationCT(&g1Gen, s)
+	}
+}
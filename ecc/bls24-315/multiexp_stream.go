@@ -0,0 +1,381 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/utils"
+)
+
+// defaultStreamChunkSize is the number of points loaded at once by the streaming multiexp
+// when config.ChunkSize is not set
+const defaultStreamChunkSize = 1 << 20
+
+// MultiExpReader computes the multi-exponentiation of the points read from r by scalars,
+// without loading all the points in memory.
+//
+// r must contain the raw encoding of a slice of at least len(scalars) points: the number of
+// points (uint32, big endian), followed by the RawBytes of each point. Only the first
+// len(scalars) points are read; a memory-mapped file can be read through a bytes.Reader.
+//
+// The multi-exponentiation uses the bucket method over the whole stream: the points are read
+// config.ChunkSize at a time (1 << 20 if not set) and added to the buckets of each window, the
+// next chunk being read while the current one is processed, and the buckets are reduced once,
+// after the last chunk. At most 2*config.ChunkSize points are held in memory, besides the
+// buckets and the digits of the scalars of one chunk. The points are not checked to be in the
+// subgroup, r must be trusted.
+//
+// This call return an error if the points can't be read or if provided config is invalid.
+func (p *G1Affine) MultiExpReader(r io.Reader, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpReader(r, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpReader computes the multi-exponentiation of the points read from r by scalars,
+// without loading all the points in memory.
+//
+// See G1Affine.MultiExpReader for the encoding of the points and the memory usage.
+func (p *G1Jac) MultiExpReader(r io.Reader, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	chunkSize := config.ChunkSize
+	if chunkSize < 0 {
+		return nil, errors.New("invalid config: config.ChunkSize < 0")
+	} else if chunkSize == 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+	if chunkSize > len(scalars) {
+		chunkSize = len(scalars)
+	}
+
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	if nbPoints := binary.BigEndian.Uint32(header[:]); uint64(nbPoints) < uint64(len(scalars)) {
+		return nil, errors.New("not enough points to read")
+	}
+
+	// chunks of points are decoded by a go routine into one of 2 buffers, and handed over
+	// to the multi-exponentiation through chChunks
+	type chunk struct {
+		points []G1Affine
+		err    error
+	}
+	const size = SizeOfG1AffineUncompressed
+	chFree := make(chan []G1Affine, 2)
+	chFree <- make([]G1Affine, chunkSize)
+	chFree <- make([]G1Affine, chunkSize)
+	chChunks := make(chan chunk, 1)
+	chDone := make(chan struct{})
+	defer close(chDone)
+
+	go func() {
+		defer close(chChunks)
+		buf := make([]byte, chunkSize*size)
+		for start := 0; start < len(scalars); start += chunkSize {
+			var points []G1Affine
+			select {
+			case points = <-chFree:
+			case <-chDone:
+				return
+			}
+			if n := len(scalars) - start; n < chunkSize {
+				points = points[:n]
+			}
+			var err error
+			if _, err = io.ReadFull(r, buf[:len(points)*size]); err == nil {
+				var errLock sync.Mutex
+				utils.Parallelize(len(points), func(start, end int) {
+					for i := start; i < end; i++ {
+						if _, errSet := points[i].setBytes(buf[i*size:(i+1)*size], false); errSet != nil {
+							errLock.Lock()
+							err = errSet
+							errLock.Unlock()
+							return
+						}
+					}
+				}, config.NbTasks)
+			}
+			select {
+			case chChunks <- chunk{points: points, err: err}:
+			case <-chDone:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// the window size is chosen for the whole stream, and each window keeps its buckets over
+	// the whole stream
+	c := bestCG1(len(scalars), fr.Bits)
+	nbChunks := int(computeNbChunks(c))
+	buckets := make([][]g1JacExtended, nbChunks)
+	for j := range buckets {
+		nbBuckets := 1 << (c - 1)
+		if j == nbChunks-1 {
+			// the last window may be larger, to accommodate the carry
+			nbBuckets = 1 << (lastC(c) - 1)
+		}
+		buckets[j] = make([]g1JacExtended, nbBuckets)
+		for k := range buckets[j] {
+			buckets[j][k].setInfinity()
+		}
+	}
+
+	// add the points of each chunk to the buckets, according to the digits of their scalars in
+	// each window; the scalars are split in c-bit windows as their chunk arrives
+	start := 0
+	for ch := range chChunks {
+		if ch.err != nil {
+			return nil, ch.err
+		}
+		points := ch.points
+		m := len(points)
+		digits, _ := partitionScalars(scalars[start:start+m], c, config.NbTasks)
+		utils.Parallelize(nbChunks, func(jStart, jEnd int) {
+			for j := jStart; j < jEnd; j++ {
+				for i, digit := range digits[j*m : (j+1)*m] {
+					if digit == 0 {
+						continue
+					}
+					// if msbWindow bit is set, we need to substract
+					if digit&1 == 0 {
+						buckets[j][(digit>>1)-1].addMixed(&points[i])
+					} else {
+						buckets[j][(digit >> 1)].subMixed(&points[i])
+					}
+				}
+			}
+		}, config.NbTasks)
+		start += m
+		chFree <- points[:cap(points)]
+	}
+
+	// reduce the buckets of each window into their weighted sum
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	chWindows := make([]chan g1JacExtended, nbChunks)
+	for j := range chWindows {
+		chWindows[j] = make(chan g1JacExtended, 1)
+	}
+	utils.Parallelize(nbChunks, func(jStart, jEnd int) {
+		for j := jStart; j < jEnd; j++ {
+			var runningSum, total g1JacExtended
+			runningSum.setInfinity()
+			total.setInfinity()
+			for k := len(buckets[j]) - 1; k >= 0; k-- {
+				if !buckets[j][k].ZZ.IsZero() {
+					runningSum.add(&buckets[j][k])
+				}
+				total.add(&runningSum)
+			}
+			chWindows[j] <- total
+		}
+	}, config.NbTasks)
+
+	return msmReduceChunkG1Affine(p, int(c), chWindows), nil
+}
+
+// MultiExpReader computes the multi-exponentiation of the points read from r by scalars,
+// without loading all the points in memory.
+//
+// r must contain the raw encoding of a slice of at least len(scalars) points: the number of
+// points (uint32, big endian), followed by the RawBytes of each point. Only the first
+// len(scalars) points are read; a memory-mapped file can be read through a bytes.Reader.
+//
+// The multi-exponentiation uses the bucket method over the whole stream: the points are read
+// config.ChunkSize at a time (1 << 20 if not set) and added to the buckets of each window, the
+// next chunk being read while the current one is processed, and the buckets are reduced once,
+// after the last chunk. At most 2*config.ChunkSize points are held in memory, besides the
+// buckets and the digits of the scalars of one chunk. The points are not checked to be in the
+// subgroup, r must be trusted.
+//
+// This call return an error if the points can't be read or if provided config is invalid.
+func (p *G2Affine) MultiExpReader(r io.Reader, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpReader(r, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpReader computes the multi-exponentiation of the points read from r by scalars,
+// without loading all the points in memory.
+//
+// See G2Affine.MultiExpReader for the encoding of the points and the memory usage.
+func (p *G2Jac) MultiExpReader(r io.Reader, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	chunkSize := config.ChunkSize
+	if chunkSize < 0 {
+		return nil, errors.New("invalid config: config.ChunkSize < 0")
+	} else if chunkSize == 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+	if chunkSize > len(scalars) {
+		chunkSize = len(scalars)
+	}
+
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	if nbPoints := binary.BigEndian.Uint32(header[:]); uint64(nbPoints) < uint64(len(scalars)) {
+		return nil, errors.New("not enough points to read")
+	}
+
+	// chunks of points are decoded by a go routine into one of 2 buffers, and handed over
+	// to the multi-exponentiation through chChunks
+	type chunk struct {
+		points []G2Affine
+		err    error
+	}
+	const size = SizeOfG2AffineUncompressed
+	chFree := make(chan []G2Affine, 2)
+	chFree <- make([]G2Affine, chunkSize)
+	chFree <- make([]G2Affine, chunkSize)
+	chChunks := make(chan chunk, 1)
+	chDone := make(chan struct{})
+	defer close(chDone)
+
+	go func() {
+		defer close(chChunks)
+		buf := make([]byte, chunkSize*size)
+		for start := 0; start < len(scalars); start += chunkSize {
+			var points []G2Affine
+			select {
+			case points = <-chFree:
+			case <-chDone:
+				return
+			}
+			if n := len(scalars) - start; n < chunkSize {
+				points = points[:n]
+			}
+			var err error
+			if _, err = io.ReadFull(r, buf[:len(points)*size]); err == nil {
+				var errLock sync.Mutex
+				utils.Parallelize(len(points), func(start, end int) {
+					for i := start; i < end; i++ {
+						if _, errSet := points[i].setBytes(buf[i*size:(i+1)*size], false); errSet != nil {
+							errLock.Lock()
+							err = errSet
+							errLock.Unlock()
+							return
+						}
+					}
+				}, config.NbTasks)
+			}
+			select {
+			case chChunks <- chunk{points: points, err: err}:
+			case <-chDone:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// the window size is chosen for the whole stream, and each window keeps its buckets over
+	// the whole stream
+	c := bestCG2(len(scalars), fr.Bits)
+	nbChunks := int(computeNbChunks(c))
+	buckets := make([][]g2JacExtended, nbChunks)
+	for j := range buckets {
+		nbBuckets := 1 << (c - 1)
+		if j == nbChunks-1 {
+			// the last window may be larger, to accommodate the carry
+			nbBuckets = 1 << (lastC(c) - 1)
+		}
+		buckets[j] = make([]g2JacExtended, nbBuckets)
+		for k := range buckets[j] {
+			buckets[j][k].setInfinity()
+		}
+	}
+
+	// add the points of each chunk to the buckets, according to the digits of their scalars in
+	// each window; the scalars are split in c-bit windows as their chunk arrives
+	start := 0
+	for ch := range chChunks {
+		if ch.err != nil {
+			return nil, ch.err
+		}
+		points := ch.points
+		m := len(points)
+		digits, _ := partitionScalars(scalars[start:start+m], c, config.NbTasks)
+		utils.Parallelize(nbChunks, func(jStart, jEnd int) {
+			for j := jStart; j < jEnd; j++ {
+				for i, digit := range digits[j*m : (j+1)*m] {
+					if digit == 0 {
+						continue
+					}
+					// if msbWindow bit is set, we need to substract
+					if digit&1 == 0 {
+						buckets[j][(digit>>1)-1].addMixed(&points[i])
+					} else {
+						buckets[j][(digit >> 1)].subMixed(&points[i])
+					}
+				}
+			}
+		}, config.NbTasks)
+		start += m
+		chFree <- points[:cap(points)]
+	}
+
+	// reduce the buckets of each window into their weighted sum
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	chWindows := make([]chan g2JacExtended, nbChunks)
+	for j := range chWindows {
+		chWindows[j] = make(chan g2JacExtended, 1)
+	}
+	utils.Parallelize(nbChunks, func(jStart, jEnd int) {
+		for j := jStart; j < jEnd; j++ {
+			var runningSum, total g2JacExtended
+			runningSum.setInfinity()
+			total.setInfinity()
+			for k := len(buckets[j]) - 1; k >= 0; k-- {
+				if !buckets[j][k].ZZ.IsZero() {
+					runningSum.add(&buckets[j][k])
+				}
+				total.add(&runningSum)
+			}
+			chWindows[j] <- total
+		}
+	}, config.NbTasks)
+
+	return msmReduceChunkG2Affine(p, int(c), chWindows), nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package bls provides BLS signature scheme on the bls24-317 curve.
+//
+// The implementation follows draft-irtf-cfrg-bls-signature-05 and supports
+// both variants of the scheme:
+//   - minimal-pubkey-size (MinPk): public keys in G1, signatures in G2;
+//   - minimal-signature-size (MinSig): signatures in G1, public keys in G2.
+//
+// Each variant can be instantiated with the basic scheme, the message
+// augmentation scheme or the proof of possession scheme, which differ in how
+// rogue key attacks are prevented when aggregating signatures.
+//
+// Signatures can be aggregated (AggregateSignatures) and verified against many
+// public keys with a single multi-pairing (AggregateVerify,
+// FastAggregateVerify). Independent signatures can be verified together with
+// BatchVerify, which checks a random linear combination of them.
+//
+// Messages are hashed to the curve with the BLS24317G1_XMD:SHA-256_SSWU_RO_
+// and BLS24317G2_XMD:SHA-256_SVDW_RO_ hash-to-curve suites.
+//
+// Documentation:
+// - BLS signatures draft: https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-05
+// - Hash to curve: https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-hash-to-curve-16
+package bls
@@ -270,3 +270,165 @@ TEXT ·Butterfly(SB), $24-16
 	MOVQ DI, 24(AX)
 	MOVQ R8, 32(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l2           // n == 0, nothing to do
+
+l1:
+	MOVQ a+8(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	MOVQ 32(AX), DI
+	MOVQ b+16(FP), AX
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+	ADCQ 32(AX), DI
+
+	// reduce element(DX,CX,BX,SI,DI) using temp registers (R8,R9,R10,R11,R12)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10,R11,R12)
+
+	MOVQ res+0(FP), AX
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	MOVQ DI, 32(AX)
+	ADDQ $0x0000000000000028, res+0(FP)
+	ADDQ $0x0000000000000028, a+8(FP)
+	ADDQ $0x0000000000000028, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l4           // n == 0, nothing to do
+
+l3:
+	XORQ    R8, R8
+	MOVQ    a+8(FP), AX
+	MOVQ    0(AX), DX
+	MOVQ    8(AX), CX
+	MOVQ    16(AX), BX
+	MOVQ    24(AX), SI
+	MOVQ    32(AX), DI
+	MOVQ    b+16(FP), AX
+	SUBQ    0(AX), DX
+	SBBQ    8(AX), CX
+	SBBQ    16(AX), BX
+	SBBQ    24(AX), SI
+	SBBQ    32(AX), DI
+	MOVQ    $0x8d512e565dab2aab, R9
+	MOVQ    $0xd6f339e43424bf7e, R10
+	MOVQ    $0x169a61e684c73446, R11
+	MOVQ    $0xf28fc5a0b7f9d039, R12
+	MOVQ    $0x1058ca226f60892c, R13
+	CMOVQCC R8, R9
+	CMOVQCC R8, R10
+	CMOVQCC R8, R11
+	CMOVQCC R8, R12
+	CMOVQCC R8, R13
+	ADDQ    R9, DX
+	ADCQ    R10, CX
+	ADCQ    R11, BX
+	ADCQ    R12, SI
+	ADCQ    R13, DI
+	MOVQ    res+0(FP), AX
+	MOVQ    DX, 0(AX)
+	MOVQ    CX, 8(AX)
+	MOVQ    BX, 16(AX)
+	MOVQ    SI, 24(AX)
+	MOVQ    DI, 32(AX)
+	ADDQ    $0x0000000000000028, res+0(FP)
+	ADDQ    $0x0000000000000028, a+8(FP)
+	ADDQ    $0x0000000000000028, b+16(FP)
+	DECQ    n+24(FP)
+	JNE     l3
+
+l4:
+	RET
+
+// sumVecAVX512(t *[40]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX512(SB), NOSPLIT, $0-24
+	MOVQ   a+8(FP), AX
+	MOVQ   n+16(FP), CX
+	SHRQ   $2, CX       // number of groups of 4 elements
+	VPXORQ Z0, Z0, Z0
+	VPXORQ Z1, Z1, Z1
+	VPXORQ Z2, Z2, Z2
+	VPXORQ Z3, Z3, Z3
+	VPXORQ Z4, Z4, Z4
+	TESTQ  CX, CX
+	JEQ    l6
+
+l5:
+	VPMOVZXDQ 0(AX), Z5
+	VPADDQ    Z5, Z0, Z0
+	VPMOVZXDQ 32(AX), Z6
+	VPADDQ    Z6, Z1, Z1
+	VPMOVZXDQ 64(AX), Z5
+	VPADDQ    Z5, Z2, Z2
+	VPMOVZXDQ 96(AX), Z6
+	VPADDQ    Z6, Z3, Z3
+	VPMOVZXDQ 128(AX), Z5
+	VPADDQ    Z5, Z4, Z4
+	ADDQ      $0x00000000000000a0, AX
+	DECQ      CX
+	JNE       l5
+
+l6:
+	MOVQ      t+0(FP), AX
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VMOVDQU64 Z4, 256(AX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(t *[40]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX2(SB), NOSPLIT, $0-24
+	MOVQ  a+8(FP), AX
+	MOVQ  n+16(FP), CX
+	SHRQ  $1, CX       // number of groups of 2 elements
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+	VPXOR Y2, Y2, Y2
+	VPXOR Y3, Y3, Y3
+	VPXOR Y4, Y4, Y4
+	TESTQ CX, CX
+	JEQ   l8
+
+l7:
+	VPMOVZXDQ 0(AX), Y5
+	VPADDQ    Y5, Y0, Y0
+	VPMOVZXDQ 16(AX), Y6
+	VPADDQ    Y6, Y1, Y1
+	VPMOVZXDQ 32(AX), Y5
+	VPADDQ    Y5, Y2, Y2
+	VPMOVZXDQ 48(AX), Y6
+	VPADDQ    Y6, Y3, Y3
+	VPMOVZXDQ 64(AX), Y5
+	VPADDQ    Y5, Y4, Y4
+	ADDQ      $0x0000000000000050, AX
+	DECQ      CX
+	JNE       l7
+
+l8:
+	MOVQ    t+0(FP), AX
+	VMOVDQU Y0, 0(AX)
+	VMOVDQU Y1, 32(AX)
+	VMOVDQU Y2, 64(AX)
+	VMOVDQU Y3, 96(AX)
+	VMOVDQU Y4, 128(AX)
+	VZEROUPPER
+	RET
@@ -71,7 +71,6 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(7)
 
 	if len(shift) != 0 {
@@ -102,7 +101,6 @@ func Generator(m uint64) (fr.Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity fr.Element
-
 	rootOfUnity.SetString("16532287748948254263922689505213135976137839535221842169193829039521719560631")
 	const maxOrderRoot uint64 = 60
 
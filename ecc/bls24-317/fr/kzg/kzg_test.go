@@ -26,6 +26,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
 )
 
 // testSRS re-used accross tests of the KZG scheme
@@ -324,6 +325,66 @@ func TestBatchVerifyMultiPoints(t *testing.T) {
 
 }
 
+func TestCommitLagrange(t *testing.T) {
+
+	const size = 64
+	srsLagrange, err := NewSRSLagrange(testSRS, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// evaluations of a random polynomial on the domain
+	evaluations := randomPolynomial(size)
+	digest, err := CommitLagrange(evaluations, srsLagrange)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// commit to the polynomial in canonical form
+	domain := fft.NewDomain(size)
+	coefficients := make([]fr.Element, size)
+	copy(coefficients, evaluations)
+	domain.FFTInverse(coefficients, fft.DIF)
+	fft.BitReverse(coefficients)
+	expected, err := Commit(coefficients, testSRS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !digest.Equal(&expected) {
+		t.Fatal("commitments in canonical and Lagrange bases differ")
+	}
+
+	if _, err = NewSRSLagrange(testSRS, 48); err != ErrInvalidLagrangeSize {
+		t.Fatal("size of the Lagrange basis should be a power of 2")
+	}
+	if _, err = NewSRSLagrange(testSRS, uint64(2*len(testSRS.G1))); err != ErrInvalidLagrangeSize {
+		t.Fatal("size of the Lagrange basis should not exceed the size of the srs")
+	}
+}
+
+func TestSerializationSRSLagrange(t *testing.T) {
+
+	srs, err := NewSRSLagrange(testSRS, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err = srs.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var _srs SRSLagrange
+	if _, err = _srs.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(srs, &_srs) {
+		t.Fatal("scheme serialization failed")
+	}
+}
+
 const benchSize = 1 << 16
 
 func BenchmarkKZGCommit(b *testing.B) {
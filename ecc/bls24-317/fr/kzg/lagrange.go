@@ -0,0 +1,168 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var ErrInvalidLagrangeSize = errors.New("lagrange srs size must be a power of 2, smaller than the size of the srs")
+
+// SRSLagrange stores the result of the MPC in Lagrange basis, for the domain
+// of size len(G1) generated by fft.Generator
+//
+// implements io.ReaderFrom and io.WriterTo
+type SRSLagrange struct {
+	G1 []bls24317.G1Affine  // [L₀(α)]G₁, [L₁(α)]G₁, ... where Lᵢ is the i-th Lagrange polynomial on the domain
+	G2 [2]bls24317.G2Affine // [G₂, [α]G₂ ]
+}
+
+// NewSRSLagrange returns the SRS in Lagrange basis for the domain of the
+// given size, so that polynomials can be committed to from their
+// evaluations on the domain with CommitLagrange.
+//
+// size must be a power of 2, and not larger than len(srs.G1).
+func NewSRSLagrange(srs *SRS, size uint64) (*SRSLagrange, error) {
+	if size > uint64(len(srs.G1)) {
+		return nil, ErrInvalidLagrangeSize
+	}
+	g1, err := ToLagrangeG1(srs.G1[:size])
+	if err != nil {
+		return nil, err
+	}
+	return &SRSLagrange{
+		G1: g1,
+		G2: srs.G2,
+	}, nil
+}
+
+// ToLagrangeG1 returns the Lagrange form of the canonical basis coeffs.
+//
+// From the canonical basis [αⁱ]G₁ of size n, it computes the Lagrange basis
+// [Lⱼ(α)]G₁ = 1/n ∑ᵢ ω⁻ⁱʲ[αⁱ]G₁ of the domain generated by ω, that is an
+// inverse FFT on the group elements.
+//
+// len(coeffs) must be a power of 2.
+func ToLagrangeG1(coeffs []bls24317.G1Affine) ([]bls24317.G1Affine, error) {
+	n := uint64(len(coeffs))
+	if n == 0 || n&(n-1) != 0 {
+		return nil, ErrInvalidLagrangeSize
+	}
+
+	omega, err := fft.Generator(n)
+	if err != nil {
+		return nil, err
+	}
+	var omegaInv fr.Element
+	omegaInv.Inverse(&omega)
+
+	a := make([]bls24317.G1Jac, n)
+	for i := range coeffs {
+		a[i].FromAffine(&coeffs[i])
+	}
+	bitReverse(a)
+
+	// iterative radix-2 decimation in time
+	twiddles := make([]big.Int, n/2)
+	for m := uint64(2); m <= n; m <<= 1 {
+		half := m >> 1
+
+		// twiddles[j] = ω⁻ʲⁿᐟᵐ
+		var w, wm fr.Element
+		wm.Exp(omegaInv, new(big.Int).SetUint64(n/m))
+		w.SetOne()
+		for j := uint64(0); j < half; j++ {
+			w.BigInt(&twiddles[j])
+			w.Mul(&w, &wm)
+		}
+
+		parallel.Execute(int(n/2), func(start, end int) {
+			var t bls24317.G1Jac
+			for b := uint64(start); b < uint64(end); b++ {
+				j := b % half
+				k := (b/half)*m + j
+				if j == 0 {
+					t.Set(&a[k+half])
+				} else {
+					t.ScalarMultiplication(&a[k+half], &twiddles[j])
+				}
+				a[k+half].Set(&a[k])
+				a[k+half].SubAssign(&t)
+				a[k].AddAssign(&t)
+			}
+		})
+	}
+
+	// scale by 1/n
+	var nInv fr.Element
+	var nInvBigInt big.Int
+	nInv.SetUint64(n).Inverse(&nInv)
+	nInv.BigInt(&nInvBigInt)
+	parallel.Execute(int(n), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].ScalarMultiplication(&a[i], &nInvBigInt)
+		}
+	})
+
+	return bls24317.BatchJacobianToAffineG1(a), nil
+}
+
+// CommitLagrange commits to a polynomial given by its evaluations on the
+// domain of the SRS, using a multi exponentiation with the Lagrange basis.
+// evaluations[i] is the evaluation at ωⁱ, in natural order; missing
+// evaluations are considered to be zero.
+func CommitLagrange(evaluations []fr.Element, srs *SRSLagrange, nbTasks ...int) (Digest, error) {
+
+	if len(evaluations) == 0 || len(evaluations) > len(srs.G1) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+
+	var res bls24317.G1Affine
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(srs.G1[:len(evaluations)], evaluations, config); err != nil {
+		return Digest{}, err
+	}
+
+	return res, nil
+}
+
+// bitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2.
+func bitReverse(a []bls24317.G1Jac) {
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		irev := bits.Reverse64(i) >> nn
+		if irev > i {
+			a[i], a[irev] = a[irev], a[i]
+		}
+	}
+}
@@ -18,46 +18,130 @@ package pedersen
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
+
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
-	"math/big"
 )
 
-// Key for proof and verification
-type Key struct {
-	g             bls24317.G2Affine // TODO @tabaie: does this really have to be randomized?
-	gRootSigmaNeg bls24317.G2Affine //gRootSigmaNeg = g^{-1/σ}
+var (
+	errInvalidSigma   = errors.New("σ must be non-zero modulo r")
+	errLengthMismatch = errors.New("commitments and knowledge proofs must have the same length")
+	errSubgroupCheck  = errors.New("subgroup check failed")
+	errProofRejected  = errors.New("proof rejected")
+)
+
+// ProvingKey for committing and proofs of knowledge
+type ProvingKey struct {
 	basis         []bls24317.G1Affine
 	basisExpSigma []bls24317.G1Affine
 }
 
-func randomOnG2() (bls24317.G2Affine, error) { // TODO: Add to G2.go?
+// VerifyingKey for the verification of proofs of knowledge
+type VerifyingKey struct {
+	g             bls24317.G2Affine // TODO @tabaie: does this really have to be randomized?
+	gRootSigmaNeg bls24317.G2Affine //gRootSigmaNeg = g^{-1/σ}
+}
+
+// Key for proof and verification
+type Key struct {
+	ProvingKey
+	VerifyingKey
+}
+
+// SetupOption defines option for altering the behavior of Setup.
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type SetupOption func(*setupConfig)
+
+type setupConfig struct {
+	rand  io.Reader
+	sigma *big.Int
+	g     *bls24317.G2Affine
+}
+
+// WithRandomness sets the source of randomness from which σ and the G2 point
+// are sampled, instead of crypto/rand. A deterministic source recreates the
+// same key.
+func WithRandomness(r io.Reader) SetupOption {
+	return func(cfg *setupConfig) {
+		cfg.rand = r
+	}
+}
+
+// WithSigma sets the secret σ, for instance as produced by an MPC ceremony,
+// instead of sampling it. Setup returns an error if σ is nil or zero modulo r.
+func WithSigma(sigma *big.Int) SetupOption {
+	return func(cfg *setupConfig) {
+		// a nil σ is rejected by Setup as σ = 0
+		cfg.sigma = new(big.Int)
+		if sigma != nil {
+			cfg.sigma.Set(sigma)
+		}
+	}
+}
+
+// WithG2Point sets the G2 point of the verifying key instead of sampling it.
+func WithG2Point(g bls24317.G2Affine) SetupOption {
+	return func(cfg *setupConfig) {
+		cfg.g = &g
+	}
+}
+
+// default options
+func setupOptions(opts ...SetupOption) setupConfig {
+	cfg := setupConfig{
+		rand: rand.Reader,
+	}
+	for _, option := range opts {
+		option(&cfg)
+	}
+	return cfg
+}
+
+func randomOnG2(r io.Reader) (bls24317.G2Affine, error) { // TODO: Add to G2.go?
 	gBytes := make([]byte, fr.Bytes)
-	if _, err := rand.Read(gBytes); err != nil {
+	if _, err := io.ReadFull(r, gBytes); err != nil {
 		return bls24317.G2Affine{}, err
 	}
 	return bls24317.HashToG2(gBytes, []byte("random on g2"))
 }
 
-func Setup(basis []bls24317.G1Affine) (Key, error) {
+// Setup returns a key for committing to vectors on the given basis, and
+// proving knowledge of the committed values. By default σ and the G2 point of
+// the verifying key are sampled with crypto/rand, see WithRandomness,
+// WithSigma and WithG2Point.
+func Setup(basis []bls24317.G1Affine, options ...SetupOption) (Key, error) {
 	var (
 		k   Key
 		err error
 	)
+	cfg := setupOptions(options...)
 
-	if k.g, err = randomOnG2(); err != nil {
+	if cfg.g != nil {
+		k.g = *cfg.g
+	} else if k.g, err = randomOnG2(cfg.rand); err != nil {
 		return k, err
 	}
 
-	var modMinusOne big.Int
-	modMinusOne.Sub(fr.Modulus(), big.NewInt(1))
-	var sigma *big.Int
-	if sigma, err = rand.Int(rand.Reader, &modMinusOne); err != nil {
-		return k, err
+	sigma := cfg.sigma
+	if sigma == nil {
+		var modMinusOne big.Int
+		modMinusOne.Sub(fr.Modulus(), big.NewInt(1))
+		if sigma, err = rand.Int(cfg.rand, &modMinusOne); err != nil {
+			return k, err
+		}
+		sigma.Add(sigma, big.NewInt(1))
+	} else {
+		sigma.Mod(sigma, fr.Modulus())
+		if sigma.Sign() == 0 {
+			return k, errInvalidSigma
+		}
 	}
-	sigma.Add(sigma, big.NewInt(1))
 
 	var sigmaInvNeg big.Int
 	sigmaInvNeg.ModInverse(sigma, fr.Modulus())
@@ -73,9 +157,9 @@ func Setup(basis []bls24317.G1Affine) (Key, error) {
 	return k, err
 }
 
-func (k *Key) Commit(values []fr.Element) (commitment bls24317.G1Affine, knowledgeProof bls24317.G1Affine, err error) {
+func (pk *ProvingKey) Commit(values []fr.Element) (commitment bls24317.G1Affine, knowledgeProof bls24317.G1Affine, err error) {
 
-	if len(values) != len(k.basis) {
+	if len(values) != len(pk.basis) {
 		err = fmt.Errorf("unexpected number of values")
 		return
 	}
@@ -86,28 +170,133 @@ func (k *Key) Commit(values []fr.Element) (commitment bls24317.G1Affine, knowled
 		NbTasks: 1, // TODO Experiment
 	}
 
-	if _, err = commitment.MultiExp(k.basis, values, config); err != nil {
+	if _, err = commitment.MultiExp(pk.basis, values, config); err != nil {
 		return
 	}
 
-	_, err = knowledgeProof.MultiExp(k.basisExpSigma, values, config)
+	_, err = knowledgeProof.MultiExp(pk.basisExpSigma, values, config)
 
 	return
 }
 
 // VerifyKnowledgeProof checks if the proof of knowledge is valid
-func (k *Key) VerifyKnowledgeProof(commitment bls24317.G1Affine, knowledgeProof bls24317.G1Affine) error {
+func (vk *VerifyingKey) VerifyKnowledgeProof(commitment bls24317.G1Affine, knowledgeProof bls24317.G1Affine) error {
 
 	if !commitment.IsInSubGroup() || !knowledgeProof.IsInSubGroup() {
-		return fmt.Errorf("subgroup check failed")
+		return errSubgroupCheck
 	}
 
-	product, err := bls24317.Pair([]bls24317.G1Affine{commitment, knowledgeProof}, []bls24317.G2Affine{k.g, k.gRootSigmaNeg})
+	product, err := bls24317.Pair([]bls24317.G1Affine{commitment, knowledgeProof}, []bls24317.G2Affine{vk.g, vk.gRootSigmaNeg})
 	if err != nil {
 		return err
 	}
 	if product.IsOne() {
 		return nil
 	}
-	return fmt.Errorf("proof rejected")
+	return errProofRejected
+}
+
+// BatchVerifyKnowledgeProofs checks the proofs of knowledge of many
+// commitments under the same verifying key. It samples random coefficients
+// λᵢ and checks
+//
+// e(∑ λᵢ⋅Cᵢ, g) ⋅ e(∑ λᵢ⋅πᵢ, g^{-1/σ}) ?= 1
+//
+// so that the cost is two multi-scalar multiplications and a single pairing
+// check. If it fails, at least one of the proofs is invalid.
+func (vk *VerifyingKey) BatchVerifyKnowledgeProofs(commitments, knowledgeProofs []bls24317.G1Affine) error {
+	if len(commitments) != len(knowledgeProofs) {
+		return errLengthMismatch
+	}
+	if len(commitments) == 0 {
+		return nil
+	}
+	for i := range commitments {
+		if !commitments[i].IsInSubGroup() || !knowledgeProofs[i].IsInSubGroup() {
+			return errSubgroupCheck
+		}
+	}
+
+	lambda, err := randomCoefficients(len(commitments))
+	if err != nil {
+		return err
+	}
+
+	var foldedCommitment, foldedProof bls24317.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err = foldedCommitment.MultiExp(commitments, lambda, config); err != nil {
+		return err
+	}
+	if _, err = foldedProof.MultiExp(knowledgeProofs, lambda, config); err != nil {
+		return err
+	}
+
+	ok, err := bls24317.PairingCheck([]bls24317.G1Affine{foldedCommitment, foldedProof}, []bls24317.G2Affine{vk.g, vk.gRootSigmaNeg})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errProofRejected
+	}
+	return nil
+}
+
+// BatchVerifyMultiVk checks the proofs of knowledge of many commitments,
+// where knowledgeProofs[i] is a proof of knowledge of commitments[i] under
+// vks[i]. It samples random coefficients λᵢ and checks
+//
+// ∏ e(λᵢ⋅Cᵢ, gᵢ) ⋅ e(λᵢ⋅πᵢ, gᵢ^{-1/σᵢ}) ?= 1
+//
+// with a single final exponentiation. If it fails, at least one of the proofs
+// is invalid.
+func BatchVerifyMultiVk(vks []VerifyingKey, commitments, knowledgeProofs []bls24317.G1Affine) error {
+	n := len(vks)
+	if len(commitments) != n || len(knowledgeProofs) != n {
+		return errLengthMismatch
+	}
+	if n == 0 {
+		return nil
+	}
+	for i := range commitments {
+		if !commitments[i].IsInSubGroup() || !knowledgeProofs[i].IsInSubGroup() {
+			return errSubgroupCheck
+		}
+	}
+
+	lambda, err := randomCoefficients(n)
+	if err != nil {
+		return err
+	}
+
+	P := make([]bls24317.G1Affine, 2*n)
+	Q := make([]bls24317.G2Affine, 2*n)
+	var l big.Int
+	for i := 0; i < n; i++ {
+		lambda[i].BigInt(&l)
+		P[2*i].ScalarMultiplication(&commitments[i], &l)
+		P[2*i+1].ScalarMultiplication(&knowledgeProofs[i], &l)
+		Q[2*i] = vks[i].g
+		Q[2*i+1] = vks[i].gRootSigmaNeg
+	}
+
+	ok, err := bls24317.PairingCheck(P, Q)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errProofRejected
+	}
+	return nil
+}
+
+// randomCoefficients samples n random coefficients, the first one being 1.
+func randomCoefficients(n int) ([]fr.Element, error) {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		if _, err := res[i].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
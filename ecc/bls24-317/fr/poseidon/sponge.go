@@ -0,0 +1,155 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon
+
+import (
+	"errors"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+const (
+	// BlockSize size that the sponge consumes
+	BlockSize = fr.Bytes
+
+	// DefaultWidth is the width of the permutation used by NewPoseidon
+	DefaultWidth = 3
+)
+
+// sponge absorbs field elements with a permutation of width t, with rate t-1
+// and capacity 1.
+type sponge struct {
+	width       int
+	permutation func([]fr.Element) error
+	data        []fr.Element // data to hash
+}
+
+// NewPoseidon returns a Poseidon sponge built on the permutation of width
+// DefaultWidth with the default parameters.
+func NewPoseidon() hash.Hash {
+	params, err := NewDefaultParameters(DefaultWidth)
+	if err != nil {
+		panic(err) // DefaultWidth has default parameters
+	}
+	return NewSponge(params.Width, params.Permutation)
+}
+
+// NewSponge returns a sponge of rate width-1 and capacity 1 built on the
+// given permutation of width elements. The capacity element is initialized
+// with the number of absorbed elements, so that inputs of different lengths
+// are domain separated.
+func NewSponge(width int, permutation func([]fr.Element) error) hash.Hash {
+	return &sponge{
+		width:       width,
+		permutation: permutation,
+	}
+}
+
+// Reset resets the Hash to its initial state.
+func (d *sponge) Reset() {
+	d.data = nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *sponge) Sum(b []byte) []byte {
+	h, err := d.checksum()
+	if err != nil {
+		panic(err) // the width of the permutation is fixed at construction
+	}
+	hash := h.Bytes()
+	return append(b, hash[:]...)
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *sponge) Size() int {
+	return BlockSize
+}
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount
+// of data, but it may operate more efficiently if all writes
+// are a multiple of the block size.
+func (d *sponge) BlockSize() int {
+	return BlockSize
+}
+
+// Write (via the embedded io.Writer interface) adds more data to the running hash.
+//
+// Each []byte block of size BlockSize represents a big endian fr.Element.
+//
+// If len(p) is not a multiple of BlockSize and any of the []byte in p represent an integer
+// larger than fr.Modulus, this function returns an error.
+//
+// To hash arbitrary data ([]byte not representing canonical field elements) use fr.Hash first
+func (d *sponge) Write(p []byte) (int, error) {
+
+	var start int
+	for start = 0; start < len(p); start += BlockSize {
+		if start+BlockSize > len(p) {
+			break
+		}
+		if elem, err := fr.BigEndian.Element((*[BlockSize]byte)(p[start : start+BlockSize])); err == nil {
+			d.data = append(d.data, elem)
+		} else {
+			return 0, err
+		}
+	}
+
+	if start != len(p) {
+		return 0, errors.New("invalid input length: must represent a list of field elements, expects a []byte of len m*BlockSize")
+	}
+	return len(p), nil
+}
+
+// checksum absorbs the data rate elements at a time, applying the
+// permutation after each chunk, and squeezes the first rate element.
+func (d *sponge) checksum() (fr.Element, error) {
+	state := make([]fr.Element, d.width)
+	state[0].SetUint64(uint64(len(d.data)))
+
+	rate := d.width - 1
+	data := d.data
+	for {
+		n := len(data)
+		if n > rate {
+			n = rate
+		}
+		for i := 0; i < n; i++ {
+			state[i+1].Add(&state[i+1], &data[i])
+		}
+		if err := d.permutation(state); err != nil {
+			return fr.Element{}, err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			break
+		}
+	}
+
+	return state[1], nil
+}
+
+// WriteString writes a string that doesn't necessarily consist of field elements
+func (d *sponge) WriteString(rawBytes []byte) {
+	if elems, err := fr.Hash(rawBytes, []byte("string:"), 1); err != nil {
+		panic(err)
+	} else {
+		d.data = append(d.data, elems[0])
+	}
+}
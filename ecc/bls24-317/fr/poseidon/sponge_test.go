@@ -0,0 +1,94 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+func TestSponge(t *testing.T) {
+	t.Parallel()
+
+	var a, b fr.Element
+	a.SetRandom()
+	b.SetRandom()
+	ab, bb := a.Bytes(), b.Bytes()
+
+	sum := func(elements ...[]byte) []byte {
+		h := NewPoseidon()
+		for _, e := range elements {
+			if _, err := h.Write(e); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return h.Sum(nil)
+	}
+
+	// Sum is deterministic and does not change the state
+	h := NewPoseidon()
+	h.Write(ab[:])
+	h.Write(bb[:])
+	s1 := h.Sum(nil)
+	s2 := h.Sum(nil)
+	if !bytes.Equal(s1, s2) || !bytes.Equal(s1, sum(ab[:], bb[:])) {
+		t.Fatal("Sum should be deterministic")
+	}
+	if len(s1) != h.Size() {
+		t.Fatal("unexpected digest size")
+	}
+
+	// inputs of different lengths are domain separated
+	var zero [BlockSize]byte
+	if bytes.Equal(sum(), sum(zero[:])) || bytes.Equal(sum(zero[:]), sum(zero[:], zero[:])) {
+		t.Fatal("inputs of different lengths should not collide")
+	}
+
+	// inputs longer than the rate
+	if bytes.Equal(sum(ab[:], bb[:], ab[:]), sum(ab[:], bb[:], bb[:])) {
+		t.Fatal("the last chunk should be absorbed")
+	}
+
+	h.Reset()
+	if !bytes.Equal(h.Sum(nil), sum()) {
+		t.Fatal("Reset should clear the state")
+	}
+
+	// non canonical inputs are rejected
+	var q [BlockSize]byte
+	fr.Modulus().FillBytes(q[:])
+	if _, err := NewPoseidon().Write(q[:]); err == nil {
+		t.Fatal("non canonical field element should be rejected")
+	}
+	if _, err := NewPoseidon().Write(ab[:BlockSize-1]); err == nil {
+		t.Fatal("input of invalid length should be rejected")
+	}
+}
+
+func TestPoseidonFiatShamir(t *testing.T) {
+	fs := fiatshamir.NewTranscript(NewPoseidon(), "c0")
+	zero := make([]byte, BlockSize)
+	if err := fs.Bind("c0", zero); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.ComputeChallenge("c0"); err != nil {
+		t.Fatal(err)
+	}
+}
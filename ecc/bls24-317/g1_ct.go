@@ -0,0 +1,242 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// g1ProjCT is a point in homogeneous projective coordinates (x = X/Z, y = Y/Z), the
+// point at infinity being (0, 1, 0).
+//
+// The complete formulas of Renes, Costello and Batina (https://eprint.iacr.org/2015/1060)
+// apply to all the pairs of points; with the constant-time operations of fp, they don't
+// branch on the coordinates.
+type g1ProjCT struct {
+	X, Y, Z fp.Element
+}
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s
+//
+// It runs in constant time with respect to s, which is reduced modulo r: a fixed 4-bit
+// window processes all the fr.Bits bits of the scalar, the multiples of a are read with a
+// constant-time table lookup and added with complete formulas. It is much slower than
+// ScalarMultiplication, and should be used when s is secret.
+//
+// Note that the reduction of s and the conversions from big.Int don't run in constant time
+// with respect to the bit length of s.
+func (p *G1Jac) ScalarMultiplicationCT(a *G1Jac, s *big.Int) *G1Jac {
+	var q g1ProjCT
+	q.fromJacobian(a)
+	q.mulWindowedCT(&q, s)
+	return q.toJacobian(p)
+}
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s, in constant time with respect to s.
+//
+// See G1Jac.ScalarMultiplicationCT.
+func (p *G1Affine) ScalarMultiplicationCT(a *G1Affine, s *big.Int) *G1Affine {
+	var q g1ProjCT
+	if a.IsInfinity() {
+		q.setInfinity()
+	} else {
+		q.X, q.Y = a.X, a.Y
+		q.Z.SetOne()
+	}
+	q.mulWindowedCT(&q, s)
+	return q.toAffine(p)
+}
+
+// ScalarMultiplicationBaseCT computes and returns p = g ⋅ s where g is the prime subgroup
+// generator, in constant time with respect to s.
+//
+// See G1Jac.ScalarMultiplicationCT.
+func (p *G1Affine) ScalarMultiplicationBaseCT(s *big.Int) *G1Affine {
+	var q g1ProjCT
+	q.fromJacobian(&g1Gen)
+	q.mulWindowedCT(&q, s)
+	return q.toAffine(p)
+}
+
+// setInfinity sets p to (0, 1, 0)
+func (p *g1ProjCT) setInfinity() *g1ProjCT {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetZero()
+	return p
+}
+
+// fromJacobian sets p to a; (X, Y, Z) in Jacobian coordinates is (X⋅Z, Y, Z³) in projective
+// coordinates, and the point at infinity (1, 1, 0) maps to (0, 1, 0).
+func (p *g1ProjCT) fromJacobian(a *G1Jac) *g1ProjCT {
+	var z fp.Element
+	z.MulCT(&a.Z, &a.Z).MulCT(&z, &a.Z)
+	p.X.MulCT(&a.X, &a.Z)
+	p.Y = a.Y
+	p.Z = z
+	return p
+}
+
+// toJacobian sets r to p and returns r; (X, Y, Z) in projective coordinates is (X⋅Z, Y⋅Z², Z)
+// in Jacobian coordinates, and the point at infinity maps to (1, 1, 0).
+func (p *g1ProjCT) toJacobian(r *G1Jac) *G1Jac {
+	var x, y, zz, one fp.Element
+	zz.MulCT(&p.Z, &p.Z)
+	x.MulCT(&p.X, &p.Z)
+	y.MulCT(&p.Y, &zz)
+
+	var w uint64
+	for i := range p.Z {
+		w |= p.Z[i]
+	}
+	isInfinity := int(((w | -w) >> 63) ^ 1)
+	one.SetOne()
+	r.X.Select(isInfinity, &x, &one)
+	r.Y.Select(isInfinity, &y, &one)
+	r.Z = p.Z
+	return r
+}
+
+// toAffine sets r to p and returns r; the inverse of Z is computed in constant time, and
+// the point at infinity maps to (0, 0).
+func (p *g1ProjCT) toAffine(r *G1Affine) *G1Affine {
+	var zInv fp.Element
+	zInv.InverseCT(&p.Z)
+	r.X.MulCT(&p.X, &zInv)
+	r.Y.MulCT(&p.Y, &zInv)
+	return r
+}
+
+// mulWindowedCT sets p = a ⋅ s with a fixed 4-bit window, in constant time with respect to s
+func (p *g1ProjCT) mulWindowedCT(a *g1ProjCT, s *big.Int) *g1ProjCT {
+	var e fr.Element
+	e.SetBigInt(s)
+	bits := e.Bits()
+
+	// table[i] = i⋅a
+	var table [16]g1ProjCT
+	table[0].setInfinity()
+	table[1] = *a
+	for i := 2; i < len(table); i++ {
+		table[i].add(&table[i-1], a)
+	}
+
+	const nbWindows = (fr.Bits + 3) / 4
+	var res, t g1ProjCT
+	res.setInfinity()
+	for i := nbWindows - 1; i >= 0; i-- {
+		res.double(&res)
+		res.double(&res)
+		res.double(&res)
+		res.double(&res)
+		w := (bits[(4*i)/64] >> ((4 * i) % 64)) & 0xf
+		t.lookupCT(&table, w)
+		res.add(&res, &t)
+	}
+	*p = res
+	return p
+}
+
+// lookupCT sets p = table[i], reading all the entries of the table
+func (p *g1ProjCT) lookupCT(table *[16]g1ProjCT, i uint64) {
+	p.X.SetZero()
+	p.Y.SetZero()
+	p.Z.SetZero()
+	for j := range table {
+		c := subtle.ConstantTimeEq(int32(j), int32(i))
+		p.X.Select(c, &p.X, &table[j].X)
+		p.Y.Select(c, &p.Y, &table[j].Y)
+		p.Z.Select(c, &p.Z, &table[j].Z)
+	}
+}
+
+// add sets p = a + b and returns p, with the complete formulas for a = 0
+// (https://eprint.iacr.org/2015/1060, algorithm 7)
+func (p *g1ProjCT) add(a, b *g1ProjCT) *g1ProjCT {
+	var t0, t1, t2, t3, t4, x3, y3, z3, b3 fp.Element
+	b3.AddCT(&bCurveCoeff, &bCurveCoeff).AddCT(&b3, &bCurveCoeff)
+
+	t0.MulCT(&a.X, &b.X)
+	t1.MulCT(&a.Y, &b.Y)
+	t2.MulCT(&a.Z, &b.Z)
+	t3.AddCT(&a.X, &a.Y)
+	t4.AddCT(&b.X, &b.Y)
+	t3.MulCT(&t3, &t4)
+	t4.AddCT(&t0, &t1)
+	t3.SubCT(&t3, &t4)
+	t4.AddCT(&a.Y, &a.Z)
+	x3.AddCT(&b.Y, &b.Z)
+	t4.MulCT(&t4, &x3)
+	x3.AddCT(&t1, &t2)
+	t4.SubCT(&t4, &x3)
+	x3.AddCT(&a.X, &a.Z)
+	y3.AddCT(&b.X, &b.Z)
+	x3.MulCT(&x3, &y3)
+	y3.AddCT(&t0, &t2)
+	y3.SubCT(&x3, &y3)
+	x3.AddCT(&t0, &t0)
+	t0.AddCT(&x3, &t0)
+	t2.MulCT(&b3, &t2)
+	z3.AddCT(&t1, &t2)
+	t1.SubCT(&t1, &t2)
+	y3.MulCT(&b3, &y3)
+	x3.MulCT(&t4, &y3)
+	t2.MulCT(&t3, &t1)
+	x3.SubCT(&t2, &x3)
+	y3.MulCT(&y3, &t0)
+	t1.MulCT(&t1, &z3)
+	y3.AddCT(&t1, &y3)
+	t0.MulCT(&t0, &t3)
+	z3.MulCT(&z3, &t4)
+	z3.AddCT(&z3, &t0)
+
+	p.X, p.Y, p.Z = x3, y3, z3
+	return p
+}
+
+// double sets p = 2a and returns p, with the complete formulas for a = 0
+// (https://eprint.iacr.org/2015/1060, algorithm 9)
+func (p *g1ProjCT) double(a *g1ProjCT) *g1ProjCT {
+	var t0, t1, t2, x3, y3, z3, b3 fp.Element
+	b3.AddCT(&bCurveCoeff, &bCurveCoeff).AddCT(&b3, &bCurveCoeff)
+
+	t0.MulCT(&a.Y, &a.Y)
+	z3.AddCT(&t0, &t0)
+	z3.AddCT(&z3, &z3)
+	z3.AddCT(&z3, &z3)
+	t1.MulCT(&a.Y, &a.Z)
+	t2.MulCT(&a.Z, &a.Z)
+	t2.MulCT(&b3, &t2)
+	x3.MulCT(&t2, &z3)
+	y3.AddCT(&t0, &t2)
+	z3.MulCT(&t1, &z3)
+	t1.AddCT(&t2, &t2)
+	t2.AddCT(&t1, &t2)
+	t0.SubCT(&t0, &t2)
+	y3.MulCT(&t0, &y3)
+	y3.AddCT(&x3, &y3)
+	t1.MulCT(&a.X, &a.Y)
+	x3.MulCT(&t0, &t1)
+	x3.AddCT(&x3, &x3)
+
+	p.X, p.Y, p.Z = x3, y3, z3
+	return p
+}
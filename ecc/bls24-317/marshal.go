@@ -26,7 +26,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/internal/fptower"
-	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils"
 )
 
 // To encode G1Affine and G2Affine points, we mask the most significant bits with these bits to specify without ambiguity
@@ -208,7 +208,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 			}
 		}
 		var nbErrs uint64
-		parallel.Execute(len(compressed), func(start, end int) {
+		utils.Parallelize(len(compressed), func(start, end int) {
 			for i := start; i < end; i++ {
 				if compressed[i] {
 					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
@@ -267,7 +267,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 			}
 		}
 		var nbErrs uint64
-		parallel.Execute(len(compressed), func(start, end int) {
+		utils.Parallelize(len(compressed), func(start, end int) {
 			for i := start; i < end; i++ {
 				if compressed[i] {
 					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
@@ -0,0 +1,699 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"errors"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// MultiExpBatchG1 computes the multi-exponentiations of the same points by several vectors of
+// scalars: res[k] = ∑ᵢ [scalars[k][i]]points[i].
+//
+// The scalar vectors share the window size and, for each window, a single pass over the points:
+// each point is loaded once and added to the buckets of every vector, and the affine additions
+// of all the vectors share the same batch inversions. Each window holds the buckets of all the
+// vectors, at most config.NbTasks windows are processed concurrently.
+//
+// This call return an error if len(scalars[k]) != len(points) or if provided config is invalid.
+func MultiExpBatchG1(points []G1Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G1Jac, error) {
+	nbPoints := len(points)
+	for k := range scalars {
+		if len(scalars[k]) != nbPoints {
+			return nil, errors.New("len(points) != len(scalars[k])")
+		}
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	res := make([]G1Jac, len(scalars))
+	if len(scalars) <= 1 {
+		for k := range scalars {
+			if _, err := res[k].MultiExp(points, scalars[k], config); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+
+	_innerMsmBatchG1(res, bestCG1(nbPoints, fr.Bits), points, scalars, config)
+	return res, nil
+}
+
+func _innerMsmBatchG1(res []G1Jac, c uint64, points []G1Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) []G1Jac {
+	nbPoints := len(points)
+	nbChunks := int(computeNbChunks(c))
+
+	// partition the scalars
+	digits := make([][]uint16, len(scalars))
+	chunkStats := make([][]chunkStat, len(scalars))
+	for k := range scalars {
+		digits[k], chunkStats[k] = partitionScalars(scalars[k], c, config.NbTasks)
+	}
+
+	chChunks := make([][]chan g1JacExtended, len(scalars))
+	for k := range chChunks {
+		chChunks[k] = make([]chan g1JacExtended, nbChunks)
+		for j := range chChunks[k] {
+			chChunks[k][j] = make(chan g1JacExtended, 1)
+		}
+	}
+
+	// the last chunk may be processed with a different window size, each vector then
+	// uses its own buckets.
+	processChunkBatch := getChunkProcessorBatchG1(c)
+	chTasks := make(chan struct{}, config.NbTasks)
+	for j := nbChunks - 1; j >= 0; j-- {
+		chunkDigits := make([][]uint16, len(scalars))
+		chRes := make([]chan g1JacExtended, len(scalars))
+		for k := range scalars {
+			chunkDigits[k] = digits[k][j*nbPoints : (j+1)*nbPoints]
+			chRes[k] = chChunks[k][j]
+		}
+		chTasks <- struct{}{}
+		go func(j int) {
+			defer func() { <-chTasks }()
+			if processChunkBatch != nil && j != nbChunks-1 {
+				processChunkBatch(uint64(j), chRes, c, points, chunkDigits)
+				return
+			}
+			chunkC := c
+			if j == nbChunks-1 {
+				chunkC = lastC(c)
+			}
+			for k := range chunkDigits {
+				processChunk := getChunkProcessorG1(chunkC, chunkStats[k][j])
+				processChunk(uint64(j), chRes[k], c, points, chunkDigits[k])
+			}
+		}(j)
+	}
+
+	for k := range res {
+		msmReduceChunkG1Affine(&res[k], int(c), chChunks[k])
+	}
+	return res
+}
+
+// getChunkProcessorBatchG1 returns the batch affine algorithm processing a chunk of several
+// vectors of scalars, or nil if c is too small for the batch affine additions.
+func getChunkProcessorBatchG1(c uint64) func(chunkID uint64, chRes []chan g1JacExtended, c uint64, points []G1Affine, digits [][]uint16) {
+	switch c {
+	case 10:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC10, bucketG1AffineC10, bitSetC10, pG1AffineC10, ppG1AffineC10, qG1AffineC10, cG1AffineC10]
+	case 11:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC11, bucketG1AffineC11, bitSetC11, pG1AffineC11, ppG1AffineC11, qG1AffineC11, cG1AffineC11]
+	case 12:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC12, bucketG1AffineC12, bitSetC12, pG1AffineC12, ppG1AffineC12, qG1AffineC12, cG1AffineC12]
+	case 13:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC13, bucketG1AffineC13, bitSetC13, pG1AffineC13, ppG1AffineC13, qG1AffineC13, cG1AffineC13]
+	case 14:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC14, bucketG1AffineC14, bitSetC14, pG1AffineC14, ppG1AffineC14, qG1AffineC14, cG1AffineC14]
+	case 15:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC15, bucketG1AffineC15, bitSetC15, pG1AffineC15, ppG1AffineC15, qG1AffineC15, cG1AffineC15]
+	case 16:
+		return processChunkG1BatchAffineBatch[bucketg1JacExtendedC16, bucketG1AffineC16, bitSetC16, pG1AffineC16, ppG1AffineC16, qG1AffineC16, cG1AffineC16]
+	default:
+		return nil
+	}
+}
+
+// processChunkG1BatchAffineBatch is processChunkG1BatchAffine over several vectors of digits:
+// each vector has its own buckets and queue of conflicting points, but the points are read once,
+// and a batch of affine additions mixes the buckets of all the vectors.
+func processChunkG1BatchAffineBatch[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP pG1Affine, TPP ppG1Affine, TQ qOpsG1Affine, TC cG1Affine](
+	chunk uint64,
+	chRes []chan g1JacExtended,
+	c uint64,
+	points []G1Affine,
+	digits [][]uint16) {
+
+	nbVectors := len(digits)
+
+	// the buckets are on the heap since there is a set per vector;
+	// the g1JacExtended buckets are only needed for doublings and flushed queues,
+	// we allocate them on first use.
+	buckets := make([]B, nbVectors)
+	bucketsJE := make([]*BJE, nbVectors)
+	bucketIds := make([]BS, nbVectors)
+	queues := make([]TQ, nbVectors)
+	qIDs := make([]int, nbVectors)
+	for k := range buckets {
+		for i := 0; i < len(buckets[k]); i++ {
+			buckets[k][i].setInfinity()
+		}
+	}
+	bucketJE := func(k int) *BJE {
+		if bucketsJE[k] == nil {
+			bucketsJE[k] = new(BJE)
+			for i := 0; i < len(*bucketsJE[k]); i++ {
+				(*bucketsJE[k])[i].setInfinity()
+			}
+		}
+		return bucketsJE[k]
+	}
+
+	// setup for the batch affine;
+	var (
+		cptAdd int // count the number of bucket + point added to current batch
+		R      TPP // bucket references
+		P      TP  // points to be added to R (buckets); it is beneficial to store them on the stack (ie copy)
+	)
+
+	batchSize := len(P)
+
+	// vector and bucket of each addition in the current batch, to reset bucketIds
+	batchVectors := make([]int, batchSize)
+	batchBuckets := make([]uint16, batchSize)
+
+	isFull := func() bool { return cptAdd == batchSize }
+
+	executeAndReset := func() {
+		batchAddG1Affine[TP, TPP, TC](&R, &P, cptAdd)
+		for i := 0; i < cptAdd; i++ {
+			bucketIds[batchVectors[i]][batchBuckets[i]] = false
+		}
+		cptAdd = 0
+	}
+
+	addToBatch := func(k int, bucketID uint16, BK *G1Affine) {
+		bucketIds[k][bucketID] = true
+		batchVectors[cptAdd] = k
+		batchBuckets[cptAdd] = bucketID
+		R[cptAdd] = BK
+	}
+
+	addFromQueue := func(k int, op batchOpG1Affine) {
+		// @precondition: must ensures bucket is not "used" in current batch
+		BK := &buckets[k][op.bucketID]
+
+		// handle special cases with inf or -P / P
+		if BK.IsInfinity() {
+			BK.Set(&op.point)
+			return
+		}
+		if BK.X.Equal(&op.point.X) {
+			if BK.Y.Equal(&op.point.Y) {
+				// P + P: doubling, which should be quite rare --
+				// we use the other set of buckets
+				(*bucketJE(k))[op.bucketID].addMixed(&op.point)
+				return
+			}
+			BK.setInfinity()
+			return
+		}
+
+		addToBatch(k, op.bucketID, BK)
+		P[cptAdd] = op.point
+		cptAdd++
+	}
+
+	add := func(k int, bucketID uint16, PP *G1Affine, isAdd bool) {
+		// @precondition: ensures bucket is not "used" in current batch
+		BK := &buckets[k][bucketID]
+		// handle special cases with inf or -P / P
+		if BK.IsInfinity() {
+			if isAdd {
+				BK.Set(PP)
+			} else {
+				BK.Neg(PP)
+			}
+			return
+		}
+		if BK.X.Equal(&PP.X) {
+			if BK.Y.Equal(&PP.Y) {
+				// P + P: doubling, which should be quite rare --
+				if isAdd {
+					(*bucketJE(k))[bucketID].addMixed(PP)
+				} else {
+					BK.setInfinity()
+				}
+				return
+			}
+			if isAdd {
+				BK.setInfinity()
+			} else {
+				(*bucketJE(k))[bucketID].subMixed(PP)
+			}
+			return
+		}
+
+		addToBatch(k, bucketID, BK)
+		if isAdd {
+			P[cptAdd].Set(PP)
+		} else {
+			P[cptAdd].Neg(PP)
+		}
+		cptAdd++
+	}
+
+	flushQueue := func(k int) {
+		if qIDs[k] == 0 {
+			return
+		}
+		bucketsJE := bucketJE(k)
+		for i := 0; i < qIDs[k]; i++ {
+			(*bucketsJE)[queues[k][i].bucketID].addMixed(&queues[k][i].point)
+		}
+		qIDs[k] = 0
+	}
+
+	processTopQueues := func() {
+		for k := range queues {
+			for i := qIDs[k] - 1; i >= 0; i-- {
+				// the queues of all the vectors may hold more than batchSize points
+				if isFull() || bucketIds[k][queues[k][i].bucketID] {
+					break
+				}
+				addFromQueue(k, queues[k][i])
+				qIDs[k]--
+			}
+		}
+	}
+
+	for i := range points {
+		if points[i].IsInfinity() {
+			continue
+		}
+
+		for k := 0; k < nbVectors; k++ {
+			digit := digits[k][i]
+			if digit == 0 {
+				continue
+			}
+
+			bucketID := uint16((digit >> 1))
+			isAdd := digit&1 == 0
+			if isAdd {
+				// add
+				bucketID -= 1
+			}
+
+			if bucketIds[k][bucketID] {
+				// put it in queue
+				qID := qIDs[k]
+				queues[k][qID].bucketID = bucketID
+				if isAdd {
+					queues[k][qID].point.Set(&points[i])
+				} else {
+					queues[k][qID].point.Neg(&points[i])
+				}
+				qIDs[k]++
+
+				// queue is full, flush it.
+				if qIDs[k] == len(queues[k])-1 {
+					flushQueue(k)
+				}
+				continue
+			}
+
+			// we add the point to the batch.
+			add(k, bucketID, &points[i], isAdd)
+			for isFull() {
+				executeAndReset()
+				processTopQueues()
+			}
+		}
+	}
+
+	// flush items in batch.
+	executeAndReset()
+
+	for k := 0; k < nbVectors; k++ {
+		// empty the queue
+		flushQueue(k)
+
+		// reduce buckets into total
+		// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+		var runningSum, total g1JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for i := len(buckets[k]) - 1; i >= 0; i-- {
+			runningSum.addMixed(&buckets[k][i])
+			if bucketsJE[k] != nil && !(*bucketsJE[k])[i].ZZ.IsZero() {
+				runningSum.add(&(*bucketsJE[k])[i])
+			}
+			total.add(&runningSum)
+		}
+
+		chRes[k] <- total
+	}
+}
+
+// MultiExpBatchG2 computes the multi-exponentiations of the same points by several vectors of
+// scalars: res[k] = ∑ᵢ [scalars[k][i]]points[i].
+//
+// The scalar vectors share the window size and, for each window, a single pass over the points:
+// each point is loaded once and added to the buckets of every vector, and the affine additions
+// of all the vectors share the same batch inversions. Each window holds the buckets of all the
+// vectors, at most config.NbTasks windows are processed concurrently.
+//
+// This call return an error if len(scalars[k]) != len(points) or if provided config is invalid.
+func MultiExpBatchG2(points []G2Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G2Jac, error) {
+	nbPoints := len(points)
+	for k := range scalars {
+		if len(scalars[k]) != nbPoints {
+			return nil, errors.New("len(points) != len(scalars[k])")
+		}
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	res := make([]G2Jac, len(scalars))
+	if len(scalars) <= 1 {
+		for k := range scalars {
+			if _, err := res[k].MultiExp(points, scalars[k], config); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+
+	_innerMsmBatchG2(res, bestCG2(nbPoints, fr.Bits), points, scalars, config)
+	return res, nil
+}
+
+func _innerMsmBatchG2(res []G2Jac, c uint64, points []G2Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) []G2Jac {
+	nbPoints := len(points)
+	nbChunks := int(computeNbChunks(c))
+
+	// partition the scalars
+	digits := make([][]uint16, len(scalars))
+	chunkStats := make([][]chunkStat, len(scalars))
+	for k := range scalars {
+		digits[k], chunkStats[k] = partitionScalars(scalars[k], c, config.NbTasks)
+	}
+
+	chChunks := make([][]chan g2JacExtended, len(scalars))
+	for k := range chChunks {
+		chChunks[k] = make([]chan g2JacExtended, nbChunks)
+		for j := range chChunks[k] {
+			chChunks[k][j] = make(chan g2JacExtended, 1)
+		}
+	}
+
+	// the last chunk may be processed with a different window size, each vector then
+	// uses its own buckets.
+	processChunkBatch := getChunkProcessorBatchG2(c)
+	chTasks := make(chan struct{}, config.NbTasks)
+	for j := nbChunks - 1; j >= 0; j-- {
+		chunkDigits := make([][]uint16, len(scalars))
+		chRes := make([]chan g2JacExtended, len(scalars))
+		for k := range scalars {
+			chunkDigits[k] = digits[k][j*nbPoints : (j+1)*nbPoints]
+			chRes[k] = chChunks[k][j]
+		}
+		chTasks <- struct{}{}
+		go func(j int) {
+			defer func() { <-chTasks }()
+			if processChunkBatch != nil && j != nbChunks-1 {
+				processChunkBatch(uint64(j), chRes, c, points, chunkDigits)
+				return
+			}
+			chunkC := c
+			if j == nbChunks-1 {
+				chunkC = lastC(c)
+			}
+			for k := range chunkDigits {
+				processChunk := getChunkProcessorG2(chunkC, chunkStats[k][j])
+				processChunk(uint64(j), chRes[k], c, points, chunkDigits[k])
+			}
+		}(j)
+	}
+
+	for k := range res {
+		msmReduceChunkG2Affine(&res[k], int(c), chChunks[k])
+	}
+	return res
+}
+
+// getChunkProcessorBatchG2 returns the batch affine algorithm processing a chunk of several
+// vectors of scalars, or nil if c is too small for the batch affine additions.
+func getChunkProcessorBatchG2(c uint64) func(chunkID uint64, chRes []chan g2JacExtended, c uint64, points []G2Affine, digits [][]uint16) {
+	switch c {
+	case 10:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC10, bucketG2AffineC10, bitSetC10, pG2AffineC10, ppG2AffineC10, qG2AffineC10, cG2AffineC10]
+	case 11:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC11, bucketG2AffineC11, bitSetC11, pG2AffineC11, ppG2AffineC11, qG2AffineC11, cG2AffineC11]
+	case 12:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC12, bucketG2AffineC12, bitSetC12, pG2AffineC12, ppG2AffineC12, qG2AffineC12, cG2AffineC12]
+	case 13:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC13, bucketG2AffineC13, bitSetC13, pG2AffineC13, ppG2AffineC13, qG2AffineC13, cG2AffineC13]
+	case 14:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC14, bucketG2AffineC14, bitSetC14, pG2AffineC14, ppG2AffineC14, qG2AffineC14, cG2AffineC14]
+	case 15:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC15, bucketG2AffineC15, bitSetC15, pG2AffineC15, ppG2AffineC15, qG2AffineC15, cG2AffineC15]
+	case 16:
+		return processChunkG2BatchAffineBatch[bucketg2JacExtendedC16, bucketG2AffineC16, bitSetC16, pG2AffineC16, ppG2AffineC16, qG2AffineC16, cG2AffineC16]
+	default:
+		return nil
+	}
+}
+
+// processChunkG2BatchAffineBatch is processChunkG2BatchAffine over several vectors of digits:
+// each vector has its own buckets and queue of conflicting points, but the points are read once,
+// and a batch of affine additions mixes the buckets of all the vectors.
+func processChunkG2BatchAffineBatch[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP pG2Affine, TPP ppG2Affine, TQ qOpsG2Affine, TC cG2Affine](
+	chunk uint64,
+	chRes []chan g2JacExtended,
+	c uint64,
+	points []G2Affine,
+	digits [][]uint16) {
+
+	nbVectors := len(digits)
+
+	// the buckets are on the heap since there is a set per vector;
+	// the g2JacExtended buckets are only needed for doublings and flushed queues,
+	// we allocate them on first use.
+	buckets := make([]B, nbVectors)
+	bucketsJE := make([]*BJE, nbVectors)
+	bucketIds := make([]BS, nbVectors)
+	queues := make([]TQ, nbVectors)
+	qIDs := make([]int, nbVectors)
+	for k := range buckets {
+		for i := 0; i < len(buckets[k]); i++ {
+			buckets[k][i].setInfinity()
+		}
+	}
+	bucketJE := func(k int) *BJE {
+		if bucketsJE[k] == nil {
+			bucketsJE[k] = new(BJE)
+			for i := 0; i < len(*bucketsJE[k]); i++ {
+				(*bucketsJE[k])[i].setInfinity()
+			}
+		}
+		return bucketsJE[k]
+	}
+
+	// setup for the batch affine;
+	var (
+		cptAdd int // count the number of bucket + point added to current batch
+		R      TPP // bucket references
+		P      TP  // points to be added to R (buckets); it is beneficial to store them on the stack (ie copy)
+	)
+
+	batchSize := len(P)
+
+	// vector and bucket of each addition in the current batch, to reset bucketIds
+	batchVectors := make([]int, batchSize)
+	batchBuckets := make([]uint16, batchSize)
+
+	isFull := func() bool { return cptAdd == batchSize }
+
+	executeAndReset := func() {
+		batchAddG2Affine[TP, TPP, TC](&R, &P, cptAdd)
+		for i := 0; i < cptAdd; i++ {
+			bucketIds[batchVectors[i]][batchBuckets[i]] = false
+		}
+		cptAdd = 0
+	}
+
+	addToBatch := func(k int, bucketID uint16, BK *G2Affine) {
+		bucketIds[k][bucketID] = true
+		batchVectors[cptAdd] = k
+		batchBuckets[cptAdd] = bucketID
+		R[cptAdd] = BK
+	}
+
+	addFromQueue := func(k int, op batchOpG2Affine) {
+		// @precondition: must ensures bucket is not "used" in current batch
+		BK := &buckets[k][op.bucketID]
+
+		// handle special cases with inf or -P / P
+		if BK.IsInfinity() {
+			BK.Set(&op.point)
+			return
+		}
+		if BK.X.Equal(&op.point.X) {
+			if BK.Y.Equal(&op.point.Y) {
+				// P + P: doubling, which should be quite rare --
+				// we use the other set of buckets
+				(*bucketJE(k))[op.bucketID].addMixed(&op.point)
+				return
+			}
+			BK.setInfinity()
+			return
+		}
+
+		addToBatch(k, op.bucketID, BK)
+		P[cptAdd] = op.point
+		cptAdd++
+	}
+
+	add := func(k int, bucketID uint16, PP *G2Affine, isAdd bool) {
+		// @precondition: ensures bucket is not "used" in current batch
+		BK := &buckets[k][bucketID]
+		// handle special cases with inf or -P / P
+		if BK.IsInfinity() {
+			if isAdd {
+				BK.Set(PP)
+			} else {
+				BK.Neg(PP)
+			}
+			return
+		}
+		if BK.X.Equal(&PP.X) {
+			if BK.Y.Equal(&PP.Y) {
+				// P + P: doubling, which should be quite rare --
+				if isAdd {
+					(*bucketJE(k))[bucketID].addMixed(PP)
+				} else {
+					BK.setInfinity()
+				}
+				return
+			}
+			if isAdd {
+				BK.setInfinity()
+			} else {
+				(*bucketJE(k))[bucketID].subMixed(PP)
+			}
+			return
+		}
+
+		addToBatch(k, bucketID, BK)
+		if isAdd {
+			P[cptAdd].Set(PP)
+		} else {
+			P[cptAdd].Neg(PP)
+		}
+		cptAdd++
+	}
+
+	flushQueue := func(k int) {
+		if qIDs[k] == 0 {
+			return
+		}
+		bucketsJE := bucketJE(k)
+		for i := 0; i < qIDs[k]; i++ {
+			(*bucketsJE)[queues[k][i].bucketID].addMixed(&queues[k][i].point)
+		}
+		qIDs[k] = 0
+	}
+
+	processTopQueues := func() {
+		for k := range queues {
+			for i := qIDs[k] - 1; i >= 0; i-- {
+				// the queues of all the vectors may hold more than batchSize points
+				if isFull() || bucketIds[k][queues[k][i].bucketID] {
+					break
+				}
+				addFromQueue(k, queues[k][i])
+				qIDs[k]--
+			}
+		}
+	}
+
+	for i := range points {
+		if points[i].IsInfinity() {
+			continue
+		}
+
+		for k := 0; k < nbVectors; k++ {
+			digit := digits[k][i]
+			if digit == 0 {
+				continue
+			}
+
+			bucketID := uint16((digit >> 1))
+			isAdd := digit&1 == 0
+			if isAdd {
+				// add
+				bucketID -= 1
+			}
+
+			if bucketIds[k][bucketID] {
+				// put it in queue
+				qID := qIDs[k]
+				queues[k][qID].bucketID = bucketID
+				if isAdd {
+					queues[k][qID].point.Set(&points[i])
+				} else {
+					queues[k][qID].point.Neg(&points[i])
+				}
+				qIDs[k]++
+
+				// queue is full, flush it.
+				if qIDs[k] == len(queues[k])-1 {
+					flushQueue(k)
+				}
+				continue
+			}
+
+			// we add the point to the batch.
+			add(k, bucketID, &points[i], isAdd)
+			for isFull() {
+				executeAndReset()
+				processTopQueues()
+			}
+		}
+	}
+
+	// flush items in batch.
+	executeAndReset()
+
+	for k := 0; k < nbVectors; k++ {
+		// empty the queue
+		flushQueue(k)
+
+		// reduce buckets into total
+		// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+		var runningSum, total g2JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for i := len(buckets[k]) - 1; i >= 0; i-- {
+			runningSum.addMixed(&buckets[k][i])
+			if bucketsJE[k] != nil && !(*bucketsJE[k])[i].ZZ.IsZero() {
+				runningSum.add(&(*bucketsJE[k])[i])
+			}
+			total.add(&runningSum)
+		}
+
+		chRes[k] <- total
+	}
+}
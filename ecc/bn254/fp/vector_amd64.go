@@ -0,0 +1,193 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func addVec(res, a, b *Element, n uint64)
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func subVec(res, a, b *Element, n uint64)
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !supportAdx {
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+	scalarMulVec(&(*vector)[0], &a[0], b, n)
+}
+
+//go:noescape
+func scalarMulVec(res, a, b *Element, n uint64)
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !supportAdx {
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+	mulVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func mulVec(res, a, b *Element, n uint64)
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	v := *vector
+	if !supportAvx512 && !supportAvx2 {
+		sumVecGeneric(&res, v)
+		return
+	}
+
+	// the kernels process groups of 4 (AVX-512) or 2 (AVX2) elements, and the lanes
+	// of the accumulators don't overflow for less than 2³² elements.
+	const blockSize = 1 << 31
+	for len(v) >= 4 {
+		n := len(v)
+		if n > blockSize {
+			n = blockSize
+		}
+		n -= n % 4
+
+		var t [8 * Limbs]uint64
+		if supportAvx512 {
+			sumVecAVX512(&t, &v[0], uint64(n))
+		} else {
+			sumVecAVX2(&t, &v[0], uint64(n))
+		}
+		var s Element
+		reduceSumVec(&s, &t)
+		res.Add(&res, &s)
+
+		v = v[n:]
+	}
+	for i := 0; i < len(v); i++ {
+		res.Add(&res, &v[i])
+	}
+	return
+}
+
+//go:noescape
+func sumVecAVX512(t *[8 * Limbs]uint64, a *Element, n uint64)
+
+//go:noescape
+func sumVecAVX2(t *[8 * Limbs]uint64, a *Element, n uint64)
+
+// reduceSumVec sets z to the sum accumulated by the sumVec kernels: t[m] is a partial
+// sum of the 32-bit words at position m mod 2*Limbs of the elements.
+func reduceSumVec(z *Element, t *[8 * Limbs]uint64) {
+	// w[j] is the sum of the j-th 32-bit words of the elements
+	var w [2 * Limbs]uint64
+	for m := 0; m < len(t); m++ {
+		w[m%len(w)] += t[m]
+	}
+
+	// the sum is lo + hi⋅2^(64*Limbs)
+	var lo Element
+	var hi, c0, c1 uint64
+	for k := 0; k < Limbs; k++ {
+		lo[k], c0 = bits.Add64(w[2*k], w[2*k+1]<<32, 0)
+		lo[k], c1 = bits.Add64(lo[k], hi, 0)
+		hi = w[2*k+1]>>32 + c0 + c1
+	}
+
+	// the elements are in Montgomery form, and so is their sum; lo may not be reduced,
+	// lo mod q = (lo⋅r⁻¹)⋅r²⋅r⁻¹ is obtained with a Montgomery reduction and multiplication,
+	// and hi⋅r mod q is the Montgomery form of hi.
+	fromMont(&lo)
+	lo.Mul(&lo, &rSquare)
+	z.SetUint64(hi)
+	z.Add(z, &lo)
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	v := *vector
+	if len(v) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	if !supportAdx {
+		innerProductVecGeneric(&res, v, other)
+		return
+	}
+
+	// the products are computed by chunks, which are summed with the Sum kernels
+	const chunkSize = 256
+	var buf [chunkSize]Element
+	for start := 0; start < len(v); start += chunkSize {
+		end := start + chunkSize
+		if end > len(v) {
+			end = len(v)
+		}
+		products := Vector(buf[:end-start])
+		mulVec(&products[0], &v[start], &other[start], uint64(end-start))
+		s := products.Sum()
+		res.Add(&res, &s)
+	}
+	return
+}
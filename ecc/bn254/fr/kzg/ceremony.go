@@ -0,0 +1,273 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+var (
+	ErrInvalidPtau    = errors.New("invalid ptau file")
+	ErrTranscriptSize = errors.New("transcript has fewer powers than requested")
+)
+
+// CeremonyOption defines option for altering the behavior of the transcript
+// readers. See the descriptions of functions returning instances of this type
+// for particular options.
+type CeremonyOption func(*ceremonyConfig)
+
+type ceremonyConfig struct {
+	maxSize        uint64
+	checkPowers    bool
+	subgroupChecks bool
+}
+
+// WithMaxSize truncates the SRS to its first size G1 powers.
+func WithMaxSize(size uint64) CeremonyOption {
+	return func(opt *ceremonyConfig) {
+		opt.maxSize = size
+	}
+}
+
+// WithPowersCheck verifies the SRS read from the transcript with
+// SRS.VerifyPowers.
+func WithPowersCheck() CeremonyOption {
+	return func(opt *ceremonyConfig) {
+		opt.checkPowers = true
+	}
+}
+
+// NoSubgroupChecks disables the subgroup checks of the points read from the
+// transcript, which are done by default. Use with caution, as crafted points
+// from an untrusted source can lead to crypto-attacks.
+func NoSubgroupChecks() CeremonyOption {
+	return func(opt *ceremonyConfig) {
+		opt.subgroupChecks = false
+	}
+}
+
+// default options
+func ceremonyOptions(opts ...CeremonyOption) ceremonyConfig {
+	opt := ceremonyConfig{subgroupChecks: true}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
+
+// sections of a ptau file, see https://github.com/iden3/snarkjs
+const (
+	ptauSectionHeader = 1
+	ptauSectionTauG1  = 2
+	ptauSectionTauG2  = 3
+)
+
+// ptauMaxPower is the largest power of a ptau file: the largest ceremonies (the
+// Perpetual Powers of Tau) have 2²⁸ powers
+const ptauMaxPower = 28
+
+// ReadPtau reads a Powers of Tau transcript in the snarkjs .ptau format
+// (for instance from the Perpetual Powers of Tau ceremony) and returns the
+// corresponding SRS. The G1 powers are truncated to WithMaxSize if provided,
+// and the first 2ᵖᵒʷᵉʳ powers of the transcript are read otherwise.
+//
+// The file starts with the magic string "ptau", a version and a number of
+// sections, each section being made of its type, its size and its content.
+// The elements of the base field are stored in little endian Montgomery form,
+// and the points in affine coordinates, zero encoding the point at infinity.
+// The points are checked to be on the curve and, unless NoSubgroupChecks is
+// provided, in the prime order subgroups.
+func ReadPtau(r io.Reader, opts ...CeremonyOption) (*SRS, error) {
+	opt := ceremonyOptions(opts...)
+	br := bufio.NewReader(r)
+
+	var magic [4]byte
+	if _, err := io.ReadFull(br, magic[:]); err != nil {
+		return nil, err
+	}
+	if string(magic[:]) != "ptau" {
+		return nil, fmt.Errorf("%w: wrong magic string", ErrInvalidPtau)
+	}
+	var version, nbSections uint32
+	if err := binary.Read(br, binary.LittleEndian, &version); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(br, binary.LittleEndian, &nbSections); err != nil {
+		return nil, err
+	}
+
+	var (
+		srs       SRS
+		power     uint32
+		hasHeader bool
+		hasTauG1  bool
+		hasTauG2  bool
+	)
+	for i := uint32(0); i < nbSections; i++ {
+		var sectionType uint32
+		var sectionSize uint64
+		if err := binary.Read(br, binary.LittleEndian, &sectionType); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(br, binary.LittleEndian, &sectionSize); err != nil {
+			return nil, err
+		}
+		section := io.LimitReader(br, int64(sectionSize))
+
+		switch sectionType {
+		case ptauSectionHeader:
+			var err error
+			if power, err = readPtauHeader(section); err != nil {
+				return nil, err
+			}
+			// the tauG1 section holds 2ᵖᵒʷᵉʳ⁺¹-1 powers
+			if opt.maxSize == 0 {
+				opt.maxSize = uint64(1) << power
+			}
+			if opt.maxSize > (uint64(2)<<power)-1 {
+				return nil, ErrTranscriptSize
+			}
+			hasHeader = true
+		case ptauSectionTauG1:
+			if !hasHeader {
+				return nil, fmt.Errorf("%w: header section must come first", ErrInvalidPtau)
+			}
+			if sectionSize < opt.maxSize*2*fp.Bytes {
+				return nil, fmt.Errorf("%w: tauG1 section holds fewer powers than the header", ErrInvalidPtau)
+			}
+			srs.G1 = make([]bn254.G1Affine, opt.maxSize)
+			for j := range srs.G1 {
+				if err := readPtauG1(section, &srs.G1[j]); err != nil {
+					return nil, err
+				}
+			}
+			if opt.subgroupChecks && !g1InSubGroup(srs.G1) {
+				return nil, fmt.Errorf("%w: point not in subgroup", ErrInvalidPtau)
+			}
+			hasTauG1 = true
+		case ptauSectionTauG2:
+			if !hasHeader {
+				return nil, fmt.Errorf("%w: header section must come first", ErrInvalidPtau)
+			}
+			for j := range srs.G2 {
+				if err := readPtauG2(section, &srs.G2[j], opt.subgroupChecks); err != nil {
+					return nil, err
+				}
+			}
+			hasTauG2 = true
+		}
+
+		// skip the rest of the section
+		if _, err := io.Copy(io.Discard, section); err != nil {
+			return nil, err
+		}
+	}
+
+	if !hasTauG1 || !hasTauG2 {
+		return nil, fmt.Errorf("%w: missing powers of tau", ErrInvalidPtau)
+	}
+
+	if opt.checkPowers {
+		if err := srs.VerifyPowers(); err != nil {
+			return nil, err
+		}
+	}
+	return &srs, nil
+}
+
+// readPtauHeader reads the header section and returns the power of the transcript,
+// the base field modulus being checked against fp.Modulus().
+func readPtauHeader(r io.Reader) (uint32, error) {
+	var n8 uint32
+	if err := binary.Read(r, binary.LittleEndian, &n8); err != nil {
+		return 0, err
+	}
+	if n8 != fp.Bytes {
+		return 0, fmt.Errorf("%w: base field elements of %d bytes, expected %d", ErrInvalidPtau, n8, fp.Bytes)
+	}
+	var qBytes [fp.Bytes]byte
+	if _, err := io.ReadFull(r, qBytes[:]); err != nil {
+		return 0, err
+	}
+	for i, j := 0, len(qBytes)-1; i < j; i, j = i+1, j-1 {
+		qBytes[i], qBytes[j] = qBytes[j], qBytes[i]
+	}
+	if new(big.Int).SetBytes(qBytes[:]).Cmp(fp.Modulus()) != 0 {
+		return 0, fmt.Errorf("%w: transcript is not on bn254", ErrInvalidPtau)
+	}
+	var power uint32
+	if err := binary.Read(r, binary.LittleEndian, &power); err != nil {
+		return 0, err
+	}
+	if power > ptauMaxPower {
+		return 0, fmt.Errorf("%w: power %d is larger than %d", ErrInvalidPtau, power, ptauMaxPower)
+	}
+	return power, nil
+}
+
+// readPtauFp reads an element of the base field in little endian Montgomery
+// form.
+func readPtauFp(r io.Reader, z *fp.Element) error {
+	var buf [fp.Bytes]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return err
+	}
+	e, err := fp.LittleEndian.Element(&buf)
+	if err != nil {
+		return err
+	}
+
+	// e = xR, and fp.Element{1} = R⁻¹
+	z.Mul(&e, &fp.Element{1})
+	return nil
+}
+
+func readPtauG1(r io.Reader, p *bn254.G1Affine) error {
+	if err := readPtauFp(r, &p.X); err != nil {
+		return err
+	}
+	if err := readPtauFp(r, &p.Y); err != nil {
+		return err
+	}
+	if !p.IsOnCurve() {
+		return fmt.Errorf("%w: point not on curve", ErrInvalidPtau)
+	}
+	return nil
+}
+
+func readPtauG2(r io.Reader, p *bn254.G2Affine, subgroupCheck bool) error {
+	for _, e := range []*fp.Element{&p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1} {
+		if err := readPtauFp(r, e); err != nil {
+			return err
+		}
+	}
+	if !p.IsOnCurve() {
+		return fmt.Errorf("%w: point not on curve", ErrInvalidPtau)
+	}
+	if subgroupCheck && !p.IsInSubGroup() {
+		return fmt.Errorf("%w: point not in subgroup", ErrInvalidPtau)
+	}
+	return nil
+}
@@ -1972,3 +1972,2508 @@ l2:
 	MOVQ AX, (SP)
 	CALL ·_fromMontGeneric(SB)
 	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+TEXT ·mulVec(SB), $64-32
+	NO_LOCAL_POINTERS
+	CMPQ n+24(FP), $0
+	JEQ  l4           // n == 0, nothing to do
+
+l3:
+	MOVQ a+8(FP), R12
+	MOVQ b+16(FP), R13
+
+	// A -> BP
+	// t[0] -> R14
+	// t[1] -> R15
+	// t[2] -> CX
+	// t[3] -> BX
+	// t[4] -> SI
+	// t[5] -> DI
+	// t[6] -> R8
+	// t[7] -> R9
+	// t[8] -> R10
+	// t[9] -> R11
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R13), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R12), R14, R15
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R12), AX, CX
+	ADOXQ AX, R15
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R12), AX, BX
+	ADOXQ AX, CX
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R12), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ 32(R12), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ 40(R12), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[6])  := x[6]*y[0] + A
+	MULXQ 48(R12), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[7])  := x[7]*y[0] + A
+	MULXQ 56(R12), AX, R10
+	ADOXQ AX, R9
+
+	// (A,t[8])  := x[8]*y[0] + A
+	MULXQ 64(R12), AX, R11
+	ADOXQ AX, R10
+
+	// (A,t[9])  := x[9]*y[0] + A
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[1] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[1] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[2] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[2] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[3] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[3] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 32(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[4] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[4] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 40(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[5] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[5] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 48(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[6] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[6] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[6] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[6] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[6] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[6] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[6] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[6] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[6] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[6] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 56(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[7] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[7] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[7] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[7] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[7] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[7] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[7] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[7] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[7] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[7] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 64(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[8] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[8] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[8] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[8] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[8] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[8] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[8] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[8] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[8] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[8] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 72(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[9] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[9] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[9] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[9] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[9] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[9] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[9] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[9] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[9] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[9] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// reduce element(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11) using temp registers (R12,R13,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP))
+	REDUCE(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ R14, 0(AX)
+	MOVQ R15, 8(AX)
+	MOVQ CX, 16(AX)
+	MOVQ BX, 24(AX)
+	MOVQ SI, 32(AX)
+	MOVQ DI, 40(AX)
+	MOVQ R8, 48(AX)
+	MOVQ R9, 56(AX)
+	MOVQ R10, 64(AX)
+	MOVQ R11, 72(AX)
+	ADDQ $0x0000000000000050, res+0(FP)
+	ADDQ $0x0000000000000050, a+8(FP)
+	ADDQ $0x0000000000000050, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l3
+
+l4:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+TEXT ·scalarMulVec(SB), $64-32
+	NO_LOCAL_POINTERS
+	CMPQ n+24(FP), $0
+	JEQ  l6           // n == 0, nothing to do
+
+l5:
+	MOVQ a+8(FP), R12
+	MOVQ b+16(FP), R13
+
+	// A -> BP
+	// t[0] -> R14
+	// t[1] -> R15
+	// t[2] -> CX
+	// t[3] -> BX
+	// t[4] -> SI
+	// t[5] -> DI
+	// t[6] -> R8
+	// t[7] -> R9
+	// t[8] -> R10
+	// t[9] -> R11
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R13), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R12), R14, R15
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R12), AX, CX
+	ADOXQ AX, R15
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R12), AX, BX
+	ADOXQ AX, CX
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R12), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ 32(R12), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ 40(R12), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[6])  := x[6]*y[0] + A
+	MULXQ 48(R12), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[7])  := x[7]*y[0] + A
+	MULXQ 56(R12), AX, R10
+	ADOXQ AX, R9
+
+	// (A,t[8])  := x[8]*y[0] + A
+	MULXQ 64(R12), AX, R11
+	ADOXQ AX, R10
+
+	// (A,t[9])  := x[9]*y[0] + A
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[1] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[1] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[2] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[2] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[3] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[3] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 32(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[4] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[4] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 40(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[5] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[5] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 48(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[6] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[6] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[6] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[6] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[6] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[6] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[6] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[6] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[6] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[6] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 56(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[7] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[7] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[7] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[7] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[7] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[7] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[7] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[7] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[7] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[7] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 64(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[8] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[8] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[8] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[8] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[8] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[8] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[8] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[8] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[8] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[8] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 72(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[9] + A
+	MULXQ 0(R12), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[9] + A
+	ADCXQ BP, R15
+	MULXQ 8(R12), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[9] + A
+	ADCXQ BP, CX
+	MULXQ 16(R12), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[9] + A
+	ADCXQ BP, BX
+	MULXQ 24(R12), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[9] + A
+	ADCXQ BP, SI
+	MULXQ 32(R12), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[9] + A
+	ADCXQ BP, DI
+	MULXQ 40(R12), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[9] + A
+	ADCXQ BP, R8
+	MULXQ 48(R12), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[9] + A
+	ADCXQ BP, R9
+	MULXQ 56(R12), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[9] + A
+	ADCXQ BP, R10
+	MULXQ 64(R12), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[9] + A
+	ADCXQ BP, R11
+	MULXQ 72(R12), AX, BP
+	ADOXQ AX, R11
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// t[9] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R11
+	ADOXQ BP, R11
+
+	// reduce element(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11) using temp registers (R12,R13,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP))
+	REDUCE(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ R14, 0(AX)
+	MOVQ R15, 8(AX)
+	MOVQ CX, 16(AX)
+	MOVQ BX, 24(AX)
+	MOVQ SI, 32(AX)
+	MOVQ DI, 40(AX)
+	MOVQ R8, 48(AX)
+	MOVQ R9, 56(AX)
+	MOVQ R10, 64(AX)
+	MOVQ R11, 72(AX)
+	ADDQ $0x0000000000000050, res+0(FP)
+	ADDQ $0x0000000000000050, a+8(FP)
+	DECQ n+24(FP)
+	JNE  l5
+
+l6:
+	RET
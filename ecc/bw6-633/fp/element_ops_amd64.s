@@ -434,3 +434,232 @@ l1:
 	MOVQ R11, 64(AX)
 	MOVQ R12, 72(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), $56-32
+	CMPQ n+24(FP), $0
+	JEQ  l3           // n == 0, nothing to do
+
+l2:
+	MOVQ a+8(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	MOVQ 32(AX), DI
+	MOVQ 40(AX), R8
+	MOVQ 48(AX), R9
+	MOVQ 56(AX), R10
+	MOVQ 64(AX), R11
+	MOVQ 72(AX), R12
+	MOVQ b+16(FP), AX
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+	ADCQ 32(AX), DI
+	ADCQ 40(AX), R8
+	ADCQ 48(AX), R9
+	ADCQ 56(AX), R10
+	ADCQ 64(AX), R11
+	ADCQ 72(AX), R12
+
+	// reduce element(DX,CX,BX,SI,DI,R8,R9,R10,R11,R12) using temp registers (R13,R14,R15,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP))
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13,R14,R15,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	MOVQ DI, 32(AX)
+	MOVQ R8, 40(AX)
+	MOVQ R9, 48(AX)
+	MOVQ R10, 56(AX)
+	MOVQ R11, 64(AX)
+	MOVQ R12, 72(AX)
+	ADDQ $0x0000000000000050, res+0(FP)
+	ADDQ $0x0000000000000050, a+8(FP)
+	ADDQ $0x0000000000000050, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l2
+
+l3:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l5           // n == 0, nothing to do
+
+l4:
+	MOVQ a+8(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	MOVQ 32(AX), DI
+	MOVQ 40(AX), R8
+	MOVQ 48(AX), R9
+	MOVQ 56(AX), R10
+	MOVQ 64(AX), R11
+	MOVQ 72(AX), R12
+	MOVQ b+16(FP), AX
+	SUBQ 0(AX), DX
+	SBBQ 8(AX), CX
+	SBBQ 16(AX), BX
+	SBBQ 24(AX), SI
+	SBBQ 32(AX), DI
+	SBBQ 40(AX), R8
+	SBBQ 48(AX), R9
+	SBBQ 56(AX), R10
+	SBBQ 64(AX), R11
+	SBBQ 72(AX), R12
+	JCC  l6
+	ADDQ q<>+0(SB), DX
+	ADCQ q<>+8(SB), CX
+	ADCQ q<>+16(SB), BX
+	ADCQ q<>+24(SB), SI
+	ADCQ q<>+32(SB), DI
+	ADCQ q<>+40(SB), R8
+	ADCQ q<>+48(SB), R9
+	ADCQ q<>+56(SB), R10
+	ADCQ q<>+64(SB), R11
+	ADCQ q<>+72(SB), R12
+
+l6:
+	MOVQ res+0(FP), AX
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	MOVQ DI, 32(AX)
+	MOVQ R8, 40(AX)
+	MOVQ R9, 48(AX)
+	MOVQ R10, 56(AX)
+	MOVQ R11, 64(AX)
+	MOVQ R12, 72(AX)
+	ADDQ $0x0000000000000050, res+0(FP)
+	ADDQ $0x0000000000000050, a+8(FP)
+	ADDQ $0x0000000000000050, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l4
+
+l5:
+	RET
+
+// sumVecAVX512(t *[80]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX512(SB), NOSPLIT, $0-24
+	MOVQ   a+8(FP), AX
+	MOVQ   n+16(FP), CX
+	SHRQ   $2, CX       // number of groups of 4 elements
+	VPXORQ Z0, Z0, Z0
+	VPXORQ Z1, Z1, Z1
+	VPXORQ Z2, Z2, Z2
+	VPXORQ Z3, Z3, Z3
+	VPXORQ Z4, Z4, Z4
+	VPXORQ Z5, Z5, Z5
+	VPXORQ Z6, Z6, Z6
+	VPXORQ Z7, Z7, Z7
+	VPXORQ Z8, Z8, Z8
+	VPXORQ Z9, Z9, Z9
+	TESTQ  CX, CX
+	JEQ    l8
+
+l7:
+	VPMOVZXDQ 0(AX), Z10
+	VPADDQ    Z10, Z0, Z0
+	VPMOVZXDQ 32(AX), Z11
+	VPADDQ    Z11, Z1, Z1
+	VPMOVZXDQ 64(AX), Z10
+	VPADDQ    Z10, Z2, Z2
+	VPMOVZXDQ 96(AX), Z11
+	VPADDQ    Z11, Z3, Z3
+	VPMOVZXDQ 128(AX), Z10
+	VPADDQ    Z10, Z4, Z4
+	VPMOVZXDQ 160(AX), Z11
+	VPADDQ    Z11, Z5, Z5
+	VPMOVZXDQ 192(AX), Z10
+	VPADDQ    Z10, Z6, Z6
+	VPMOVZXDQ 224(AX), Z11
+	VPADDQ    Z11, Z7, Z7
+	VPMOVZXDQ 256(AX), Z10
+	VPADDQ    Z10, Z8, Z8
+	VPMOVZXDQ 288(AX), Z11
+	VPADDQ    Z11, Z9, Z9
+	ADDQ      $0x0000000000000140, AX
+	DECQ      CX
+	JNE       l7
+
+l8:
+	MOVQ      t+0(FP), AX
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VMOVDQU64 Z4, 256(AX)
+	VMOVDQU64 Z5, 320(AX)
+	VMOVDQU64 Z6, 384(AX)
+	VMOVDQU64 Z7, 448(AX)
+	VMOVDQU64 Z8, 512(AX)
+	VMOVDQU64 Z9, 576(AX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(t *[80]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX2(SB), NOSPLIT, $0-24
+	MOVQ  a+8(FP), AX
+	MOVQ  n+16(FP), CX
+	SHRQ  $1, CX       // number of groups of 2 elements
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+	VPXOR Y2, Y2, Y2
+	VPXOR Y3, Y3, Y3
+	VPXOR Y4, Y4, Y4
+	VPXOR Y5, Y5, Y5
+	VPXOR Y6, Y6, Y6
+	VPXOR Y7, Y7, Y7
+	VPXOR Y8, Y8, Y8
+	VPXOR Y9, Y9, Y9
+	TESTQ CX, CX
+	JEQ   l10
+
+l9:
+	VPMOVZXDQ 0(AX), Y10
+	VPADDQ    Y10, Y0, Y0
+	VPMOVZXDQ 16(AX), Y11
+	VPADDQ    Y11, Y1, Y1
+	VPMOVZXDQ 32(AX), Y10
+	VPADDQ    Y10, Y2, Y2
+	VPMOVZXDQ 48(AX), Y11
+	VPADDQ    Y11, Y3, Y3
+	VPMOVZXDQ 64(AX), Y10
+	VPADDQ    Y10, Y4, Y4
+	VPMOVZXDQ 80(AX), Y11
+	VPADDQ    Y11, Y5, Y5
+	VPMOVZXDQ 96(AX), Y10
+	VPADDQ    Y10, Y6, Y6
+	VPMOVZXDQ 112(AX), Y11
+	VPADDQ    Y11, Y7, Y7
+	VPMOVZXDQ 128(AX), Y10
+	VPADDQ    Y10, Y8, Y8
+	VPMOVZXDQ 144(AX), Y11
+	VPADDQ    Y11, Y9, Y9
+	ADDQ      $0x00000000000000a0, AX
+	DECQ      CX
+	JNE       l9
+
+l10:
+	MOVQ    t+0(FP), AX
+	VMOVDQU Y0, 0(AX)
+	VMOVDQU Y1, 32(AX)
+	VMOVDQU Y2, 64(AX)
+	VMOVDQU Y3, 96(AX)
+	VMOVDQU Y4, 128(AX)
+	VMOVDQU Y5, 160(AX)
+	VMOVDQU Y6, 192(AX)
+	VMOVDQU Y7, 224(AX)
+	VMOVDQU Y8, 256(AX)
+	VMOVDQU Y9, 288(AX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,205 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+)
+
+const nbSigners = 5
+
+// sign returns the public keys, the distinct messages and the signatures of
+// nbSigners signers in the ciphersuite cs.
+func sign(t testing.TB, cs Ciphersuite) ([]PublicKey, [][]byte, [][]byte) {
+	publicKeys := make([]PublicKey, nbSigners)
+	messages := make([][]byte, nbSigners)
+	signatures := make([][]byte, nbSigners)
+	for i := 0; i < nbSigners; i++ {
+		privKey, err := GenerateKey(rand.Reader, cs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeys[i] = privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("testing BLS aggregation %d", i))
+		if signatures[i], err = privKey.Sign(messages[i], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return publicKeys, messages, signatures
+}
+
+func TestAggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range ciphersuites {
+		publicKeys, messages, signatures := sign(t, cs)
+
+		aggregated, err := AggregateSignatures(cs, signatures...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := AggregateVerify(publicKeys, messages, aggregated); err != nil || !ok {
+			t.Fatal("aggregated signature should verify", err)
+		}
+
+		// swapping two messages invalidates the aggregated signature
+		messages[0], messages[1] = messages[1], messages[0]
+		if ok, _ := AggregateVerify(publicKeys, messages, aggregated); ok {
+			t.Fatal("aggregated signature should not verify on permuted messages")
+		}
+
+		if _, err := AggregateVerify(publicKeys, messages[1:], aggregated); err == nil {
+			t.Fatal("AggregateVerify should reject inputs of different lengths")
+		}
+	}
+}
+
+func TestAggregateVerifyDuplicateMessages(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []Variant{MinPk, MinSig} {
+		cs := Ciphersuite{Variant: v}
+		publicKeys, messages, signatures := sign(t, cs)
+		aggregated, err := AggregateSignatures(cs, signatures...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		messages[1] = messages[0]
+		if _, err = AggregateVerify(publicKeys, messages, aggregated); err != errDuplicateMessage {
+			t.Fatal("basic scheme should reject duplicate messages")
+		}
+	}
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []Variant{MinPk, MinSig} {
+		cs := Ciphersuite{v, ProofOfPossession}
+		msg := []byte("testing BLS fast aggregation")
+
+		publicKeys := make([]PublicKey, nbSigners)
+		signatures := make([][]byte, nbSigners)
+		for i := 0; i < nbSigners; i++ {
+			privKey, err := GenerateKey(rand.Reader, cs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			publicKeys[i] = privKey.PublicKey
+			if signatures[i], err = privKey.Sign(msg, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		aggregated, err := AggregateSignatures(cs, signatures...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := FastAggregateVerify(publicKeys, msg, aggregated); err != nil || !ok {
+			t.Fatal("aggregated signature should verify", err)
+		}
+		if ok, _ := FastAggregateVerify(publicKeys[1:], msg, aggregated); ok {
+			t.Fatal("aggregated signature should not verify with a missing public key")
+		}
+
+		// the aggregated public key verifies the aggregated signature
+		aggregatedKey, err := AggregatePublicKeys(publicKeys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := aggregatedKey.Verify(aggregated, msg, nil); err != nil || !ok {
+			t.Fatal("aggregated signature should verify under the aggregated public key", err)
+		}
+
+		// fast aggregate verification is only defined with proofs of possession
+		basic := make([]PublicKey, nbSigners)
+		for i := range basic {
+			basic[i] = publicKeys[i]
+			basic[i].Suite.Scheme = Basic
+		}
+		if _, err = FastAggregateVerify(basic, msg, aggregated); err != errFastAggregate {
+			t.Fatal("fast aggregate verification should require the ProofOfPossession scheme")
+		}
+	}
+}
+
+func TestBatchVerify(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range ciphersuites {
+		publicKeys, messages, signatures := sign(t, cs)
+
+		if ok, err := BatchVerify(publicKeys, messages, signatures); err != nil || !ok {
+			t.Fatal("batch of valid signatures should verify", err)
+		}
+
+		// swapping two valid signatures preserves their sum but not the
+		// random linear combination
+		signatures[0], signatures[1] = signatures[1], signatures[0]
+		if ok, _ := BatchVerify(publicKeys, messages, signatures); ok {
+			t.Fatal("batch with swapped signatures should not verify")
+		}
+
+		if _, err := BatchVerify(publicKeys, messages, signatures[1:]); err == nil {
+			t.Fatal("BatchVerify should reject inputs of different lengths")
+		}
+	}
+}
+
+func TestMixedCiphersuites(t *testing.T) {
+	t.Parallel()
+
+	pk1, _ := GenerateKey(rand.Reader, Ciphersuite{MinPk, Basic})
+	pk2, _ := GenerateKey(rand.Reader, Ciphersuite{MinPk, MessageAugmentation})
+	if _, err := AggregatePublicKeys([]PublicKey{pk1.PublicKey, pk2.PublicKey}); err != errMixedCiphersuites {
+		t.Fatal("public keys of different ciphersuites should not be aggregated")
+	}
+	if _, err := AggregatePublicKeys(nil); err != errEmptyInput {
+		t.Fatal("empty input should be rejected")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkAggregateVerify(b *testing.B) {
+	for _, v := range []Variant{MinPk, MinSig} {
+		cs := Ciphersuite{Variant: v}
+		publicKeys, messages, signatures := sign(b, cs)
+		aggregated, _ := AggregateSignatures(cs, signatures...)
+
+		b.Run(variantName(v), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				AggregateVerify(publicKeys, messages, aggregated)
+			}
+		})
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	for _, v := range []Variant{MinPk, MinSig} {
+		publicKeys, messages, signatures := sign(b, Ciphersuite{Variant: v})
+
+		b.Run(variantName(v), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				BatchVerify(publicKeys, messages, signatures)
+			}
+		})
+	}
+}
@@ -2756,3 +2756,3576 @@ l2:
 	MOVQ AX, (SP)
 	CALL ·_fromMontGeneric(SB)
 	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+TEXT ·mulVec(SB), $96-32
+	NO_LOCAL_POINTERS
+	CMPQ n+24(FP), $0
+	JEQ  l4           // n == 0, nothing to do
+
+l3:
+	MOVQ a+8(FP), AX
+
+	// x[0] -> s0-8(SP)
+	// x[1] -> s1-16(SP)
+	// x[2] -> s2-24(SP)
+	// x[3] -> s3-32(SP)
+	// x[4] -> s4-40(SP)
+	// x[5] -> s5-48(SP)
+	// x[6] -> s6-56(SP)
+	// x[7] -> s7-64(SP)
+	// x[8] -> s8-72(SP)
+	// x[9] -> s9-80(SP)
+	// x[10] -> s10-88(SP)
+	// x[11] -> s11-96(SP)
+	MOVQ 0(AX), R14
+	MOVQ 8(AX), R15
+	MOVQ 16(AX), CX
+	MOVQ 24(AX), BX
+	MOVQ 32(AX), SI
+	MOVQ 40(AX), DI
+	MOVQ 48(AX), R8
+	MOVQ 56(AX), R9
+	MOVQ 64(AX), R10
+	MOVQ 72(AX), R11
+	MOVQ 80(AX), R12
+	MOVQ 88(AX), R13
+	MOVQ R14, s0-8(SP)
+	MOVQ R15, s1-16(SP)
+	MOVQ CX, s2-24(SP)
+	MOVQ BX, s3-32(SP)
+	MOVQ SI, s4-40(SP)
+	MOVQ DI, s5-48(SP)
+	MOVQ R8, s6-56(SP)
+	MOVQ R9, s7-64(SP)
+	MOVQ R10, s8-72(SP)
+	MOVQ R11, s9-80(SP)
+	MOVQ R12, s10-88(SP)
+	MOVQ R13, s11-96(SP)
+
+	// A -> BP
+	// t[0] -> R14
+	// t[1] -> R15
+	// t[2] -> CX
+	// t[3] -> BX
+	// t[4] -> SI
+	// t[5] -> DI
+	// t[6] -> R8
+	// t[7] -> R9
+	// t[8] -> R10
+	// t[9] -> R11
+	// t[10] -> R12
+	// t[11] -> R13
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 0(AX), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ s0-8(SP), R14, R15
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ s1-16(SP), AX, CX
+	ADOXQ AX, R15
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ s2-24(SP), AX, BX
+	ADOXQ AX, CX
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ s3-32(SP), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ s4-40(SP), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ s5-48(SP), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[6])  := x[6]*y[0] + A
+	MULXQ s6-56(SP), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[7])  := x[7]*y[0] + A
+	MULXQ s7-64(SP), AX, R10
+	ADOXQ AX, R9
+
+	// (A,t[8])  := x[8]*y[0] + A
+	MULXQ s8-72(SP), AX, R11
+	ADOXQ AX, R10
+
+	// (A,t[9])  := x[9]*y[0] + A
+	MULXQ s9-80(SP), AX, R12
+	ADOXQ AX, R11
+
+	// (A,t[10])  := x[10]*y[0] + A
+	MULXQ s10-88(SP), AX, R13
+	ADOXQ AX, R12
+
+	// (A,t[11])  := x[11]*y[0] + A
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 8(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[1] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[1] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[1] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[1] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 16(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[2] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[2] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[2] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[2] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 24(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[3] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[3] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[3] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[3] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 32(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[4] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[4] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[4] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[4] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 40(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[5] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[5] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[5] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[5] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 48(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[6] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[6] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[6] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[6] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[6] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[6] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[6] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[6] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[6] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[6] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[6] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[6] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 56(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[7] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[7] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[7] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[7] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[7] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[7] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[7] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[7] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[7] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[7] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[7] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[7] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 64(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[8] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[8] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[8] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[8] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[8] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[8] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[8] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[8] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[8] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[8] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[8] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[8] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 72(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[9] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[9] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[9] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[9] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[9] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[9] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[9] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[9] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[9] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[9] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[9] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[9] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 80(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[10] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[10] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[10] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[10] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[10] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[10] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[10] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[10] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[10] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[10] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[10] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[10] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 88(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[11] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[11] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[11] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[11] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[11] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[11] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[11] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[11] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[11] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[11] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[11] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[11] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// reduce element(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13) using temp registers (s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP),s8-72(SP),s9-80(SP),s10-88(SP),s11-96(SP))
+	REDUCE(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP),s8-72(SP),s9-80(SP),s10-88(SP),s11-96(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ R14, 0(AX)
+	MOVQ R15, 8(AX)
+	MOVQ CX, 16(AX)
+	MOVQ BX, 24(AX)
+	MOVQ SI, 32(AX)
+	MOVQ DI, 40(AX)
+	MOVQ R8, 48(AX)
+	MOVQ R9, 56(AX)
+	MOVQ R10, 64(AX)
+	MOVQ R11, 72(AX)
+	MOVQ R12, 80(AX)
+	MOVQ R13, 88(AX)
+	ADDQ $0x0000000000000060, res+0(FP)
+	ADDQ $0x0000000000000060, a+8(FP)
+	ADDQ $0x0000000000000060, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l3
+
+l4:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+TEXT ·scalarMulVec(SB), $96-32
+	NO_LOCAL_POINTERS
+	CMPQ n+24(FP), $0
+	JEQ  l6           // n == 0, nothing to do
+
+l5:
+	MOVQ a+8(FP), AX
+
+	// x[0] -> s0-8(SP)
+	// x[1] -> s1-16(SP)
+	// x[2] -> s2-24(SP)
+	// x[3] -> s3-32(SP)
+	// x[4] -> s4-40(SP)
+	// x[5] -> s5-48(SP)
+	// x[6] -> s6-56(SP)
+	// x[7] -> s7-64(SP)
+	// x[8] -> s8-72(SP)
+	// x[9] -> s9-80(SP)
+	// x[10] -> s10-88(SP)
+	// x[11] -> s11-96(SP)
+	MOVQ 0(AX), R14
+	MOVQ 8(AX), R15
+	MOVQ 16(AX), CX
+	MOVQ 24(AX), BX
+	MOVQ 32(AX), SI
+	MOVQ 40(AX), DI
+	MOVQ 48(AX), R8
+	MOVQ 56(AX), R9
+	MOVQ 64(AX), R10
+	MOVQ 72(AX), R11
+	MOVQ 80(AX), R12
+	MOVQ 88(AX), R13
+	MOVQ R14, s0-8(SP)
+	MOVQ R15, s1-16(SP)
+	MOVQ CX, s2-24(SP)
+	MOVQ BX, s3-32(SP)
+	MOVQ SI, s4-40(SP)
+	MOVQ DI, s5-48(SP)
+	MOVQ R8, s6-56(SP)
+	MOVQ R9, s7-64(SP)
+	MOVQ R10, s8-72(SP)
+	MOVQ R11, s9-80(SP)
+	MOVQ R12, s10-88(SP)
+	MOVQ R13, s11-96(SP)
+
+	// A -> BP
+	// t[0] -> R14
+	// t[1] -> R15
+	// t[2] -> CX
+	// t[3] -> BX
+	// t[4] -> SI
+	// t[5] -> DI
+	// t[6] -> R8
+	// t[7] -> R9
+	// t[8] -> R10
+	// t[9] -> R11
+	// t[10] -> R12
+	// t[11] -> R13
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 0(AX), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ s0-8(SP), R14, R15
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ s1-16(SP), AX, CX
+	ADOXQ AX, R15
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ s2-24(SP), AX, BX
+	ADOXQ AX, CX
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ s3-32(SP), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ s4-40(SP), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ s5-48(SP), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[6])  := x[6]*y[0] + A
+	MULXQ s6-56(SP), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[7])  := x[7]*y[0] + A
+	MULXQ s7-64(SP), AX, R10
+	ADOXQ AX, R9
+
+	// (A,t[8])  := x[8]*y[0] + A
+	MULXQ s8-72(SP), AX, R11
+	ADOXQ AX, R10
+
+	// (A,t[9])  := x[9]*y[0] + A
+	MULXQ s9-80(SP), AX, R12
+	ADOXQ AX, R11
+
+	// (A,t[10])  := x[10]*y[0] + A
+	MULXQ s10-88(SP), AX, R13
+	ADOXQ AX, R12
+
+	// (A,t[11])  := x[11]*y[0] + A
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 8(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[1] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[1] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[1] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[1] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 16(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[2] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[2] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[2] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[2] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 24(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[3] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[3] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[3] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[3] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 32(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[4] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[4] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[4] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[4] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 40(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[5] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[5] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[5] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[5] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 48(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[6] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[6] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[6] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[6] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[6] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[6] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[6] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[6] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[6] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[6] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[6] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[6] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 56(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[7] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[7] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[7] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[7] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[7] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[7] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[7] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[7] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[7] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[7] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[7] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[7] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 64(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[8] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[8] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[8] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[8] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[8] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[8] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[8] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[8] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[8] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[8] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[8] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[8] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 72(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[9] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[9] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[9] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[9] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[9] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[9] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[9] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[9] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[9] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[9] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[9] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[9] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 80(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[10] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[10] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[10] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[10] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[10] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[10] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[10] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[10] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[10] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[10] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[10] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[10] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ b+16(FP), AX
+	MOVQ 88(AX), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[11] + A
+	MULXQ s0-8(SP), AX, BP
+	ADOXQ AX, R14
+
+	// (A,t[1])  := t[1] + x[1]*y[11] + A
+	ADCXQ BP, R15
+	MULXQ s1-16(SP), AX, BP
+	ADOXQ AX, R15
+
+	// (A,t[2])  := t[2] + x[2]*y[11] + A
+	ADCXQ BP, CX
+	MULXQ s2-24(SP), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[3])  := t[3] + x[3]*y[11] + A
+	ADCXQ BP, BX
+	MULXQ s3-32(SP), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[4])  := t[4] + x[4]*y[11] + A
+	ADCXQ BP, SI
+	MULXQ s4-40(SP), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[5])  := t[5] + x[5]*y[11] + A
+	ADCXQ BP, DI
+	MULXQ s5-48(SP), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[6])  := t[6] + x[6]*y[11] + A
+	ADCXQ BP, R8
+	MULXQ s6-56(SP), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[7])  := t[7] + x[7]*y[11] + A
+	ADCXQ BP, R9
+	MULXQ s7-64(SP), AX, BP
+	ADOXQ AX, R9
+
+	// (A,t[8])  := t[8] + x[8]*y[11] + A
+	ADCXQ BP, R10
+	MULXQ s8-72(SP), AX, BP
+	ADOXQ AX, R10
+
+	// (A,t[9])  := t[9] + x[9]*y[11] + A
+	ADCXQ BP, R11
+	MULXQ s9-80(SP), AX, BP
+	ADOXQ AX, R11
+
+	// (A,t[10])  := t[10] + x[10]*y[11] + A
+	ADCXQ BP, R12
+	MULXQ s10-88(SP), AX, BP
+	ADOXQ AX, R12
+
+	// (A,t[11])  := t[11] + x[11]*y[11] + A
+	ADCXQ BP, R13
+	MULXQ s11-96(SP), AX, BP
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+	PUSHQ BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, BP
+	ADCXQ R14, AX
+	MOVQ  BP, R14
+	POPQ  BP
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ R15, R14
+	MULXQ q<>+8(SB), AX, R15
+	ADOXQ AX, R14
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ CX, R15
+	MULXQ q<>+16(SB), AX, CX
+	ADOXQ AX, R15
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ BX, CX
+	MULXQ q<>+24(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ SI, BX
+	MULXQ q<>+32(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ DI, SI
+	MULXQ q<>+40(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[5]) := t[6] + m*q[6] + C
+	ADCXQ R8, DI
+	MULXQ q<>+48(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[6]) := t[7] + m*q[7] + C
+	ADCXQ R9, R8
+	MULXQ q<>+56(SB), AX, R9
+	ADOXQ AX, R8
+
+	// (C,t[7]) := t[8] + m*q[8] + C
+	ADCXQ R10, R9
+	MULXQ q<>+64(SB), AX, R10
+	ADOXQ AX, R9
+
+	// (C,t[8]) := t[9] + m*q[9] + C
+	ADCXQ R11, R10
+	MULXQ q<>+72(SB), AX, R11
+	ADOXQ AX, R10
+
+	// (C,t[9]) := t[10] + m*q[10] + C
+	ADCXQ R12, R11
+	MULXQ q<>+80(SB), AX, R12
+	ADOXQ AX, R11
+
+	// (C,t[10]) := t[11] + m*q[11] + C
+	ADCXQ R13, R12
+	MULXQ q<>+88(SB), AX, R13
+	ADOXQ AX, R12
+
+	// t[11] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ BP, R13
+
+	// reduce element(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13) using temp registers (s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP),s8-72(SP),s9-80(SP),s10-88(SP),s11-96(SP))
+	REDUCE(R14,R15,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13,s0-8(SP),s1-16(SP),s2-24(SP),s3-32(SP),s4-40(SP),s5-48(SP),s6-56(SP),s7-64(SP),s8-72(SP),s9-80(SP),s10-88(SP),s11-96(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ R14, 0(AX)
+	MOVQ R15, 8(AX)
+	MOVQ CX, 16(AX)
+	MOVQ BX, 24(AX)
+	MOVQ SI, 32(AX)
+	MOVQ DI, 40(AX)
+	MOVQ R8, 48(AX)
+	MOVQ R9, 56(AX)
+	MOVQ R10, 64(AX)
+	MOVQ R11, 72(AX)
+	MOVQ R12, 80(AX)
+	MOVQ R13, 88(AX)
+	ADDQ $0x0000000000000060, res+0(FP)
+	ADDQ $0x0000000000000060, a+8(FP)
+	DECQ n+24(FP)
+	JNE  l5
+
+l6:
+	RET
@@ -304,3 +304,182 @@ TEXT ·Butterfly(SB), $48-16
 	MOVQ R8, 32(AX)
 	MOVQ R9, 40(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l2           // n == 0, nothing to do
+
+l1:
+	MOVQ a+8(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	MOVQ 32(AX), DI
+	MOVQ 40(AX), R8
+	MOVQ b+16(FP), AX
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+	ADCQ 32(AX), DI
+	ADCQ 40(AX), R8
+
+	// reduce element(DX,CX,BX,SI,DI,R8) using temp registers (R9,R10,R11,R12,R13,R14)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10,R11,R12,R13,R14)
+
+	MOVQ res+0(FP), AX
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	MOVQ DI, 32(AX)
+	MOVQ R8, 40(AX)
+	ADDQ $0x0000000000000030, res+0(FP)
+	ADDQ $0x0000000000000030, a+8(FP)
+	ADDQ $0x0000000000000030, b+16(FP)
+	DECQ n+24(FP)
+	JNE  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	CMPQ n+24(FP), $0
+	JEQ  l4           // n == 0, nothing to do
+
+l3:
+	XORQ    R9, R9
+	MOVQ    a+8(FP), AX
+	MOVQ    0(AX), DX
+	MOVQ    8(AX), CX
+	MOVQ    16(AX), BX
+	MOVQ    24(AX), SI
+	MOVQ    32(AX), DI
+	MOVQ    40(AX), R8
+	MOVQ    b+16(FP), AX
+	SUBQ    0(AX), DX
+	SBBQ    8(AX), CX
+	SBBQ    16(AX), BX
+	SBBQ    24(AX), SI
+	SBBQ    32(AX), DI
+	SBBQ    40(AX), R8
+	MOVQ    $0x8508c00000000001, R10
+	MOVQ    $0x170b5d4430000000, R11
+	MOVQ    $0x1ef3622fba094800, R12
+	MOVQ    $0x1a22d9f300f5138f, R13
+	MOVQ    $0xc63b05c06ca1493b, R14
+	MOVQ    $0x01ae3a4617c510ea, R15
+	CMOVQCC R9, R10
+	CMOVQCC R9, R11
+	CMOVQCC R9, R12
+	CMOVQCC R9, R13
+	CMOVQCC R9, R14
+	CMOVQCC R9, R15
+	ADDQ    R10, DX
+	ADCQ    R11, CX
+	ADCQ    R12, BX
+	ADCQ    R13, SI
+	ADCQ    R14, DI
+	ADCQ    R15, R8
+	MOVQ    res+0(FP), AX
+	MOVQ    DX, 0(AX)
+	MOVQ    CX, 8(AX)
+	MOVQ    BX, 16(AX)
+	MOVQ    SI, 24(AX)
+	MOVQ    DI, 32(AX)
+	MOVQ    R8, 40(AX)
+	ADDQ    $0x0000000000000030, res+0(FP)
+	ADDQ    $0x0000000000000030, a+8(FP)
+	ADDQ    $0x0000000000000030, b+16(FP)
+	DECQ    n+24(FP)
+	JNE     l3
+
+l4:
+	RET
+
+// sumVecAVX512(t *[48]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX512(SB), NOSPLIT, $0-24
+	MOVQ   a+8(FP), AX
+	MOVQ   n+16(FP), CX
+	SHRQ   $2, CX       // number of groups of 4 elements
+	VPXORQ Z0, Z0, Z0
+	VPXORQ Z1, Z1, Z1
+	VPXORQ Z2, Z2, Z2
+	VPXORQ Z3, Z3, Z3
+	VPXORQ Z4, Z4, Z4
+	VPXORQ Z5, Z5, Z5
+	TESTQ  CX, CX
+	JEQ    l6
+
+l5:
+	VPMOVZXDQ 0(AX), Z6
+	VPADDQ    Z6, Z0, Z0
+	VPMOVZXDQ 32(AX), Z7
+	VPADDQ    Z7, Z1, Z1
+	VPMOVZXDQ 64(AX), Z6
+	VPADDQ    Z6, Z2, Z2
+	VPMOVZXDQ 96(AX), Z7
+	VPADDQ    Z7, Z3, Z3
+	VPMOVZXDQ 128(AX), Z6
+	VPADDQ    Z6, Z4, Z4
+	VPMOVZXDQ 160(AX), Z7
+	VPADDQ    Z7, Z5, Z5
+	ADDQ      $0x00000000000000c0, AX
+	DECQ      CX
+	JNE       l5
+
+l6:
+	MOVQ      t+0(FP), AX
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VMOVDQU64 Z4, 256(AX)
+	VMOVDQU64 Z5, 320(AX)
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(t *[48]uint64, a *Element, n uint64)
+TEXT ·sumVecAVX2(SB), NOSPLIT, $0-24
+	MOVQ  a+8(FP), AX
+	MOVQ  n+16(FP), CX
+	SHRQ  $1, CX       // number of groups of 2 elements
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+	VPXOR Y2, Y2, Y2
+	VPXOR Y3, Y3, Y3
+	VPXOR Y4, Y4, Y4
+	VPXOR Y5, Y5, Y5
+	TESTQ CX, CX
+	JEQ   l8
+
+l7:
+	VPMOVZXDQ 0(AX), Y6
+	VPADDQ    Y6, Y0, Y0
+	VPMOVZXDQ 16(AX), Y7
+	VPADDQ    Y7, Y1, Y1
+	VPMOVZXDQ 32(AX), Y6
+	VPADDQ    Y6, Y2, Y2
+	VPMOVZXDQ 48(AX), Y7
+	VPADDQ    Y7, Y3, Y3
+	VPMOVZXDQ 64(AX), Y6
+	VPADDQ    Y6, Y4, Y4
+	VPMOVZXDQ 80(AX), Y7
+	VPADDQ    Y7, Y5, Y5
+	ADDQ      $0x0000000000000060, AX
+	DECQ      CX
+	JNE       l7
+
+l8:
+	MOVQ    t+0(FP), AX
+	VMOVDQU Y0, 0(AX)
+	VMOVDQU Y1, 32(AX)
+	VMOVDQU Y2, 64(AX)
+	VMOVDQU Y3, 96(AX)
+	VMOVDQU Y4, 128(AX)
+	VMOVDQU Y5, 160(AX)
+	VZEROUPPER
+	RET
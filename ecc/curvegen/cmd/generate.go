@@ -0,0 +1,83 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/ecc"
+	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
+)
+
+// generateFromConfig generates the package of the curve described in the configuration file
+// at path (see config.CustomCurveParams) in outputDir
+func generateFromConfig(path, outputDir string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var params config.CustomCurveParams
+	if err := json.Unmarshal(data, &params); err != nil {
+		return fmt.Errorf("invalid configuration file: %w", err)
+	}
+	conf, err := config.NewCustomCurve(params)
+	if err != nil {
+		return err
+	}
+
+	if err := generator.GenerateFF(conf.Fr, filepath.Join(outputDir, "fr")); err != nil {
+		return err
+	}
+	if err := generator.GenerateFF(conf.Fp, filepath.Join(outputDir, "fp")); err != nil {
+		return err
+	}
+
+	// the generators read their templates from disk
+	tmpDir, err := os.MkdirTemp("", "curvegen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bgen := bavard.NewBatchGenerator("ConsenSys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	templateDir, err := config.ExtractTemplates(ecc.Templates, filepath.Join(tmpDir, "ecc"))
+	if err != nil {
+		return err
+	}
+	if err := ecc.GenerateWithTemplates(conf, outputDir, templateDir, bgen); err != nil {
+		return err
+	}
+	templateDir, err = config.ExtractTemplates(ecdsa.Templates, filepath.Join(tmpDir, "ecdsa"))
+	if err != nil {
+		return err
+	}
+	if err := ecdsa.GenerateWithTemplates(conf, outputDir, templateDir, bgen); err != nil {
+		return fmt.Errorf("ecdsa: %w", err)
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// integration test will generate a curve with and a curve without GLV endomorphism and run
+// the tests of the generated packages
+
+// k256 is secp256k1 (a = 0), with the GLV decomposition and the SVDW map
+const k256 = `{
+	"name": "k256",
+	"importPath": "%s",
+	"p": "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+	"r": "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+	"a": "0",
+	"b": "7",
+	"generator": {
+		"x": "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		"y": "0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+	},
+	"glv": {
+		"thirdRootOne": "0x7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee",
+		"lambda": "0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"
+	}
+}`
+
+// p256 is NIST P-256 (a = -3), without endomorphism and with the SSWU map
+const p256 = `{
+	"name": "p256",
+	"importPath": "%s",
+	"p": "0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff",
+	"r": "0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551",
+	"a": "-3",
+	"b": "0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b",
+	"generator": {
+		"x": "0x6b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
+		"y": "0x4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5"
+	},
+	"sswu": {"z": -10}
+}`
+
+func TestIntegration(t *testing.T) {
+	// the generated packages must be in the module to import gnark-crypto
+	rootDir, err := os.MkdirTemp(".", "integration_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(rootDir)
+
+	const importPath = "github.com/consensys/gnark-crypto/ecc/curvegen/cmd"
+	for name, conf := range map[string]string{"k256": k256, "p256": p256} {
+		path := filepath.Join(rootDir, name+".json")
+		conf = fmt.Sprintf(conf, strings.Join([]string{importPath, filepath.Base(rootDir), name}, "/"))
+		if err = os.WriteFile(path, []byte(conf), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err = generateFromConfig(path, filepath.Join(rootDir, name)); err != nil {
+			t.Fatal(name, err)
+		}
+	}
+
+	// run go test
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	packageDir := filepath.Join(wd, rootDir) + string(filepath.Separator) + "..."
+	cmd := exec.Command("go", "test", "-short", packageDir)
+	out, err := cmd.CombinedOutput()
+	fmt.Println(string(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cmd is the CLI interface for curvegen
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "curvegen",
+	Short: "curvegen generates the arithmetic, multi-exponentiation, serialization, hash to curve and ECDSA of a short Weierstrass curve",
+	Run:   cmdGenerate,
+}
+
+// flags
+var (
+	fConfig    string
+	fOutputDir string
+)
+
+var errMissingArgument = errors.New("missing argument")
+
+func init() {
+	cobra.OnInitialize()
+	rootCmd.PersistentFlags().StringVarP(&fConfig, "config", "c", "", "JSON file describing the curve")
+	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path to create output files")
+	if bits.UintSize != 64 {
+		panic("curvegen only supports 64bits architectures")
+	}
+}
+
+func cmdGenerate(cmd *cobra.Command, args []string) {
+	if fConfig == "" || fOutputDir == "" {
+		_ = cmd.Usage()
+		fmt.Printf("\n%s\n", errMissingArgument.Error())
+		os.Exit(-1)
+	}
+
+	if err := generateFromConfig(fConfig, filepath.Clean(fOutputDir)); err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package curvegen generates a standalone package for a short Weierstrass curve
+// y² = x³ + ax + b defined by the user: the fields 𝔽p and 𝔽r, G1 arithmetic (with the GLV
+// scalar multiplication if the curve has an efficient endomorphism), multi-exponentiation,
+// serialization, hash to curve and ECDSA. The code is generated from the same templates as
+// the curves of gnark-crypto.
+//
+// Example usage:
+//
+//	curvegen -c ./k256.json -o ./k256
+//
+// The JSON configuration file describes the curve; for instance:
+//
+//	{
+//		"name": "k256",
+//		"importPath": "example.com/k256",
+//		"p": "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+//		"r": "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+//		"a": "0",
+//		"b": "7",
+//		"generator": {
+//			"x": "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+//			"y": "0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+//		},
+//		"glv": {
+//			"thirdRootOne": "0x7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee",
+//			"lambda": "0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"
+//		}
+//	}
+//
+// The cofactor defaults to 1. The hash to curve uses the SVDW map, unless the parameters of
+// the SSWU map are given (RFC 9380, section 6.6.2): "sswu": {"z": -10} for a curve with
+// ab ≠ 0, or with the isogeny from a curve E' with a'b' ≠ 0 otherwise (see
+// CustomIsogeny in internal/generator/config).
+//
+// # Warning
+//
+// The parameters are checked (primality, generator of order r, Hasse bound, GLV eigenvalue...)
+// but not their security (embedding degree, twist security, CM discriminant...). The generated
+// code has not been audited and is provided as-is. In particular, there is no security
+// guarantees such as constant time implementation or side-channel attack resistance.
+package main
+
+import "github.com/consensys/gnark-crypto/ecc/curvegen/cmd"
+
+func main() {
+	cmd.Execute()
+}
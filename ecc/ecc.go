@@ -116,4 +116,19 @@ func modulus(c *config.Curve, scalarField bool) *big.Int {
 // MultiExpConfig enables to set optional configuration attribute to a call to MultiExp
 type MultiExpConfig struct {
 	NbTasks int // go routines to be used in the multiexp. can be larger than num cpus.
+
+	// TableStride sets the memory/time trade-off of the fixed-base multiexp tables
+	// (NewMultiExpTableG1, ...): a table stores the multiples of the bases for one c-bit window
+	// out of TableStride, and a multiexp then runs TableStride bucket methods. 0 means 1.
+	TableStride int
+
+	// ChunkSize is the number of points read at once by the streaming multiexp
+	// (G1Jac.MultiExpReader, ...). 0 means 1 << 20.
+	ChunkSize int
+
+	// GLV enables the GLV decomposition of the scalars in the multiexp (G1Jac.MultiExp, ...) on
+	// curves with an efficient endomorphism ϕ (bn254, bls12-*, bls24-*, bw6-*, ...): each scalar
+	// s is split in s = k₁ + λk₂ with k₁, k₂ half the size of s, and the multiexp runs over
+	// the points Pᵢ and ϕ(Pᵢ) with half as many windows. It is ignored on other curves.
+	GLV bool
 }
@@ -19,8 +19,14 @@ package fp
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/consensys/gnark-crypto/utils"
 )
 
 // Vector represents a slice of Element.
@@ -32,6 +38,9 @@ import (
 //   - encoding.BinaryMarshaler
 //   - encoding.BinaryUnmarshaler
 //   - sort.Interface
+//
+// It also provides element-wise arithmetic (Add, Sub, Mul, ScalarMul, BatchInvert) and
+// reductions (Sum, InnerProduct) on whole vectors.
 type Vector []Element
 
 // MarshalBinary implements encoding.BinaryMarshaler
@@ -130,3 +139,169 @@ func (vector Vector) Less(i, j int) bool {
 func (vector Vector) Swap(i, j int) {
 	vector[i], vector[j] = vector[j], vector[i]
 }
+
+// BatchInvert inverts a element-wise and stores the result in self, using the Montgomery
+// batch inversion trick; zeros are mapped to zero. a and self may be the same vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) BatchInvert(a Vector) {
+	if len(a) != len(*vector) {
+		panic("vector.BatchInvert: vectors don't have the same length")
+	}
+	if len(a) == 0 {
+		return
+	}
+	v := *vector
+
+	// prefix[i] is the product of the non-zero elements of a[0...i-1]
+	prefix := make(Vector, len(a))
+	var acc Element
+	acc.SetOne()
+	for i := 0; i < len(a); i++ {
+		prefix[i] = acc
+		if !a[i].IsZero() {
+			acc.Mul(&acc, &a[i])
+		}
+	}
+
+	acc.Inverse(&acc)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			v[i].SetZero()
+			continue
+		}
+		var inv Element
+		inv.Mul(&acc, &prefix[i])
+		acc.Mul(&acc, &a[i])
+		v[i] = inv
+	}
+}
+
+// FromCanonicalLimbs sets the vector to the elements whose canonical (non-Montgomery) values
+// are given as little-endian 64-bit words: limbs[Limbs⋅i : Limbs⋅(i+1)] is the i-th element.
+// The vector is resized to len(limbs) / Limbs elements; the conversion is parallelized.
+//
+// It returns an error if len(limbs) is not a multiple of Limbs, or if a value is not
+// reduced modulo q; the vector is then left unchanged.
+func (vector *Vector) FromCanonicalLimbs(limbs []uint64) error {
+	if len(limbs)%Limbs != 0 {
+		return errors.New("vector.FromCanonicalLimbs: the number of limbs is not a multiple of Limbs")
+	}
+	n := len(limbs) / Limbs
+
+	// index of the first non-reduced element found, -1 if none
+	var lock sync.Mutex
+	invalid := -1
+
+	utils.Parallelize(n, func(start, end int) {
+		var e Element
+		for i := start; i < end; i++ {
+			copy(e[:], limbs[Limbs*i:Limbs*(i+1)])
+			if !e.smallerThanModulus() {
+				lock.Lock()
+				if invalid == -1 || i < invalid {
+					invalid = i
+				}
+				lock.Unlock()
+				return
+			}
+		}
+	})
+
+	if invalid != -1 {
+		return fmt.Errorf("vector.FromCanonicalLimbs: element %d is not reduced", invalid)
+	}
+
+	if cap(*vector) < n {
+		*vector = make(Vector, n)
+	} else {
+		*vector = (*vector)[:n]
+	}
+	v := *vector
+
+	utils.Parallelize(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			copy(v[i][:], limbs[Limbs*i:Limbs*(i+1)])
+		}
+		// to Montgomery form: x⋅r = x⋅r²⋅r⁻¹
+		chunk := v[start:end]
+		chunk.ScalarMul(chunk, &rSquare)
+	})
+
+	return nil
+}
+
+// ToCanonicalLimbs returns the canonical (non-Montgomery) values of the elements as
+// little-endian 64-bit words: limbs[Limbs⋅i : Limbs⋅(i+1)] is the i-th element.
+// The conversion is parallelized.
+func (vector Vector) ToCanonicalLimbs() []uint64 {
+	limbs := make([]uint64, Limbs*len(vector))
+	utils.Parallelize(len(vector), func(start, end int) {
+		for i := start; i < end; i++ {
+			e := vector[i]
+			fromMont(&e)
+			copy(limbs[Limbs*i:], e[:])
+		}
+	})
+	return limbs
+}
+
+// MontgomeryLimbs returns the words of the elements in Montgomery form, without copy:
+// the returned slice aliases the vector, and limbs[Limbs⋅i : Limbs⋅(i+1)] is the i-th element.
+func (vector Vector) MontgomeryLimbs() []uint64 {
+	if len(vector) == 0 {
+		return nil
+	}
+	return unsafe.Slice(&vector[0][0], Limbs*len(vector))
+}
+
+// VectorFromMontgomeryLimbs returns the vector of the elements in Montgomery form given by
+// limbs, without copy: the returned vector aliases limbs. The values are not checked to be
+// reduced modulo q. It panics if len(limbs) is not a multiple of Limbs.
+func VectorFromMontgomeryLimbs(limbs []uint64) Vector {
+	if len(limbs)%Limbs != 0 {
+		panic("VectorFromMontgomeryLimbs: the number of limbs is not a multiple of Limbs")
+	}
+	if len(limbs) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*Element)(unsafe.Pointer(&limbs[0])), len(limbs)/Limbs)
+}
+
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *Element) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+
+func sumVecGeneric(res *Element, a Vector) {
+	for i := 0; i < len(a); i++ {
+		res.Add(res, &a[i])
+	}
+}
+
+func innerProductVecGeneric(res *Element, a, b Vector) {
+	var tmp Element
+	for i := 0; i < len(a); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(res, &tmp)
+	}
+}
@@ -17,6 +17,7 @@
 package secp256k1
 
 import (
+	"io"
 	"math/big"
 	"testing"
 
@@ -49,6 +50,15 @@ func TestG1AffineSerialization(t *testing.T) {
 		}
 	}
 
+	// a buffer holding X but not Y is too short
+	{
+		var p G1Affine
+		buf := make([]byte, SizeOfG1AffineCompressed+8)
+		if _, err := p.SetBytes(buf); err != io.ErrShortBuffer {
+			t.Fatal("decoding a short buffer should fail with io.ErrShortBuffer")
+		}
+	}
+
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
 		parameters.MinSuccessfulTests = nbFuzzShort
@@ -0,0 +1,28 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package schnorr provides Schnorr signatures on the secp256k1 curve, following BIP-340.
+//
+// Public keys are x-only: a public key is the x coordinate of the point P
+// with even y coordinate among ±P. Signatures are 64 bytes long, the x
+// coordinate of the nonce commitment R followed by the scalar s. Nonces are
+// derived deterministically from the private key, the message and 32 bytes of
+// auxiliary random data, and several signatures can be verified at once with
+// BatchVerify.
+//
+// Documentation:
+// - BIP-340: https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+package schnorr
@@ -0,0 +1,379 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizeFp         = fp.Bytes
+	sizePublicKey  = sizeFp
+	sizePrivateKey = sizeFr + sizePublicKey
+	sizeSignature  = sizeFp + sizeFr
+	sizeAuxRand    = 32
+)
+
+// tags of the hash functions, see BIP-340 "Design" section
+const (
+	tagAux       = "BIP0340/aux"
+	tagNonce     = "BIP0340/nonce"
+	tagChallenge = "BIP0340/challenge"
+)
+
+var (
+	errInvalidPrivateKey = errors.New("private key must be in [1, n-1]")
+	errInvalidAuxRand    = errors.New("auxiliary random data must be 32 bytes long")
+	errInvalidNonce      = errors.New("nonce is zero")
+	errNotOnCurve        = errors.New("x coordinate is not on the curve")
+	errLengthMismatch    = errors.New("public keys, messages and signatures must have the same length")
+)
+
+var order = fr.Modulus()
+
+// PublicKey represents a BIP-340 x-only public key. A is the point of even y
+// coordinate with the given x coordinate.
+type PublicKey struct {
+	A secp256k1.G1Affine
+}
+
+// PrivateKey represents a BIP-340 private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// Signature represents a BIP-340 signature
+type Signature struct {
+	R [sizeFp]byte // x coordinate of the nonce commitment
+	S [sizeFr]byte
+}
+
+var one = new(big.Int).SetInt64(1)
+
+// randFieldElement returns a random element of the order of the given
+// curve using the procedure given in FIPS 186-4, Appendix B.5.1.
+func randFieldElement(rand io.Reader) (k *big.Int, err error) {
+	b := make([]byte, fr.Bits/8+8)
+	_, err = io.ReadFull(rand, b)
+	if err != nil {
+		return
+	}
+
+	k = new(big.Int).SetBytes(b)
+	n := new(big.Int).Sub(order, one)
+	k.Mod(k, n)
+	k.Add(k, one)
+	return
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+
+	k, err := randFieldElement(rand)
+	if err != nil {
+		return nil, err
+
+	}
+
+	privateKey := new(PrivateKey)
+	k.FillBytes(privateKey.scalar[:sizeFr])
+	privateKey.PublicKey.A.ScalarMultiplicationBaseCT(k)
+	if !hasEvenY(&privateKey.PublicKey.A) {
+		privateKey.PublicKey.A.Neg(&privateKey.PublicKey.A)
+	}
+	return privateKey, nil
+}
+
+// taggedHash returns SHA256(SHA256(tag) ∥ SHA256(tag) ∥ x₀ ∥ x₁ ∥ …)
+func taggedHash(tag string, x ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for i := range x {
+		h.Write(x[i])
+	}
+	return h.Sum(nil)
+}
+
+// hasEvenY reports whether the y coordinate of P is even.
+func hasEvenY(P *secp256k1.G1Affine) bool {
+	y := P.Y.Bytes()
+	return y[sizeFp-1]&1 == 0
+}
+
+// liftX returns the point of even y coordinate whose x coordinate is the
+// big endian integer x, if any.
+func liftX(x []byte) (secp256k1.G1Affine, error) {
+	var P secp256k1.G1Affine
+	if err := P.X.SetBytesCanonical(x); err != nil {
+		return P, err
+	}
+
+	// y² = x³ + b
+	_, b := secp256k1.CurveCoefficients()
+	var c fp.Element
+	c.Square(&P.X).Mul(&c, &P.X).Add(&c, &b)
+	if P.Y.Sqrt(&c) == nil {
+		return P, errNotOnCurve
+	}
+	if !hasEvenY(&P) {
+		P.Y.Neg(&P.Y)
+	}
+	return P, nil
+}
+
+// hashMessage returns hFunc(message) if hFunc is provided, and message
+// otherwise.
+func hashMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
+
+// challenge returns e = int(hash_BIP0340/challenge(bytes(R) ∥ bytes(P) ∥ m)) mod n
+func challenge(r, pk, message []byte) *big.Int {
+	e := new(big.Int).SetBytes(taggedHash(tagChallenge, r, pk, message))
+	return e.Mod(e, order)
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign performs the BIP-340 signature with 32 bytes of fresh auxiliary random
+// data. If hFunc is provided, the message is first hashed with hFunc.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	auxRand := make([]byte, sizeAuxRand)
+	if _, err := io.ReadFull(rand.Reader, auxRand); err != nil {
+		return nil, err
+	}
+	return privKey.SignWithAuxRand(message, auxRand, hFunc)
+}
+
+// SignWithAuxRand performs the BIP-340 signature
+//
+// d = d' if P = d'⋅G has an even y coordinate, n-d' otherwise
+// t = bytes(d) ⊕ hash_BIP0340/aux(a)
+// k' = int(hash_BIP0340/nonce(t ∥ bytes(P) ∥ m)) mod n
+// R = k'⋅G, k = k' if R has an even y coordinate, n-k' otherwise
+// e = int(hash_BIP0340/challenge(bytes(R) ∥ bytes(P) ∥ m)) mod n
+// signature = bytes(R) ∥ bytes((k + e⋅d) mod n)
+//
+// where a is the 32 bytes of auxiliary random data auxRand. The nonce is a
+// deterministic function of the private key, the message and auxRand, so
+// that signing with a constant auxRand (e.g. zeros) is deterministic.
+// If hFunc is provided, the message is first hashed with hFunc.
+func (privKey *PrivateKey) SignWithAuxRand(message, auxRand []byte, hFunc hash.Hash) ([]byte, error) {
+	if len(auxRand) != sizeAuxRand {
+		return nil, errInvalidAuxRand
+	}
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	d := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+	if d.Sign() == 0 || d.Cmp(order) >= 0 {
+		return nil, errInvalidPrivateKey
+	}
+	var P secp256k1.G1Affine
+	P.ScalarMultiplicationBaseCT(d)
+	if !hasEvenY(&P) {
+		d.Sub(order, d)
+	}
+	pBin := P.X.Bytes()
+
+	var t [sizeFr]byte
+	d.FillBytes(t[:])
+	auxHash := taggedHash(tagAux, auxRand)
+	for i := range t {
+		t[i] ^= auxHash[i]
+	}
+
+	k := new(big.Int).SetBytes(taggedHash(tagNonce, t[:], pBin[:], m))
+	k.Mod(k, order)
+	if k.Sign() == 0 {
+		return nil, errInvalidNonce
+	}
+	var R secp256k1.G1Affine
+	R.ScalarMultiplicationBaseCT(k)
+	if !hasEvenY(&R) {
+		k.Sub(order, k)
+	}
+
+	var sig Signature
+	sig.R = R.X.Bytes()
+	e := challenge(sig.R[:], pBin[:], m)
+	s := e.Mul(e, d)
+	s.Add(s, k).Mod(s, order)
+	s.FillBytes(sig.S[:])
+
+	return sig.Bytes(), nil
+}
+
+// Verify validates the BIP-340 signature
+//
+// e = int(hash_BIP0340/challenge(bytes(r) ∥ bytes(P) ∥ m)) mod n
+// R = s⋅G - e⋅P
+//
+// and checks that R is not the point at infinity, has an even y coordinate
+// and x(R) = r. If hFunc is provided, the message is first hashed with hFunc.
+func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	// Deserialize the signature
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	pBin := publicKey.Bytes()
+	P, err := liftX(pBin)
+	if err != nil {
+		return false, err
+	}
+
+	// r < p
+	var r fp.Element
+	if err := r.SetBytesCanonical(sig.R[:]); err != nil {
+		return false, nil
+	}
+	// s < n
+	s := new(big.Int).SetBytes(sig.S[:])
+	if s.Cmp(order) >= 0 {
+		return false, nil
+	}
+
+	e := challenge(sig.R[:], pBin, m)
+	e.Sub(order, e)
+
+	var _R secp256k1.G1Jac
+	_R.JointScalarMultiplicationBase(&P, s, e)
+	var R secp256k1.G1Affine
+	R.FromJacobian(&_R)
+
+	return !R.IsInfinity() && hasEvenY(&R) && R.X.Equal(&r), nil
+}
+
+// BatchVerify validates independent BIP-340 signatures, where signatures[i]
+// is a signature of messages[i] by publicKeys[i]. It samples random
+// coefficients a₁ = 1, a₂, …, aₙ in [1, n-1] and checks
+//
+// (∑ aᵢ⋅sᵢ)⋅G = ∑ aᵢ⋅Rᵢ + ∑ (aᵢ⋅eᵢ)⋅Pᵢ
+//
+// with a single multi-scalar multiplication. It returns true if and only if
+// all the signatures are valid, except with negligible probability.
+// If hFunc is provided, the messages are first hashed with hFunc.
+func BatchVerify(publicKeys []PublicKey, messages [][]byte, signatures [][]byte, hFunc hash.Hash) (bool, error) {
+	n := len(publicKeys)
+	if len(messages) != n || len(signatures) != n {
+		return false, errLengthMismatch
+	}
+	if n == 0 {
+		return true, nil
+	}
+
+	// points = [G, R₁, …, Rₙ, P₁, …, Pₙ]
+	points := make([]secp256k1.G1Affine, 2*n+1)
+	scalars := make([]fr.Element, 2*n+1)
+	_, points[0] = secp256k1.Generators()
+
+	var a, s, e, sum fr.Element
+	for i := 0; i < n; i++ {
+		var sig Signature
+		if _, err := sig.SetBytes(signatures[i]); err != nil {
+			return false, err
+		}
+		m, err := hashMessage(messages[i], hFunc)
+		if err != nil {
+			return false, err
+		}
+		pBin := publicKeys[i].Bytes()
+		if points[1+n+i], err = liftX(pBin); err != nil {
+			return false, err
+		}
+		if points[1+i], err = liftX(sig.R[:]); err != nil {
+			return false, nil
+		}
+		if err = s.SetBytesCanonical(sig.S[:]); err != nil {
+			return false, nil
+		}
+		e.SetBigInt(challenge(sig.R[:], pBin, m))
+
+		if i == 0 {
+			a.SetOne()
+		} else {
+			for {
+				if _, err = a.SetRandom(); err != nil {
+					return false, err
+				}
+				if !a.IsZero() {
+					break
+				}
+			}
+		}
+
+		scalars[1+i] = a
+		scalars[1+n+i].Mul(&a, &e)
+		s.Mul(&s, &a)
+		sum.Add(&sum, &s)
+	}
+	scalars[0].Neg(&sum)
+
+	var res secp256k1.G1Jac
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+	return res.Z.IsZero(), nil
+}
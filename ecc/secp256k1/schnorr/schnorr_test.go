@@ -0,0 +1,431 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package schnorr
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestSchnorr(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[SECP256K1] test the signing and verification", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing Schnorr")
+			hFunc := sha256.New()
+			sig, _ := privKey.Sign(msg, hFunc)
+			flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+			return flag
+		},
+	))
+
+	properties.Property("[SECP256K1] test the signing and verification (pre-hashed)", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing Schnorr")
+			sig, _ := privKey.Sign(msg, nil)
+			flag, _ := publicKey.Verify(sig, msg, nil)
+
+			return flag
+		},
+	))
+
+	properties.Property("[SECP256K1] test the verification of a wrong message", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			sig, _ := privKey.Sign([]byte("testing Schnorr"), nil)
+			flag, _ := publicKey.Verify(sig, []byte("testing schnorr"), nil)
+
+			return !flag
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// bip340Vector is a test vector from
+// https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv
+type bip340Vector struct {
+	secretKey, publicKey, auxRand, message, signature string
+	result                                            bool
+}
+
+var bip340Vectors = []bip340Vector{
+	{
+		secretKey: "0000000000000000000000000000000000000000000000000000000000000003",
+		publicKey: "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		auxRand:   "0000000000000000000000000000000000000000000000000000000000000000",
+		message:   "0000000000000000000000000000000000000000000000000000000000000000",
+		signature: "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+		result:    true,
+	},
+	{
+		secretKey: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		auxRand:   "0000000000000000000000000000000000000000000000000000000000000001",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		result:    true,
+	},
+	{
+		secretKey: "C90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B14E5C9",
+		publicKey: "DD308AFEC5777E13121FA72B9CC1B7CC0139715309B086C960E18FD969774EB8",
+		auxRand:   "C87AA53824B4D7AE2EB035A2B5BBBCCC080E76CDC6D1692C4B0B62D798E6D906",
+		message:   "7E2D58D8B3BCDF1ABADEC7829054F90DDA9805AAB56C77333024B9D0A508B75C",
+		signature: "5831AAEED7B44BB74E5EAB94BA9D4294C49BCF2A60728D8B4C200F50DD313C1BAB745879A5AD954A72C45A91C3A51D3C7ADEA98D82F8481E0E1E03674A6F3FB7",
+		result:    true,
+	},
+	{
+		secretKey: "0B432B2677937381AEF05BB02A66ECD012773062CF3FA2549E44F58ED2401710",
+		publicKey: "25D1DFF95105F5253C4022F628A996AD3A0D95FBF21D468A1B33F8C160D8F517",
+		auxRand:   "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+		message:   "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+		signature: "7EB0509757E246F19449885651611CB965ECC1A187DD51B64FDA1EDC9637D5EC97582B9CB13DB3933705B32BA982AF5AF25FD78881EBB32771FC5922EFC66EA3",
+		result:    true,
+	},
+	{
+		publicKey: "D69C3509BB99E412E68B0FE8544E72837DFA30746D8BE2AA65975F29D22DC7B9",
+		message:   "4DF3C3F68FCC83B27E9D42C90431A72499F17875C81A599B566C9889B9696703",
+		signature: "00000000000000000000003B78CE563F89A0ED9414F5AA28AD0D96D6795F9C6376AFB1548AF603B3EB45C9F8207DEE1060CB71C04E80F593060B07D28308D7F4",
+		result:    true,
+	},
+	{
+		// public key not on the curve
+		publicKey: "EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E17776969E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+		result:    false,
+	},
+	{
+		// has_even_y(R) is false
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "FFF97BD5755EEEA420453A14355235D382F6472F8568A18B2F057A14602975563CC27944640AC607CD107AE10923D9EF7A73C643E166BE5EBEAFA34B1AC553E2",
+		result:    false,
+	},
+	{
+		// negated message
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "1FA62E331EDBC21C394792D2AB1100A7B432B013DF3F6FF4F99FCB33E0E1515F28890B3EDB6E7189B630448B515CE4F8622A954CFE545735AAEA5134FCCDB2BD",
+		result:    false,
+	},
+	{
+		// negated s value
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769961764B3AA9B2FFCB6EF947B6887A226E8D7C93E00C5ED0C1834FF0D0C2E6DA6",
+		result:    false,
+	},
+	{
+		// sG - eP is infinite, with x(inf) = 0
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "0000000000000000000000000000000000000000000000000000000000000000123DDA8328AF9C23A94C1FEECFD123BA4FB73476F0D594DCB65C6425BD186051",
+		result:    false,
+	},
+	{
+		// sG - eP is infinite, with x(inf) = 1
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "00000000000000000000000000000000000000000000000000000000000000017615FBAF5AE28864013C099742DEADB4DBA87F11AC6754F93780D5A1837CF197",
+		result:    false,
+	},
+	{
+		// sig[0:32] is not an x coordinate on the curve
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "4A298DACAE57395A15D0795DDBFD1DCB564DA82B0F269BC70A74F8220429BA1D69E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+		result:    false,
+	},
+	{
+		// sig[0:32] is equal to the field size
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F69E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+		result:    false,
+	},
+	{
+		// sig[32:64] is equal to the curve order
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+		result:    false,
+	},
+	{
+		// public key is not a valid x coordinate, as it exceeds the field size
+		publicKey: "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+		message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		signature: "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E17776969E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+		result:    false,
+	},
+	{
+		// empty message
+		secretKey: "0340034003400340034003400340034003400340034003400340034003400340",
+		publicKey: "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+		auxRand:   "0000000000000000000000000000000000000000000000000000000000000000",
+		message:   "",
+		signature: "71535DB165ECD9FBBC046E5FFAEA61186BB6AD436732FCCC25291A55895464CF6069CE26BF03466228F19A3A62DB8A649F2D560FAC652827D1AF0574E427AB63",
+		result:    true,
+	},
+	{
+		// 1-byte message
+		secretKey: "0340034003400340034003400340034003400340034003400340034003400340",
+		publicKey: "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+		auxRand:   "0000000000000000000000000000000000000000000000000000000000000000",
+		message:   "11",
+		signature: "08A20A0AFEF64124649232E0693C583AB1B9934AE63B4C3511F3AE1134C6A303EA3173BFEA6683BD101FA5AA5DBC1996FE7CACFC5A577D33EC14564CEC2BACBF",
+		result:    true,
+	},
+	{
+		// 17-byte message
+		secretKey: "0340034003400340034003400340034003400340034003400340034003400340",
+		publicKey: "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+		auxRand:   "0000000000000000000000000000000000000000000000000000000000000000",
+		message:   "0102030405060708090A0B0C0D0E0F1011",
+		signature: "5130F39A4059B43BC7CAC09A19ECE52B5D8699D1A71E3C52DA9AFDB6B50AC370C4A482B77BF960F8681540E25B6771ECE1E5A37FD80E5A51897C5566A97EA5A5",
+		result:    true,
+	},
+	{
+		// 100-byte message
+		secretKey: "0340034003400340034003400340034003400340034003400340034003400340",
+		publicKey: "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+		auxRand:   "0000000000000000000000000000000000000000000000000000000000000000",
+		message:   "99999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999",
+		signature: "403B12B0D8555A344175EA7EC746566303321E5DBFA8BE6F091635163ECA79A8585ED3E3170807E7C03B720FC54C7B23897FCBA0E9D0B4A06894CFD249F22367",
+		result:    true,
+	},
+}
+
+func TestBIP340Vectors(t *testing.T) {
+	t.Parallel()
+
+	for i, v := range bip340Vectors {
+		t.Run(fmt.Sprintf("vector %d", i), func(t *testing.T) {
+			pkBin, _ := hex.DecodeString(v.publicKey)
+			msg, _ := hex.DecodeString(v.message)
+			sig, _ := hex.DecodeString(v.signature)
+
+			if v.secretKey != "" {
+				skBin, _ := hex.DecodeString(v.secretKey)
+				auxRand, _ := hex.DecodeString(v.auxRand)
+
+				var privKey PrivateKey
+				copy(privKey.scalar[:], skBin)
+				privKey.PublicKey.A.ScalarMultiplicationBase(new(big.Int).SetBytes(skBin))
+				if !bytes.Equal(privKey.PublicKey.Bytes(), pkBin) {
+					t.Fatal("wrong public key")
+				}
+				res, err := privKey.SignWithAuxRand(msg, auxRand, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(res, sig) {
+					t.Fatal("wrong signature")
+				}
+			}
+
+			var publicKey PublicKey
+			if _, err := publicKey.SetBytes(pkBin); err != nil {
+				if v.result {
+					t.Fatal(err)
+				}
+				return
+			}
+			flag, _ := publicKey.Verify(sig, msg, nil)
+			if flag != v.result {
+				t.Fatalf("verification returned %v, expected %v", flag, v.result)
+			}
+		})
+	}
+}
+
+func TestBIP340BatchVerify(t *testing.T) {
+	t.Parallel()
+
+	// the valid vectors verify in a batch
+	var publicKeys []PublicKey
+	var messages, signatures [][]byte
+	for _, v := range bip340Vectors {
+		if !v.result {
+			continue
+		}
+		pkBin, _ := hex.DecodeString(v.publicKey)
+		msg, _ := hex.DecodeString(v.message)
+		sig, _ := hex.DecodeString(v.signature)
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytes(pkBin); err != nil {
+			t.Fatal(err)
+		}
+		publicKeys = append(publicKeys, publicKey)
+		messages = append(messages, msg)
+		signatures = append(signatures, sig)
+	}
+	if ok, err := BatchVerify(publicKeys, messages, signatures, nil); err != nil || !ok {
+		t.Fatal("batch of the valid BIP-340 vectors should verify", err)
+	}
+
+	// adding any invalid vector to the batch makes it fail
+	for i, v := range bip340Vectors {
+		if v.result {
+			continue
+		}
+		pkBin, _ := hex.DecodeString(v.publicKey)
+		msg, _ := hex.DecodeString(v.message)
+		sig, _ := hex.DecodeString(v.signature)
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytes(pkBin); err != nil {
+			continue
+		}
+		ok, _ := BatchVerify(append(publicKeys, publicKey), append(messages, msg), append(signatures, sig), nil)
+		if ok {
+			t.Fatalf("batch with the invalid vector %d should not verify", i)
+		}
+	}
+}
+
+func TestNegativeVerification(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	publicKey := privKey.PublicKey
+	msg := []byte("testing Schnorr")
+	sig, err := privKey.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// s ≥ n is rejected
+	var tampered Signature
+	tampered.SetBytes(sig)
+	order.FillBytes(tampered.S[:])
+	if flag, _ := publicKey.Verify(tampered.Bytes(), msg, nil); flag {
+		t.Fatal("signature with s = n should not verify")
+	}
+
+	// r not on the curve is rejected
+	tampered.SetBytes(sig)
+	tampered.R[sizeFp-1] ^= 1
+	if flag, _ := publicKey.Verify(tampered.Bytes(), msg, nil); flag {
+		t.Fatal("tampered signature should not verify")
+	}
+
+	// signing requires 32 bytes of auxiliary data
+	if _, err = privKey.SignWithAuxRand(msg, make([]byte, sizeAuxRand-1), nil); err != errInvalidAuxRand {
+		t.Fatal("signing should reject auxiliary data of the wrong size")
+	}
+}
+
+func TestBatchVerify(t *testing.T) {
+	t.Parallel()
+
+	const nbSigners = 10
+	publicKeys := make([]PublicKey, nbSigners)
+	messages := make([][]byte, nbSigners)
+	signatures := make([][]byte, nbSigners)
+	for i := 0; i < nbSigners; i++ {
+		privKey, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeys[i] = privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("testing Schnorr batch verification %d", i))
+		if signatures[i], err = privKey.Sign(messages[i], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if ok, err := BatchVerify(publicKeys, messages, signatures, nil); err != nil || !ok {
+		t.Fatal("batch of valid signatures should verify", err)
+	}
+
+	signatures[0], signatures[1] = signatures[1], signatures[0]
+	if ok, _ := BatchVerify(publicKeys, messages, signatures, nil); ok {
+		t.Fatal("batch with swapped signatures should not verify")
+	}
+
+	if _, err := BatchVerify(publicKeys, messages, signatures[1:], nil); err != errLengthMismatch {
+		t.Fatal("BatchVerify should reject inputs of different lengths")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkSignSchnorr(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+
+	msg := []byte("benchmarking Schnorr sign()")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.Sign(msg, nil)
+	}
+}
+
+func BenchmarkVerifySchnorr(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking Schnorr sign()")
+	sig, _ := privKey.Sign(msg, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.PublicKey.Verify(sig, msg, nil)
+	}
+}
+
+func BenchmarkBatchVerifySchnorr(b *testing.B) {
+	const nbSigners = 64
+	publicKeys := make([]PublicKey, nbSigners)
+	messages := make([][]byte, nbSigners)
+	signatures := make([][]byte, nbSigners)
+	for i := 0; i < nbSigners; i++ {
+		privKey, _ := GenerateKey(rand.Reader)
+		publicKeys[i] = privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("benchmarking Schnorr batch verification %d", i))
+		signatures[i], _ = privKey.Sign(messages[i], nil)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchVerify(publicKeys, messages, signatures, nil)
+	}
+}
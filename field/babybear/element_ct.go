@@ -0,0 +1,78 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"math/big"
+)
+
+// InverseCT z = x⁻¹ (mod q) and returns z, or z = 0 if x == 0
+//
+// It computes x^(q-2) in constant time with respect to x; it is much slower than Inverse
+// and should be used when x is secret.
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expCT(x, q-2)
+}
+
+// ExpCT z = xᵏ (mod q) and returns z
+//
+// It runs in constant time with respect to x and |k|: all the bits of a Bytes bytes exponent
+// are processed, whatever its bit length. If k is negative, x is inverted with InverseCT; the
+// sign of k is not secret. It panics if |k| ⩾ 2^(8⋅Bytes).
+func (z *Element) ExpCT(x Element, k *big.Int) *Element {
+	var abs big.Int
+	abs.Abs(k)
+	var buf [Bytes]byte
+	abs.FillBytes(buf[:])
+	if k.Sign() == -1 {
+		x.InverseCT(&x)
+	}
+	return z.expCT(&x, binary.BigEndian.Uint32(buf[:]))
+}
+
+// expCT z = xᵉ (mod q) with a fixed 4-bit window: all the bits of e are processed, and the
+// window is read from the table with lookupCT.
+func (z *Element) expCT(x *Element, e uint32) *Element {
+	var table [16]Element
+	table[0].SetOne()
+	table[1] = *x
+	for i := 2; i < len(table); i++ {
+		table[i][0] = montReduceBranchless(uint64(table[i-1][0]) * uint64(x[0]))
+	}
+
+	var res, t Element
+	res.SetOne()
+	for j := 28; j >= 0; j -= 4 {
+		for k := 0; k < 4; k++ {
+			res[0] = montReduceBranchless(uint64(res[0]) * uint64(res[0]))
+		}
+		t.lookupCT(&table, (e>>j)&0xf)
+		res[0] = montReduceBranchless(uint64(res[0]) * uint64(t[0]))
+	}
+	*z = res
+	return z
+}
+
+// lookupCT sets z = table[i], reading all the entries of the table
+func (z *Element) lookupCT(table *[16]Element, i uint32) {
+	z.SetZero()
+	for j := range table {
+		z.Select(subtle.ConstantTimeEq(int32(j), int32(i)), z, &table[j])
+	}
+}
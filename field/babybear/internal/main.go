@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	// q = 2³¹ - 2²⁷ + 1
+	const modulus = "0x78000001"
+	babybear, err := config.NewFieldConfig("babybear", "Element", modulus, false)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(babybear, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated babybear field")
+}
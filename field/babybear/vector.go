@@ -0,0 +1,368 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/consensys/gnark-crypto/utils"
+)
+
+// Vector represents a slice of Element.
+//
+// It implements the following interfaces:
+//   - Stringer
+//   - io.WriterTo
+//   - io.ReaderFrom
+//   - encoding.BinaryMarshaler
+//   - encoding.BinaryUnmarshaler
+//   - sort.Interface
+type Vector []Element
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (vector *Vector) MarshalBinary() (data []byte, err error) {
+	var buf bytes.Buffer
+
+	if _, err = vector.WriteTo(&buf); err != nil {
+		return
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (vector *Vector) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	_, err := vector.ReadFrom(r)
+	return err
+}
+
+// WriteTo implements io.WriterTo and writes a vector of big endian encoded Element.
+// Length of the vector is encoded as a uint32 on the first 4 bytes.
+func (vector *Vector) WriteTo(w io.Writer) (int64, error) {
+	// encode slice length
+	if err := binary.Write(w, binary.BigEndian, uint32(len(*vector))); err != nil {
+		return 0, err
+	}
+
+	n := int64(4)
+
+	var buf [Bytes]byte
+	for i := 0; i < len(*vector); i++ {
+		BigEndian.PutElement(&buf, (*vector)[i])
+		m, err := w.Write(buf[:])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom implements io.ReaderFrom and reads a vector of big endian encoded Element.
+// Length of the vector must be encoded as a uint32 on the first 4 bytes.
+func (vector *Vector) ReadFrom(r io.Reader) (int64, error) {
+
+	var buf [Bytes]byte
+	if read, err := io.ReadFull(r, buf[:4]); err != nil {
+		return int64(read), err
+	}
+	sliceLen := binary.BigEndian.Uint32(buf[:4])
+
+	n := int64(4)
+	(*vector) = make(Vector, sliceLen)
+
+	for i := 0; i < int(sliceLen); i++ {
+		read, err := io.ReadFull(r, buf[:])
+		n += int64(read)
+		if err != nil {
+			return n, err
+		}
+		(*vector)[i], err = BigEndian.Element(&buf)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// String implements fmt.Stringer interface
+func (vector Vector) String() string {
+	var sbb strings.Builder
+	sbb.WriteByte('[')
+	for i := 0; i < len(vector); i++ {
+		sbb.WriteString(vector[i].String())
+		if i != len(vector)-1 {
+			sbb.WriteByte(',')
+		}
+	}
+	sbb.WriteByte(']')
+	return sbb.String()
+}
+
+// Len is the number of elements in the collection.
+func (vector Vector) Len() int {
+	return len(vector)
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (vector Vector) Less(i, j int) bool {
+	return vector[i].Cmp(&vector[j]) == -1
+}
+
+// Swap swaps the elements with indexes i and j.
+func (vector Vector) Swap(i, j int) {
+	vector[i], vector[j] = vector[j], vector[i]
+}
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	v := *vector
+	for i := 0; i < len(a); i++ {
+		v[i][0] = reduceOnce(a[i][0] + b[i][0])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	v := *vector
+	for i := 0; i < len(a); i++ {
+		v[i][0] = reduceOnce(a[i][0] + q - b[i][0])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	v := *vector
+	s := uint64(b[0])
+	for i := 0; i < len(a); i++ {
+		v[i][0] = montReduceBranchless(uint64(a[i][0]) * s)
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	v := *vector
+	for i := 0; i < len(a); i++ {
+		v[i][0] = montReduceBranchless(uint64(a[i][0]) * uint64(b[i][0]))
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	// elements are < 2³¹; we accumulate blocks of them in a uint64 without overflow,
+	// and reduce once per block
+	v := *vector
+	var acc uint64
+	for start := 0; start < len(v); start += blockSize {
+		end := start + blockSize
+		if end > len(v) {
+			end = len(v)
+		}
+		var s uint64
+		for i := start; i < end; i++ {
+			s += uint64(v[i][0])
+		}
+		acc = (acc + s) % uint64(q)
+	}
+	res[0] = uint32(acc)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	v := *vector
+	if len(v) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	// products are < q² < 2⁶²; we sum them by pairs before a Montgomery reduction,
+	// and accumulate blocks of the reduced values (< q) in a uint64 without overflow.
+	var acc uint64
+	for start := 0; start < len(v); start += blockSize {
+		end := start + blockSize
+		if end > len(v) {
+			end = len(v)
+		}
+		var s uint64
+		i := start
+		for ; i+1 < end; i += 2 {
+			t := uint64(v[i][0])*uint64(other[i][0]) + uint64(v[i+1][0])*uint64(other[i+1][0])
+			s += uint64(montReduceBranchless(t))
+		}
+		if i < end {
+			s += uint64(montReduceBranchless(uint64(v[i][0]) * uint64(other[i][0])))
+		}
+		acc = (acc + s) % uint64(q)
+	}
+	res[0] = uint32(acc)
+	return
+}
+
+// BatchInvert inverts a element-wise and stores the result in self, using the Montgomery
+// batch inversion trick; zeros are mapped to zero. a and self may be the same vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) BatchInvert(a Vector) {
+	if len(a) != len(*vector) {
+		panic("vector.BatchInvert: vectors don't have the same length")
+	}
+	if len(a) == 0 {
+		return
+	}
+	v := *vector
+
+	// prefix[i] is the product of the non-zero elements of a[0...i-1]
+	prefix := make(Vector, len(a))
+	var acc Element
+	acc.SetOne()
+	for i := 0; i < len(a); i++ {
+		prefix[i] = acc
+		if !a[i].IsZero() {
+			acc.Mul(&acc, &a[i])
+		}
+	}
+
+	acc.Inverse(&acc)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			v[i].SetZero()
+			continue
+		}
+		var inv Element
+		inv.Mul(&acc, &prefix[i])
+		acc.Mul(&acc, &a[i])
+		v[i] = inv
+	}
+}
+
+// FromCanonicalLimbs sets the vector to the elements whose canonical (non-Montgomery) values
+// are given by limbs. The vector is resized to len(limbs) elements; the conversion is
+// parallelized.
+//
+// It returns an error if a value is not reduced modulo q; the vector is then left unchanged.
+func (vector *Vector) FromCanonicalLimbs(limbs []uint32) error {
+	n := len(limbs)
+
+	// index of the first non-reduced element found, -1 if none
+	var lock sync.Mutex
+	invalid := -1
+
+	utils.Parallelize(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			if limbs[i] >= q {
+				lock.Lock()
+				if invalid == -1 || i < invalid {
+					invalid = i
+				}
+				lock.Unlock()
+				return
+			}
+		}
+	})
+
+	if invalid != -1 {
+		return fmt.Errorf("vector.FromCanonicalLimbs: element %d is not reduced", invalid)
+	}
+
+	if cap(*vector) < n {
+		*vector = make(Vector, n)
+	} else {
+		*vector = (*vector)[:n]
+	}
+	v := *vector
+
+	utils.Parallelize(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			// to Montgomery form: x⋅r = x⋅r²⋅r⁻¹
+			v[i][0] = montReduceBranchless(uint64(limbs[i]) * uint64(rSquare[0]))
+		}
+	})
+
+	return nil
+}
+
+// ToCanonicalLimbs returns the canonical (non-Montgomery) values of the elements.
+// The conversion is parallelized.
+func (vector Vector) ToCanonicalLimbs() []uint32 {
+	limbs := make([]uint32, len(vector))
+	utils.Parallelize(len(vector), func(start, end int) {
+		for i := start; i < end; i++ {
+			limbs[i] = montReduceBranchless(uint64(vector[i][0]))
+		}
+	})
+	return limbs
+}
+
+// MontgomeryLimbs returns the words of the elements in Montgomery form, without copy:
+// the returned slice aliases the vector.
+func (vector Vector) MontgomeryLimbs() []uint32 {
+	if len(vector) == 0 {
+		return nil
+	}
+	return unsafe.Slice(&vector[0][0], len(vector))
+}
+
+// VectorFromMontgomeryLimbs returns the vector of the elements in Montgomery form given by
+// limbs, without copy: the returned vector aliases limbs. The values are not checked to be
+// reduced modulo q.
+func VectorFromMontgomeryLimbs(limbs []uint32) Vector {
+	if len(limbs) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*Element)(unsafe.Pointer(&limbs[0])), len(limbs))
+}
+
+// blockSize is the number of values < 2³¹ that can be accumulated in a uint64
+// on top of a value < q without overflow
+const blockSize = 1 << 30
+
+// reduceOnce returns x mod q, for x < 2q; it is branch-free
+func reduceOnce(x uint32) uint32 {
+	// x - q ∈ [-q, q) fits an int32; its sign bit selects whether q must be added back
+	x -= q
+	return x + (q & uint32(int32(x)>>31))
+}
+
+// montReduceBranchless returns v⋅r⁻¹ (mod q), for v < 2q²; it is branch-free
+func montReduceBranchless(v uint64) uint32 {
+	m := uint32(v) * qInvNeg
+	return reduceOnce(uint32((v + uint64(m)*uint64(q)) >> 32))
+}
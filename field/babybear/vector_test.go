@@ -0,0 +1,223 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := make(Vector, 3)
+	v[0].SetUint64(2)
+	v[1].SetUint64(3)
+	v[2].SetUint64(1)
+
+	sort.Sort(v)
+
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 3)
+	v1[0].SetUint64(2)
+	v1[1].SetUint64(3)
+	v1[2].SetUint64(1)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+}
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	// odd length, with the extreme values
+	const n = 1025
+	a, b := make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		b[i].SetRandom()
+	}
+	a[0].SetUint64(0)
+	a[1].SetInt64(-1)
+	b[1].SetInt64(-1)
+	b[2].SetInt64(-1)
+
+	var s Element
+	s.SetRandom()
+
+	sum, diff, prod, scaled := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+	sum.Add(a, b)
+	diff.Sub(a, b)
+	prod.Mul(a, b)
+	scaled.ScalarMul(a, &s)
+
+	var expectedSum, expectedInnerProduct Element
+	for i := 0; i < n; i++ {
+		var tmp Element
+		assert.True(sum[i].Equal(tmp.Add(&a[i], &b[i])), "Add mismatch at index %d", i)
+		assert.True(diff[i].Equal(tmp.Sub(&a[i], &b[i])), "Sub mismatch at index %d", i)
+		assert.True(prod[i].Equal(tmp.Mul(&a[i], &b[i])), "Mul mismatch at index %d", i)
+		assert.True(scaled[i].Equal(tmp.Mul(&a[i], &s)), "ScalarMul mismatch at index %d", i)
+		expectedSum.Add(&expectedSum, &a[i])
+		expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+	}
+
+	s = a.Sum()
+	assert.True(s.Equal(&expectedSum), "Sum mismatch")
+	s = a.InnerProduct(b)
+	assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch")
+
+	// q - 1 everywhere maximizes the accumulators
+	for i := 0; i < n; i++ {
+		a[i].SetInt64(-1)
+	}
+	var expected Element
+	expected.SetInt64(-n)
+	s = a.Sum()
+	assert.True(s.Equal(&expected), "Sum mismatch on q - 1")
+	expected.SetInt64(n)
+	s = a.InnerProduct(a)
+	assert.True(s.Equal(&expected), "InnerProduct mismatch on q - 1")
+
+	assert.Panics(func() { sum.Add(a, b[1:]) })
+	assert.Panics(func() { a.InnerProduct(b[1:]) })
+}
+
+func TestVectorBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[n/2].SetZero()
+
+	expected := BatchInvert(a)
+	inv := make(Vector, n)
+	inv.BatchInvert(a)
+	assert.True(reflect.DeepEqual(Vector(expected), inv))
+
+	// in place
+	a.BatchInvert(a)
+	assert.True(reflect.DeepEqual(a, inv))
+
+	assert.Panics(func() { a.BatchInvert(inv[1:]) })
+}
+
+func TestVectorCanonicalLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 1000
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[1].SetOne()
+
+	expected := make([]uint32, 1*n)
+	for i := 0; i < n; i++ {
+		expected[i] = uint32(a[i].Uint64())
+	}
+	limbs := a.ToCanonicalLimbs()
+	assert.Equal(expected, limbs)
+
+	var v Vector
+	assert.NoError(v.FromCanonicalLimbs(limbs))
+	assert.True(reflect.DeepEqual(a, v))
+
+	// values ⩾ q are rejected
+	bad := make([]uint32, len(limbs))
+	copy(bad, limbs)
+	bad[n/2] = q
+	err := v.FromCanonicalLimbs(bad)
+	assert.Error(err)
+	assert.Contains(err.Error(), "element 500")
+	assert.True(reflect.DeepEqual(a, v), "the vector should be left unchanged on error")
+
+	// empty vectors
+	assert.NoError(v.FromCanonicalLimbs(nil))
+	assert.Equal(0, len(v))
+	assert.Equal(0, len(Vector{}.ToCanonicalLimbs()))
+}
+
+func TestVectorMontgomeryLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+
+	limbs := a.MontgomeryLimbs()
+	assert.Equal(1*n, len(limbs))
+	for i := 0; i < n; i++ {
+		assert.Equal(a[i][:], limbs[1*i:1*(i+1)])
+	}
+
+	// the views alias the vector
+	v := VectorFromMontgomeryLimbs(limbs)
+	assert.True(reflect.DeepEqual(a, v))
+	v[3].SetOne()
+	assert.True(a[3].IsOne())
+
+	assert.Nil(Vector{}.MontgomeryLimbs())
+	assert.Nil(VectorFromMontgomeryLimbs(nil))
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 20
+	a, c, res := make(Vector, n), make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		c[i].SetRandom()
+	}
+
+	b.Run("Add", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+}
@@ -158,6 +158,12 @@ func Generate(w io.Writer, F *config.FieldConfig) error {
 	// fft butterflies
 	f.generateButterfly()
 
+	// vector operations
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateSumVec(true)
+	f.generateSumVec(false)
+
 	return nil
 }
 
@@ -176,6 +182,10 @@ func GenerateMul(w io.Writer, F *config.FieldConfig) error {
 	// from mont
 	f.generateFromMont(false)
 
+	// vector multiplications
+	f.generateMulVec(false)
+	f.generateMulVec(true)
+
 	return nil
 }
 
@@ -178,158 +178,167 @@ func (f *FFAmd64) generateMul(forceADX bool) {
 	}
 
 	{
-		// we need to access x and y words, per index
-		var xat, yat func(int) string
-		var gc func()
-
-		// we need NbWords registers for t, plus optionally one for tmp register in mulADX if we want to avoid PUSH/POP
-		nbRegisters := registers.Available()
-		if nbRegisters < f.NbWords {
-			panic("not enough registers, not supported.")
+		t := f.mulBody(&registers, "x+8(FP)", "y+16(FP)")
+		f.MOVQ("res+0(FP)", amd64.AX)
+		f.Mov(t, amd64.AX)
+		f.RET()
+	}
+
+	// ---------------------------------------------------------------------------------------------
+	// no MULX, ADX instructions
+	if !forceADX {
+		f.LABEL(noAdx)
+
+		f.MOVQ("res+0(FP)", amd64.AX)
+		f.MOVQ(amd64.AX, "(SP)")
+		f.MOVQ("x+8(FP)", amd64.AX)
+		f.MOVQ(amd64.AX, "8(SP)")
+		f.MOVQ("y+16(FP)", amd64.AX)
+		f.MOVQ(amd64.AX, "16(SP)")
+		f.WriteLn("CALL ·_mulGeneric(SB)")
+		f.RET()
+
+	}
+}
+
+// mulBody computes the Montgomery product of the elements whose addresses are stored at
+// xPtr and yPtr (typically arguments in the frame), and returns the reduced result registers.
+//
+// It requires ADX and BMI2 instructions; DX and AX must be reserved.
+func (f *FFAmd64) mulBody(registers *amd64.Registers, xPtr, yPtr string) []amd64.Register {
+	// we need to access x and y words, per index
+	var xat, yat func(int) string
+	var gc func()
+
+	// we need NbWords registers for t, plus optionally one for tmp register in mulADX if we want to avoid PUSH/POP
+	nbRegisters := registers.Available()
+	if nbRegisters < f.NbWords {
+		panic("not enough registers, not supported.")
+	}
+
+	t := registers.PopN(f.NbWords)
+	nbRegisters = registers.Available()
+	switch nbRegisters {
+	case 0:
+		// y is access through use of AX/DX
+		yat = func(i int) string {
+			y := amd64.AX
+			f.MOVQ(yPtr, y)
+			return y.At(i)
 		}
 
-		t := registers.PopN(f.NbWords)
-		nbRegisters = registers.Available()
-		switch nbRegisters {
-		case 0:
-			// y is access through use of AX/DX
-			yat = func(i int) string {
-				y := amd64.AX
-				f.MOVQ("y+16(FP)", y)
-				return y.At(i)
-			}
+		// we move x on the stack.
+		f.MOVQ(xPtr, amd64.AX)
+		_x := f.PopN(registers, true)
+		f.LabelRegisters("x", _x...)
+		f.Mov(amd64.AX, t)
+		f.Mov(t, _x)
+		xat = func(i int) string {
+			return string(_x[i])
+		}
+		gc = func() {
+			f.Push(registers, _x...)
+		}
+	case 1:
+		// y is access through use of AX/DX
+		yat = func(i int) string {
+			y := amd64.AX
+			f.MOVQ(yPtr, y)
+			return y.At(i)
+		}
+		// x uses the register
+		x := registers.Pop()
+		f.MOVQ(xPtr, x)
+		xat = func(i int) string {
+			return x.At(i)
+		}
+		gc = func() {
+			registers.Push(x)
+		}
+	case 2, 3:
+		// x, y uses registers
+		x := registers.Pop()
+		y := registers.Pop()
+
+		f.MOVQ(xPtr, x)
+		f.MOVQ(yPtr, y)
 
-			// we move x on the stack.
-			f.MOVQ("x+8(FP)", amd64.AX)
-			_x := f.PopN(&registers, true)
+		xat = func(i int) string {
+			return x.At(i)
+		}
+
+		yat = func(i int) string {
+			return y.At(i)
+		}
+		gc = func() {
+			registers.Push(x, y)
+		}
+	default:
+		// we have a least 4 registers.
+		// 1 for tmp.
+		nbRegisters--
+		// 1 for y
+		nbRegisters--
+		var y amd64.Register
+
+		if nbRegisters >= f.NbWords {
+			// we store x fully in registers
+			x := registers.Pop()
+			f.MOVQ(xPtr, x)
+			_x := registers.PopN(f.NbWords)
 			f.LabelRegisters("x", _x...)
-			f.Mov(amd64.AX, t)
-			f.Mov(t, _x)
+			f.Mov(x, _x)
+
 			xat = func(i int) string {
 				return string(_x[i])
 			}
+			registers.Push(x)
 			gc = func() {
-				f.Push(&registers, _x...)
-			}
-		case 1:
-			// y is access through use of AX/DX
-			yat = func(i int) string {
-				y := amd64.AX
-				f.MOVQ("y+16(FP)", y)
-				return y.At(i)
-			}
-			// x uses the register
-			x := registers.Pop()
-			f.MOVQ("x+8(FP)", x)
-			xat = func(i int) string {
-				return x.At(i)
-			}
-			gc = func() {
-				registers.Push(x)
+				registers.Push(y)
+				registers.Push(_x...)
 			}
-		case 2, 3:
-			// x, y uses registers
+		} else {
+			// we take at least 1 register for x addr
+			nbRegisters--
 			x := registers.Pop()
-			y := registers.Pop()
-
-			f.MOVQ("x+8(FP)", x)
-			f.MOVQ("y+16(FP)", y)
+			y = registers.Pop() // temporary lock 1 for y
+			f.MOVQ(xPtr, x)
 
+			// and use the rest for x0...xn
+			_x := registers.PopN(nbRegisters)
+			f.LabelRegisters("x", _x...)
+			for i := 0; i < len(_x); i++ {
+				f.MOVQ(x.At(i), _x[i])
+			}
 			xat = func(i int) string {
+				if i < len(_x) {
+					return string(_x[i])
+				}
 				return x.At(i)
 			}
+			registers.Push(y)
 
-			yat = func(i int) string {
-				return y.At(i)
-			}
 			gc = func() {
 				registers.Push(x, y)
-			}
-		default:
-			// we have a least 4 registers.
-			// 1 for tmp.
-			nbRegisters--
-			// 1 for y
-			nbRegisters--
-			var y amd64.Register
-
-			if nbRegisters >= f.NbWords {
-				// we store x fully in registers
-				x := registers.Pop()
-				f.MOVQ("x+8(FP)", x)
-				_x := registers.PopN(f.NbWords)
-				f.LabelRegisters("x", _x...)
-				f.Mov(x, _x)
-
-				xat = func(i int) string {
-					return string(_x[i])
-				}
-				registers.Push(x)
-				gc = func() {
-					registers.Push(y)
-					registers.Push(_x...)
-				}
-			} else {
-				// we take at least 1 register for x addr
-				nbRegisters--
-				x := registers.Pop()
-				y = registers.Pop() // temporary lock 1 for y
-				f.MOVQ("x+8(FP)", x)
-
-				// and use the rest for x0...xn
-				_x := registers.PopN(nbRegisters)
-				f.LabelRegisters("x", _x...)
-				for i := 0; i < len(_x); i++ {
-					f.MOVQ(x.At(i), _x[i])
-				}
-				xat = func(i int) string {
-					if i < len(_x) {
-						return string(_x[i])
-					}
-					return x.At(i)
-				}
-				registers.Push(y)
-
-				gc = func() {
-					registers.Push(x, y)
-					registers.Push(_x...)
-				}
-
-			}
-			y = registers.Pop()
-
-			f.MOVQ("y+16(FP)", y)
-			yat = func(i int) string {
-				return y.At(i)
+				registers.Push(_x...)
 			}
 
 		}
+		y = registers.Pop()
 
-		f.MulADX(&registers, xat, yat, t)
-		gc()
-
-		// ---------------------------------------------------------------------------------------------
-		// reduce
-		f.Reduce(&registers, t)
+		f.MOVQ(yPtr, y)
+		yat = func(i int) string {
+			return y.At(i)
+		}
 
-		f.MOVQ("res+0(FP)", amd64.AX)
-		f.Mov(t, amd64.AX)
-		f.RET()
 	}
 
-	// ---------------------------------------------------------------------------------------------
-	// no MULX, ADX instructions
-	if !forceADX {
-		f.LABEL(noAdx)
+	f.MulADX(registers, xat, yat, t)
+	gc()
 
-		f.MOVQ("res+0(FP)", amd64.AX)
-		f.MOVQ(amd64.AX, "(SP)")
-		f.MOVQ("x+8(FP)", amd64.AX)
-		f.MOVQ(amd64.AX, "8(SP)")
-		f.MOVQ("y+16(FP)", amd64.AX)
-		f.MOVQ(amd64.AX, "16(SP)")
-		f.WriteLn("CALL ·_mulGeneric(SB)")
-		f.RET()
+	// ---------------------------------------------------------------------------------------------
+	// reduce
+	f.Reduce(registers, t)
 
-	}
+	return t
 }
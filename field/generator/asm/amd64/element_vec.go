@@ -0,0 +1,244 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// the vector kernels take (res, a, b *Element, n uint64); the addresses and the counter
+// are kept in the frame and advanced in place, so that all the registers are available
+// for the arithmetic, whatever the number of words.
+const (
+	vecArgSize = 4 * 8
+	vecRes     = "res+0(FP)"
+	vecA       = "a+8(FP)"
+	vecB       = "b+16(FP)"
+	vecN       = "n+24(FP)"
+)
+
+// vecLoop writes the loop of a vector kernel; body processes the element at the
+// current addresses, and the addresses in advance are incremented after each iteration.
+func (f *FFAmd64) vecLoop(body func(), advance ...string) {
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.CMPQ(vecN, 0)
+	f.JEQ(done, "n == 0, nothing to do")
+
+	f.LABEL(loop)
+	body()
+	for _, a := range advance {
+		f.ADDQ(8*f.NbWords, a)
+	}
+	f.DECQ(vecN)
+	f.JNE(loop)
+
+	f.LABEL(done)
+	f.RET()
+}
+
+// generateAddVec res[i] = a[i] + b[i] for i < n
+func (f *FFAmd64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+
+	stackSize := f.StackSize(1+2*f.NbWords, 0, 0)
+	registers := f.FnHeader("addVec", stackSize, vecArgSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	r := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	scratch := f.PopN(&registers)
+
+	f.vecLoop(func() {
+		f.MOVQ(vecA, r)
+		f.Mov(r, t)
+		f.MOVQ(vecB, r)
+		f.Add(r, t)
+
+		f.ReduceElement(t, scratch)
+
+		f.MOVQ(vecRes, r)
+		f.Mov(t, r)
+	}, vecRes, vecA, vecB)
+
+	f.Push(&registers, scratch...)
+	registers.Push(t...)
+	registers.Push(r)
+}
+
+// generateSubVec res[i] = a[i] - b[i] for i < n
+func (f *FFAmd64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+
+	// when there are enough registers, q is conditionally added without a branch;
+	// otherwise, q is added from memory when the subtraction borrows.
+	branchFree := 2+2*f.NbWords <= amd64.NbRegisters
+	nbRegisters := 1 + f.NbWords
+	if branchFree {
+		nbRegisters = 2 + 2*f.NbWords
+	}
+	stackSize := f.StackSize(nbRegisters, 0, 0)
+	registers := f.FnHeader("subVec", stackSize, vecArgSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	r := registers.Pop()
+	t := registers.PopN(f.NbWords)
+
+	if branchFree {
+		zero := registers.Pop()
+		q := registers.PopN(f.NbWords)
+
+		f.vecLoop(func() {
+			f.XORQ(zero, zero)
+			f.MOVQ(vecA, r)
+			f.Mov(r, t)
+			f.MOVQ(vecB, r)
+			f.Sub(r, t)
+
+			// q if the subtraction borrowed, 0 otherwise
+			f.Mov(f.Q, q)
+			for i := 0; i < f.NbWords; i++ {
+				f.CMOVQCC(zero, q[i])
+			}
+			f.Add(q, t)
+
+			f.MOVQ(vecRes, r)
+			f.Mov(t, r)
+		}, vecRes, vecA, vecB)
+
+		registers.Push(q...)
+		registers.Push(zero)
+	} else {
+		f.vecLoop(func() {
+			f.MOVQ(vecA, r)
+			f.Mov(r, t)
+			f.MOVQ(vecB, r)
+			f.Sub(r, t)
+
+			noReduce := f.NewLabel()
+			f.JCC(noReduce)
+			for i := 0; i < f.NbWords; i++ {
+				if i == 0 {
+					f.ADDQ(f.qAt(i), t[i])
+				} else {
+					f.ADCQ(f.qAt(i), t[i])
+				}
+			}
+			f.LABEL(noReduce)
+
+			f.MOVQ(vecRes, r)
+			f.Mov(t, r)
+		}, vecRes, vecA, vecB)
+	}
+
+	registers.Push(t...)
+	registers.Push(r)
+}
+
+// generateMulVec res[i] = a[i] * b[i] (or res[i] = a[i] * b if scalar is set) for i < n;
+// the kernels require ADX and BMI2 instructions, the caller must check for them.
+func (f *FFAmd64) generateMulVec(scalar bool) {
+	name := "mulVec"
+	if scalar {
+		name = "scalarMulVec"
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]")
+	}
+
+	// MulADX uses BP: the frame must not be empty, so that the frame pointer is saved
+	const minStackSize = 8
+	stackSize := f.StackSize(f.NbWords*2, 2, minStackSize)
+	reserved := []amd64.Register{amd64.DX, amd64.AX}
+	if f.NbWords <= 5 {
+		// see generateMul
+		reserved = append(reserved, amd64.R15)
+	}
+	registers := f.FnHeader(name, stackSize, vecArgSize, reserved...)
+	defer f.AssertCleanStack(stackSize, minStackSize)
+
+	if stackSize > 0 {
+		f.WriteLn("NO_LOCAL_POINTERS")
+	}
+
+	advance := []string{vecRes, vecA, vecB}
+	if scalar {
+		advance = advance[:2]
+	}
+	f.vecLoop(func() {
+		t := f.mulBody(&registers, vecA, vecB)
+		f.MOVQ(vecRes, amd64.AX)
+		f.Mov(t, amd64.AX)
+		registers.Push(t...)
+	}, advance...)
+}
+
+// generateSumVec accumulates the 32-bit words of a[0...n] in 64-bit lanes, with AVX-512
+// or AVX2 instructions:
+//
+//	sumVecAVX512(t *[8*N]uint64, a *Element, n uint64), n multiple of 4
+//	sumVecAVX2(t *[8*N]uint64, a *Element, n uint64), n multiple of 2
+//
+// A group of 4 (resp. 2) elements fills exactly N zmm (resp. ymm) registers once its
+// 32-bit words are zero extended; N accumulators thus cover all the words of the group,
+// and the accumulator lane m (t[m]) sums the words at position m mod 2N of the elements.
+// The caller folds the lanes and reduces the result; lanes don't overflow for n < 2³².
+func (f *FFAmd64) generateSumVec(avx512 bool) {
+	name, reg, groupSize := "sumVecAVX2", "Y", 2
+	zero, store := "VPXOR", "VMOVDQU"
+	if avx512 {
+		name, reg, groupSize = "sumVecAVX512", "Z", 4
+		zero, store = "VPXORQ", "VMOVDQU64"
+	}
+	loadSize := 4 * 2 * groupSize // bytes of 32-bit words zero extended in a register
+
+	f.Comment(fmt.Sprintf("%s(t *[%d]uint64, a *Element, n uint64)", name, 8*f.NbWords))
+	f.FnHeader(name, 0, 3*8)
+
+	acc := func(k int) string { return fmt.Sprintf("%s%d", reg, k) }
+	tmp := func(k int) string { return fmt.Sprintf("%s%d", reg, f.NbWords+k%2) }
+
+	f.MOVQ("a+8(FP)", amd64.AX)
+	f.MOVQ("n+16(FP)", amd64.CX)
+	f.SHRQ(fmt.Sprintf("$%d", groupSize/2), amd64.CX, fmt.Sprintf("number of groups of %d elements", groupSize))
+	for k := 0; k < f.NbWords; k++ {
+		f.WriteLn(fmt.Sprintf("%s %s, %s, %s", zero, acc(k), acc(k), acc(k)))
+	}
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	f.TESTQ(amd64.CX, amd64.CX)
+	f.JEQ(done)
+
+	f.LABEL(loop)
+	for k := 0; k < f.NbWords; k++ {
+		f.WriteLn(fmt.Sprintf("VPMOVZXDQ %d(AX), %s", loadSize*k, tmp(k)))
+		f.WriteLn(fmt.Sprintf("VPADDQ %s, %s, %s", tmp(k), acc(k), acc(k)))
+	}
+	f.ADDQ(8*f.NbWords*groupSize, amd64.AX)
+	f.DECQ(amd64.CX)
+	f.JNE(loop)
+
+	f.LABEL(done)
+	f.MOVQ("t+0(FP)", amd64.AX)
+	for k := 0; k < f.NbWords; k++ {
+		f.WriteLn(fmt.Sprintf("%s %s, %d(AX)", store, acc(k), 2*loadSize*k))
+	}
+	f.WriteLn("VZEROUPPER")
+	f.RET()
+}
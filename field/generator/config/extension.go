@@ -4,12 +4,16 @@ import "math/big"
 
 type Element []big.Int
 
-// Extension is a simple radical extension, obtained by adjoining ⁿ√α to Fp
+// Extension is a simple radical extension, obtained by adjoining ⁿ√α to Fp, or to
+// another extension (Parent) in a tower of extensions
 type Extension struct {
 	Base   *FieldConfig //Fp
 	Size   big.Int      //q
 	Degree int          //n such that q = pⁿ TODO: Make uint8 so forced to be positive and small
 	RootOf int64        //α
+
+	Parent     *Extension // the extension this one extends, nil for an extension of Fp
+	NonResidue Element    // α, as coordinates over Fp in the basis of Parent; used iff Parent != nil
 }
 
 func NewTower(base *FieldConfig, degree uint8, rootOf int64) Extension {
@@ -242,3 +246,38 @@ func NewElement(s []string) []big.Int {
 	}
 	return res
 }
+
+// FrobeniusCoefficients returns γᵢ = α^(i(p-1)/n) for 0 ≤ i < n, so that the
+// Frobenius map x ↦ xᵖ sends ∑ xᵢuⁱ to ∑ xᵢγᵢuⁱ, where u = ⁿ√α.
+func (f *Extension) FrobeniusCoefficients() []big.Int {
+	var e big.Int
+	e.Sub(f.Base.ModulusBig, big.NewInt(1)).Div(&e, big.NewInt(int64(f.Degree)))
+
+	alpha := big.NewInt(f.RootOf)
+	alpha.Mod(alpha, f.Base.ModulusBig)
+	var gamma big.Int
+	gamma.Exp(alpha, &e, f.Base.ModulusBig)
+
+	res := make([]big.Int, f.Degree)
+	res[0].SetInt64(1)
+	for i := 1; i < f.Degree; i++ {
+		f.Base.Mul(&res[i], &res[i-1], &gamma)
+	}
+	return res
+}
+
+// IsIrreducible returns true if Xⁿ - α is irreducible over Fp, for a prime
+// degree n. That is the case iff n divides p-1 and α is not an n-th power.
+func (f *Extension) IsIrreducible() bool {
+	n := big.NewInt(int64(f.Degree))
+	if !n.ProbablyPrime(0) {
+		panic("only prime degrees are supported")
+	}
+	var pMinusOne, rem big.Int
+	pMinusOne.Sub(f.Base.ModulusBig, big.NewInt(1))
+	if rem.Mod(&pMinusOne, n).BitLen() != 0 {
+		return false
+	}
+	gamma := f.FrobeniusCoefficients()
+	return gamma[1].Cmp(big.NewInt(1)) != 0
+}
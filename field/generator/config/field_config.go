@@ -66,12 +66,24 @@ type FieldConfig struct {
 	SqrtSMinusOneOver2        string   // big.Int to base16 string
 	SqrtQ3Mod4Exponent        string   // big.Int to base16 string
 	SqrtG                     []uint64 // NonResidue ^  SqrtR (montgomery form)
+	SqrtTables                bool     // Tonelli-Shanks with precomputed tables of roots of unity (high 2-adicity)
+	SqrtTablesWindow          uint64   // the discrete logarithm of xˢ is computed SqrtTablesWindow bits at a time
+	SqrtTablesFirstWindow     uint64   // size of the first (least significant) window
+	SqrtTablesNbWindows       uint64
+	CbrtQ2Mod3                bool   // q ≡ 2 (mod 3), every element has a unique cube root
+	CbrtTonelliShanks         bool   // q ≡ 1 (mod 3), cube roots computed with a Tonelli-Shanks variant
+	CbrtE                     uint64 // q-1 = 3ᵉ * s, s prime to 3
+	CbrtSMod3                 uint64
+	CbrtExponent              string   // big.Int to base16 string
+	CbrtG                     []uint64 // NonCubicResidue ^ s (montgomery form)
+	CbrtOmega                 []uint64 // primitive cube root of unity CbrtG^(3ᵉ⁻¹) (montgomery form)
 	NonResidue                big.Int  // (montgomery form)
 	LegendreExponentData      *addchain.AddChainData
 	SqrtAtkinExponentData     *addchain.AddChainData
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
 	UseAddChain               bool
+	F31                       bool // q fits on 31 bits, elements are stored on a uint32 and r = 2³²
 }
 
 // NewFieldConfig returns a data structure with needed information to generate apis for field element
@@ -97,6 +109,10 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	F.NbBits = bModulus.BitLen()
 	F.NbWords = len(bModulus.Bits())
 	F.NbBytes = F.NbWords * 8 // (F.NbBits + 7) / 8
+	F.F31 = F.NbBits <= 31
+	if F.F31 {
+		F.NbBytes = 4
+	}
 
 	F.NbWordsLastIndex = F.NbWords - 1
 
@@ -110,7 +126,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 
 	//  setting qInverse
 	_r := big.NewInt(1)
-	_r.Lsh(_r, uint(F.NbWords)*64)
+	_r.Lsh(_r, F.rBits())
 	_rInv := big.NewInt(1)
 	_qInv := big.NewInt(0)
 	extendedEuclideanAlgo(_r, &bModulus, _rInv, _qInv)
@@ -139,19 +155,19 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 
 	// rsquare
 	_rSquare := big.NewInt(2)
-	exponent := big.NewInt(int64(F.NbWords) * 64 * 2)
+	exponent := big.NewInt(int64(F.rBits()) * 2)
 	_rSquare.Exp(_rSquare, exponent, &bModulus)
 	F.RSquare = toUint64Slice(_rSquare, F.NbWords)
 
 	var one big.Int
 	one.SetUint64(1)
-	one.Lsh(&one, uint(F.NbWords)*64).Mod(&one, &bModulus)
+	one.Lsh(&one, F.rBits()).Mod(&one, &bModulus)
 	F.One = toUint64Slice(&one, F.NbWords)
 
 	{
 		var n big.Int
 		n.SetUint64(13)
-		n.Lsh(&n, uint(F.NbWords)*64).Mod(&n, &bModulus)
+		n.Lsh(&n, F.rBits()).Mod(&n, &bModulus)
 		F.Thirteen = toUint64Slice(&n, F.NbWords)
 	}
 
@@ -240,7 +256,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 			var g big.Int
 			g.Exp(&nonResidue, &s, &bModulus)
 			// store g in montgomery form
-			g.Lsh(&g, uint(F.NbWords)*64).Mod(&g, &bModulus)
+			g.Lsh(&g, F.rBits()).Mod(&g, &bModulus)
 			F.SqrtG = toUint64Slice(&g, F.NbWords)
 
 			// store non residue in montgomery form
@@ -253,9 +269,21 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 			if F.UseAddChain {
 				F.SqrtSMinusOneOver2Data = addchain.GetAddChain(&s)
 			}
+
+			// for a large 2-adicity, the discrete logarithm of xˢ in the subgroup of order 2ᵉ is
+			// computed window by window with precomputed tables (Sarkar, ePrint 2020/1407)
+			if F.SqrtE >= sqrtTablesMinTwoAdicity {
+				F.SqrtTables = true
+				F.SqrtTablesWindow = sqrtTablesWindow
+				F.SqrtTablesNbWindows = (F.SqrtE + sqrtTablesWindow - 1) / sqrtTablesWindow
+				F.SqrtTablesFirstWindow = F.SqrtE - sqrtTablesWindow*(F.SqrtTablesNbWindows-1)
+			}
 		}
 	}
 
+	// Cbrt pre computes
+	F.setCbrtParameters()
+
 	// note: to simplify output files generated, we generated ASM code only for
 	// moduli that meet the condition F.NoCarry
 	// asm code generation for moduli with more than 6 words can be optimized further
@@ -264,6 +292,61 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	return F, nil
 }
 
+const (
+	sqrtTablesMinTwoAdicity = 16
+	sqrtTablesWindow        = 8
+)
+
+// setCbrtParameters sets the parameters of the cube root algorithms
+func (f *FieldConfig) setCbrtParameters() {
+	var r, one, three big.Int
+	one.SetUint64(1)
+	three.SetUint64(3)
+	switch r.Mod(f.ModulusBig, &three).Uint64() {
+	case 2:
+		// q ≡ 2 (mod 3)
+		// using  z = x^((2q-1)/3), since 3 * (2q-1)/3 ≡ 1 (mod q-1)
+		f.CbrtQ2Mod3 = true
+		var e big.Int
+		e.Lsh(f.ModulusBig, 1).Sub(&e, &one).Div(&e, &three)
+		f.CbrtExponent = e.Text(16)
+	case 1:
+		// q ≡ 1 (mod 3)
+		// Write q-1 = 3ᵉ * s , s prime to 3
+		f.CbrtTonelliShanks = true
+		var s big.Int
+		s.Sub(f.ModulusBig, &one)
+		for r.Mod(&s, &three).Sign() == 0 {
+			s.Div(&s, &three)
+			f.CbrtE++
+		}
+		f.CbrtSMod3 = r.Mod(&s, &three).Uint64()
+
+		// ⌊s/3⌋
+		var e big.Int
+		e.Div(&s, &three)
+		f.CbrtExponent = e.Text(16)
+
+		// find a cubic non residue
+		var legendreExponent, nonResidue, t big.Int
+		legendreExponent.Sub(f.ModulusBig, &one).Div(&legendreExponent, &three)
+		nonResidue.SetUint64(2)
+		for t.Exp(&nonResidue, &legendreExponent, f.ModulusBig).Cmp(&one) == 0 {
+			nonResidue.Add(&nonResidue, &one)
+		}
+
+		// g = nonresidue ^ s generates the subgroup of order 3ᵉ, ω = g^(3ᵉ⁻¹)
+		var g, omega big.Int
+		g.Exp(&nonResidue, &s, f.ModulusBig)
+		omega.Exp(&three, new(big.Int).SetUint64(f.CbrtE-1), nil)
+		omega.Exp(&g, &omega, f.ModulusBig)
+		g = f.ToMont(g)
+		omega = f.ToMont(omega)
+		f.CbrtG = toUint64Slice(&g, f.NbWords)
+		f.CbrtOmega = toUint64Slice(&omega, f.NbWords)
+	}
+}
+
 func toUint64Slice(b *big.Int, nbWords ...int) (s []uint64) {
 	if len(nbWords) > 0 && nbWords[0] > len(b.Bits()) {
 		s = make([]uint64, nbWords[0])
@@ -322,9 +405,17 @@ func (f *FieldConfig) StringToMont(str string) big.Int {
 	return i
 }
 
+// rBits returns log₂(r), r being the Montgomery constant
+func (f *FieldConfig) rBits() uint {
+	if f.F31 {
+		return 32
+	}
+	return uint(f.NbWords) * 64
+}
+
 func (f *FieldConfig) ToMont(nonMont big.Int) big.Int {
 	var mont big.Int
-	mont.Lsh(&nonMont, uint(f.NbWords)*64)
+	mont.Lsh(&nonMont, f.rBits())
 	mont.Mod(&mont, f.ModulusBig)
 	return mont
 }
@@ -336,7 +427,7 @@ func (f *FieldConfig) FromMont(nonMont *big.Int, mont *big.Int) *FieldConfig {
 		return f
 	}
 	f.halve(nonMont, mont)
-	for i := 1; i < f.NbWords*64; i++ {
+	for i := uint(1); i < f.rBits(); i++ {
 		f.halve(nonMont, nonMont)
 	}
 
@@ -0,0 +1,290 @@
+package config
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// NewTowerOf returns the extension of degree n of parent obtained by adjoining ⁿ√α, α being
+// given by its coordinates over Fp in the (flattened) basis of parent.
+//
+// For instance, with e2 = NewTower(fp, 2, -1), the sextic extension of the BN254 tower is
+// NewTowerOf(&e2, 3, 9, 1) (α = 9 + u), and the dodecic one NewTowerOf(&e6, 2, 0, 0, 1, 0, 0, 0)
+// (α = v).
+func NewTowerOf(parent *Extension, degree uint8, nonResidue ...int64) (Extension, error) {
+	coords := make([]string, len(nonResidue))
+	for i, c := range nonResidue {
+		coords[i] = fmt.Sprint(c)
+	}
+	return NewTowerOfString(parent, degree, coords...)
+}
+
+// NewTowerOfString is NewTowerOf with the coordinates of α given as strings, in base 10 or
+// with a base prefix (0x, 0b, ...).
+func NewTowerOfString(parent *Extension, degree uint8, nonResidue ...string) (Extension, error) {
+	if len(nonResidue) != parent.TotalDegree() {
+		return Extension{}, fmt.Errorf("the non-residue must have %d coordinates, got %d", parent.TotalDegree(), len(nonResidue))
+	}
+	ret := Extension{
+		Degree:     int(degree),
+		Base:       parent.Base,
+		Parent:     parent,
+		NonResidue: make(Element, len(nonResidue)),
+	}
+	for i, s := range nonResidue {
+		if _, ok := ret.NonResidue[i].SetString(s, 0); !ok {
+			return Extension{}, fmt.Errorf("invalid coordinate %q of the non-residue", s)
+		}
+		ret.NonResidue[i].Mod(&ret.NonResidue[i], ret.Base.ModulusBig)
+	}
+	ret.Size.Exp(ret.Base.ModulusBig, big.NewInt(int64(ret.TotalDegree())), nil)
+	return ret, nil
+}
+
+// TotalDegree returns the degree of the extension over Fp
+func (f *Extension) TotalDegree() int {
+	if f.Parent == nil {
+		return f.Degree
+	}
+	return f.Degree * f.Parent.TotalDegree()
+}
+
+// Level returns the height of the extension in its tower, 1 for an extension of Fp
+func (f *Extension) Level() int {
+	if f.Parent == nil {
+		return 1
+	}
+	return f.Parent.Level() + 1
+}
+
+// nonResidue returns α, as coordinates over Fp
+func (f *Extension) nonResidue() Element {
+	if f.Parent == nil {
+		alpha := make(Element, 1)
+		alpha[0].SetInt64(f.RootOf).Mod(&alpha[0], f.Base.ModulusBig)
+		return alpha
+	}
+	return f.NonResidue
+}
+
+// parentOne returns 1 in the field f extends, as coordinates over Fp
+func (f *Extension) parentOne() Element {
+	if f.Parent == nil {
+		one := make(Element, 1)
+		one[0].SetInt64(1)
+		return one
+	}
+	return f.Parent.One()
+}
+
+// One returns 1, as coordinates over Fp
+func (f *Extension) One() Element {
+	z := make(Element, f.TotalDegree())
+	z[0].SetInt64(1)
+	return z
+}
+
+// parentMul multiplies x and y in the field f extends
+func (f *Extension) parentMul(x, y Element) Element {
+	if f.Parent == nil {
+		z := make(Element, 1)
+		f.Base.Mul(&z[0], &x[0], &y[0])
+		return z
+	}
+	return f.Parent.MulFlat(x, y)
+}
+
+// parentExp returns xᵉ in the field f extends
+func (f *Extension) parentExp(x Element, e *big.Int) Element {
+	if f.Parent == nil {
+		z := make(Element, 1)
+		z[0].Exp(&x[0], e, f.Base.ModulusBig)
+		return z
+	}
+	return f.Parent.ExpFlat(x, e)
+}
+
+// MulFlat returns x⋅y, x and y being given by their coordinates over Fp in the (flattened)
+// basis of the tower.
+func (f *Extension) MulFlat(x, y Element) Element {
+	m := f.TotalDegree() / f.Degree
+	coord := func(v Element, i int) Element { return v[i*m : (i+1)*m] }
+
+	// schoolbook product, reduced modulo Xⁿ - α
+	c := make([]Element, 2*f.Degree-1)
+	for i := range c {
+		c[i] = make(Element, m)
+	}
+	for i := 0; i < f.Degree; i++ {
+		for j := 0; j < f.Degree; j++ {
+			t := f.parentMul(coord(x, i), coord(y, j))
+			for k := range t {
+				f.Base.Add(&c[i+j][k], &c[i+j][k], &t[k])
+			}
+		}
+	}
+	alpha := f.nonResidue()
+	for i := 2*f.Degree - 2; i >= f.Degree; i-- {
+		t := f.parentMul(c[i], alpha)
+		for k := range t {
+			f.Base.Add(&c[i-f.Degree][k], &c[i-f.Degree][k], &t[k])
+		}
+	}
+
+	z := make(Element, 0, f.TotalDegree())
+	for i := 0; i < f.Degree; i++ {
+		z = append(z, c[i]...)
+	}
+	return z
+}
+
+// ExpFlat returns xᵉ, for e ⩾ 0, x being given by its coordinates over Fp
+func (f *Extension) ExpFlat(x Element, e *big.Int) Element {
+	z := f.One()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		z = f.MulFlat(z, z)
+		if e.Bit(i) == 1 {
+			z = f.MulFlat(z, x)
+		}
+	}
+	return z
+}
+
+// parentSize returns the order of the field f extends
+func (f *Extension) parentSize() *big.Int {
+	if f.Parent == nil {
+		return f.Base.ModulusBig
+	}
+	return &f.Parent.Size
+}
+
+// CheckIrreducible returns an error if Xⁿ - α is not irreducible over the field f extends,
+// for a prime degree n. That is the case iff n divides q-1 and α is not an n-th power, q
+// being the order of the field.
+func (f *Extension) CheckIrreducible() error {
+	n := big.NewInt(int64(f.Degree))
+	if !n.ProbablyPrime(0) {
+		return fmt.Errorf("unsupported degree %d: only prime degrees are supported", f.Degree)
+	}
+	var e, rem big.Int
+	e.Sub(f.parentSize(), big.NewInt(1))
+	if rem.Mod(&e, n).BitLen() != 0 {
+		return fmt.Errorf("%s is not irreducible: %d doesn't divide the order of the multiplicative group", f.Polynomial(), f.Degree)
+	}
+	e.Div(&e, n)
+	if f.Equal(f.parentExp(f.nonResidue(), &e), f.parentOne()) {
+		power := fmt.Sprintf("%d-th power", f.Degree)
+		switch f.Degree {
+		case 2:
+			power = "square"
+		case 3:
+			power = "cube"
+		}
+		return fmt.Errorf("%s is not irreducible: the non-residue is a %s", f.Polynomial(), power)
+	}
+	return nil
+}
+
+// FrobeniusMap returns the Frobenius map x ↦ xᵖ on the basis (1, X, ..., Xⁿ⁻¹) of the
+// extension over the field it extends: (Xⁱ)ᵖ = γᵢ⋅X^σ(i), with σ(i) = i⋅p mod n and
+// γᵢ = α^⌊i⋅p/n⌋ in the field f extends.
+func (f *Extension) FrobeniusMap() (sigma []int, gamma []Element) {
+	n := big.NewInt(int64(f.Degree))
+	alpha := f.nonResidue()
+	sigma = make([]int, f.Degree)
+	gamma = make([]Element, f.Degree)
+	for i := 0; i < f.Degree; i++ {
+		var ip, q, r big.Int
+		ip.Mul(big.NewInt(int64(i)), f.Base.ModulusBig)
+		q.DivMod(&ip, n, &r)
+		sigma[i] = int(r.Int64())
+		gamma[i] = f.parentExp(alpha, &q)
+	}
+	return
+}
+
+// Var returns the name of the root adjoined at this level of the tower: u, v, w, ...
+func (f *Extension) Var() string {
+	return string(rune('u' + f.Level() - 1))
+}
+
+// Polynomial returns the irreducible binomial defining the extension, e.g. "v³ - (9 + u)"
+func (f *Extension) Polynomial() string {
+	alpha := f.formatParent(f.nonResidue())
+	switch {
+	case !strings.ContainsAny(alpha[1:], "+-") && alpha[0] == '-':
+		return f.Var() + supScript(f.Degree) + " + " + alpha[1:]
+	case strings.ContainsAny(alpha, "+-"):
+		alpha = "(" + alpha + ")"
+	}
+	return f.Var() + supScript(f.Degree) + " - " + alpha
+}
+
+// formatParent formats x, an element of the field f extends, given by its coordinates over Fp
+func (f *Extension) formatParent(x Element) string {
+	if f.Parent == nil {
+		return f.formatInt(&x[0])
+	}
+	return f.Parent.Format(x)
+}
+
+// Format formats x, given by its coordinates over Fp, as a polynomial in the root adjoined at
+// this level of the tower. The coordinates in Fp are printed in (-p/2, p/2].
+func (f *Extension) Format(x Element) string {
+	m := f.TotalDegree() / f.Degree
+	var terms []string
+	for i := 0; i < f.Degree; i++ {
+		c := x[i*m : (i+1)*m]
+		isZero := true
+		for k := range c {
+			isZero = isZero && c[k].Sign() == 0
+		}
+		if isZero {
+			continue
+		}
+		s := f.formatParent(c)
+		if i == 0 {
+			terms = append(terms, s)
+			continue
+		}
+		monomial := f.Var()
+		if i > 1 {
+			monomial += supScript(i)
+		}
+		switch {
+		case s == "1":
+			s = monomial
+		case strings.ContainsAny(s[1:], "+-"):
+			s = "(" + s + ")⋅" + monomial
+		default:
+			s += "⋅" + monomial
+		}
+		terms = append(terms, s)
+	}
+	if len(terms) == 0 {
+		return "0"
+	}
+	return strings.Join(terms, " + ")
+}
+
+// formatInt formats x mod p in (-p/2, p/2]
+func (f *Extension) formatInt(x *big.Int) string {
+	var r, halfP big.Int
+	r.Mod(x, f.Base.ModulusBig)
+	halfP.Rsh(f.Base.ModulusBig, 1)
+	if r.Cmp(&halfP) > 0 {
+		r.Sub(&r, f.Base.ModulusBig)
+	}
+	return r.String()
+}
+
+func supScript(i int) string {
+	const digits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+	runes := []rune(digits)
+	var sb strings.Builder
+	for _, d := range fmt.Sprint(i) {
+		sb.WriteRune(runes[d-'0'])
+	}
+	return sb.String()
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestTowerBN254(t *testing.T) {
+	t.Parallel()
+	fp, err := NewFieldConfig("fp", "Element", "21888242871839275222246405745257275088696311157297823662689037894645226208583", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2 := NewTower(fp, 2, -1)
+	e6, err := NewTowerOf(&e2, 3, 9, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e12, err := NewTowerOf(&e6, 2, 0, 0, 1, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range []*Extension{&e2, &e6, &e12} {
+		if err := e.CheckIrreducible(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e12.TotalDegree() != 12 || e12.Level() != 3 {
+		t.Fatal("wrong degree or level")
+	}
+
+	expected := []string{"u² + 1", "v³ - (9 + u)", "w² - v"}
+	for i, e := range []*Extension{&e2, &e6, &e12} {
+		if p := e.Polynomial(); p != expected[i] {
+			t.Fatalf("expected %s, got %s", expected[i], p)
+		}
+	}
+
+	// v³ - 1 is reducible
+	reducible, err := NewTowerOf(&e2, 3, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reducible.CheckIrreducible() == nil {
+		t.Fatal("v³ - 1 should be reducible")
+	}
+
+	if _, err := NewTowerOf(&e2, 3, 9); err == nil {
+		t.Fatal("a non-residue with a wrong number of coordinates should be rejected")
+	}
+
+	checkFrobeniusMap(t, &e6)
+	checkFrobeniusMap(t, &e12)
+}
+
+// TestTowerFrobeniusPermutation checks the Frobenius map of a cubic extension of Fp², for
+// p = 2 mod 3: (vⁱ)ᵖ is then a multiple of v²ⁱ.
+func TestTowerFrobeniusPermutation(t *testing.T) {
+	t.Parallel()
+	fp, err := NewFieldConfig("fp", "Element", "47", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2 := NewTower(fp, 2, -1)
+
+	// find a non-cube α = a + u
+	var e6 Extension
+	for a := int64(1); ; a++ {
+		if e6, err = NewTowerOf(&e2, 3, a, 1); err != nil {
+			t.Fatal(err)
+		}
+		if e6.CheckIrreducible() == nil {
+			break
+		}
+	}
+	sigma, _ := e6.FrobeniusMap()
+	if sigma[1] != 2 || sigma[2] != 1 {
+		t.Fatalf("unexpected permutation %v", sigma)
+	}
+	checkFrobeniusMap(t, &e6)
+}
+
+// checkFrobeniusMap checks that (Xⁱ)ᵖ = γᵢ⋅X^σ(i)
+func checkFrobeniusMap(t *testing.T, e *Extension) {
+	t.Helper()
+	sigma, gamma := e.FrobeniusMap()
+	m := e.TotalDegree() / e.Degree
+	for i := 0; i < e.Degree; i++ {
+		x := make(Element, e.TotalDegree())
+		x[i*m].SetInt64(1)
+		xp := e.ExpFlat(x, e.Base.ModulusBig)
+
+		expected := make(Element, e.TotalDegree())
+		copy(expected[sigma[i]*m:], gamma[i])
+		if !e.Equal(xp, expected) {
+			t.Fatalf("wrong Frobenius map at index %d of %s", i, e.Polynomial())
+		}
+	}
+}
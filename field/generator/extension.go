@@ -0,0 +1,280 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/extension"
+)
+
+// extensionTemplateData is the data passed to the extension templates
+type extensionTemplateData struct {
+	FieldPackagePath      string
+	FieldPackageName      string
+	ElementType           string   // the base field element
+	BaseType              string   // the type of the coordinates: ElementType, or the extension this one extends
+	BaseName              string   // the name of the extended field in the documentation
+	OverField             bool     // true if the extension is an extension of the base field
+	Name                  string   // E{{total degree}}
+	Degree                int      // degree over BaseType
+	Var                   string   // the root adjoined to BaseType
+	RootOf                string   // the non-residue, as a polynomial in the roots of the tower
+	Polynomial            string   // the irreducible binomial
+	Coords                []string // the coordinates over BaseType
+	ElementPath           string   // the path to the first coordinate over the base field
+	Leaves                []string // the paths to all the coordinates over the base field
+	NonResidue            string
+	FrobeniusCoefficients []string
+	FrobeniusTerms        []frobeniusTerm // Frobenius map of a level of a tower
+	NormExponent          string          // 1 + q + ... + qⁿ⁻¹, q being the order of BaseType
+}
+
+// frobeniusTerm is a term xᵢᵖ⋅γᵢ⋅X^σ(i) of the Frobenius map
+type frobeniusTerm struct {
+	Index    int
+	Src, Dst string
+	IsOne    bool
+}
+
+// GenerateExtensions will generate go files in outputDir (package extensions)
+// for the given extensions of the field F, whose package is at fieldPackagePath.
+//
+// Only extensions of degree 2 and 3 by an irreducible binomial are supported; the
+// extension of total degree n over F is named En. An extension can extend another
+// (see config.NewTowerOf), which must then appear before it in extensions: this way,
+// towers such as Fp → Fp2 → Fp6 → Fp12 are generated, the coordinates of the successive
+// levels being named A, B, C, ...
+//
+// Example usage
+//
+//	goldilocks, _ = config.NewFieldConfig("goldilocks", "Element", modulus, true)
+//	generator.GenerateExtensions(goldilocks, "github.com/consensys/gnark-crypto/field/goldilocks", "../extensions",
+//		config.NewTower(goldilocks, 2, 7), config.NewTower(goldilocks, 3, 7))
+func GenerateExtensions(F *config.FieldConfig, fieldPackagePath, outputDir string, extensions ...config.Extension) error {
+	return generateExtensions(F, fieldPackagePath, outputDir, "extensions", extensions)
+}
+
+// GenerateTower will generate go files in outputDir (package packageName) for a tower of
+// extensions of the field F, whose package is at fieldPackagePath: the first extension
+// extends F, and each following one extends the previous one, as in ecc/bn254/internal/fptower.
+//
+// Example usage
+//
+//	fp, _ := config.NewFieldConfig("fp", "Element", modulus, false)
+//	e2 := config.NewTower(fp, 2, -1)
+//	e6, _ := config.NewTowerOf(&e2, 3, 9, 1)
+//	e12, _ := config.NewTowerOf(&e6, 2, 0, 0, 1, 0, 0, 0)
+//	generator.GenerateTower(fp, "github.com/consensys/gnark-crypto/ecc/bn254/fp", "./fptower", "fptower", e2, e6, e12)
+func GenerateTower(F *config.FieldConfig, fieldPackagePath, outputDir, packageName string, tower ...config.Extension) error {
+	for i := range tower {
+		if i == 0 && tower[i].Parent != nil {
+			return errors.New("the first extension of a tower must extend the base field")
+		}
+		if i > 0 && (tower[i].Parent == nil || tower[i].Parent.TotalDegree() != tower[i-1].TotalDegree()) {
+			return errors.New("each extension of a tower must extend the previous one")
+		}
+	}
+	return generateExtensions(F, fieldPackagePath, outputDir, packageName, tower)
+}
+
+func generateExtensions(F *config.FieldConfig, fieldPackagePath, outputDir, packageName string, extensions []config.Extension) error {
+	if len(extensions) == 0 {
+		return errors.New("no extension to generate")
+	}
+
+	elementType := F.PackageName + "." + F.ElementName
+	name := func(e *config.Extension) string {
+		return fmt.Sprintf("E%d", e.TotalDegree())
+	}
+	coordName := func(e *config.Extension, i int) string {
+		return fmt.Sprintf("%c%d", 'A'+e.Level()-1, i)
+	}
+
+	// toLiteral returns the literal of x, given by its coordinates over F, in the field e extends
+	var toLiteral func(e *config.Extension, x config.Element) string
+	toLiteral = func(e *config.Extension, x config.Element) string {
+		var builder strings.Builder
+		if e.Parent == nil {
+			builder.WriteString(elementType)
+			builder.WriteString("{")
+			var v big.Int
+			v.Mod(&x[0], F.ModulusBig)
+			mont := F.ToMont(v)
+			bavard.WriteBigIntAsUint64Slice(&builder, &mont)
+			builder.WriteString("}")
+			return builder.String()
+		}
+		p := e.Parent
+		m := p.TotalDegree() / p.Degree
+		builder.WriteString(name(p))
+		builder.WriteString("{")
+		for i := 0; i < p.Degree; i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(coordName(p, i))
+			builder.WriteString(": ")
+			builder.WriteString(toLiteral(p, x[i*m:(i+1)*m]))
+		}
+		builder.WriteString("}")
+		return builder.String()
+	}
+
+	// leaves returns the paths to the coordinates over F of an element of e
+	var leaves func(e *config.Extension) []string
+	leaves = func(e *config.Extension) []string {
+		var res []string
+		for i := 0; i < e.Degree; i++ {
+			c := coordName(e, i)
+			if e.Parent == nil {
+				res = append(res, c)
+				continue
+			}
+			for _, l := range leaves(e.Parent) {
+				res = append(res, c+"."+l)
+			}
+		}
+		return res
+	}
+
+	data := make([]extensionTemplateData, len(extensions))
+	for i := range extensions {
+		e := &extensions[i]
+		if e.Degree != 2 && e.Degree != 3 {
+			return fmt.Errorf("unsupported extension degree %d", e.Degree)
+		}
+		if err := e.CheckIrreducible(); err != nil {
+			return err
+		}
+
+		d := extensionTemplateData{
+			FieldPackagePath: fieldPackagePath,
+			FieldPackageName: F.PackageName,
+			ElementType:      elementType,
+			BaseType:         elementType,
+			BaseName:         F.PackageName,
+			OverField:        e.Parent == nil,
+			Name:             name(e),
+			Degree:           e.Degree,
+			Var:              e.Var(),
+			Polynomial:       e.Polynomial(),
+			Leaves:           leaves(e),
+		}
+		d.ElementPath = d.Leaves[0]
+
+		alpha := e.NonResidue
+		if e.Parent == nil {
+			alpha = config.Element{*big.NewInt(e.RootOf)}
+			d.RootOf = fmt.Sprint(e.RootOf)
+		} else {
+			found := false
+			for j := 0; j < i; j++ {
+				found = found || data[j].Name == name(e.Parent)
+			}
+			if !found {
+				return fmt.Errorf("the extension %s must be generated before %s", name(e.Parent), d.Name)
+			}
+			d.BaseType = name(e.Parent)
+			d.BaseName = d.BaseType
+			d.RootOf = e.Parent.Format(alpha)
+			if strings.ContainsAny(d.RootOf, "+-") {
+				d.RootOf = "(" + d.RootOf + ")"
+			}
+
+			var n, q, pow big.Int
+			q.Set(&e.Parent.Size)
+			pow.SetInt64(1)
+			for j := 0; j < e.Degree; j++ {
+				n.Add(&n, &pow)
+				pow.Mul(&pow, &q)
+			}
+			d.NormExponent = n.String()
+		}
+		d.NonResidue = toLiteral(e, alpha)
+
+		for j := 0; j < e.Degree; j++ {
+			d.Coords = append(d.Coords, coordName(e, j))
+		}
+		sigma, gamma := e.FrobeniusMap()
+		for j := range gamma {
+			d.FrobeniusCoefficients = append(d.FrobeniusCoefficients, toLiteral(e, gamma[j]))
+			isOne := gamma[j][0].Cmp(big.NewInt(1)) == 0
+			for k := 1; k < len(gamma[j]); k++ {
+				isOne = isOne && gamma[j][k].Sign() == 0
+			}
+			d.FrobeniusTerms = append(d.FrobeniusTerms, frobeniusTerm{
+				Index: j,
+				Src:   coordName(e, j),
+				Dst:   coordName(e, sigma[j]),
+				IsOne: isOne,
+			})
+		}
+		for j := 0; j < i; j++ {
+			if data[j].Name == d.Name {
+				return fmt.Errorf("duplicate extension of degree %d", e.TotalDegree())
+			}
+		}
+		data[i] = d
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(packageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	for _, d := range data {
+		name := strings.ToLower(d.Name)
+
+		// generate source file
+		pathSrc := filepath.Join(outputDir, name+".go")
+		if err := bavard.GenerateFromString(pathSrc, []string{extension.Base, extension.Vector}, d, bavardOpts...); err != nil {
+			return err
+		}
+
+		// generate test file
+		pathTest := filepath.Join(outputDir, name+"_test.go")
+		if err := bavard.GenerateFromString(pathTest, []string{extension.Tests}, d, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	{
+		// generate helpers shared by the tests
+		pathTest := filepath.Join(outputDir, "extensions_test.go")
+		if err := bavard.GenerateFromString(pathTest, []string{extension.TestsCommon}, data[0], bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	{
+		// generate doc.go
+		doc := struct {
+			PackageName      string
+			FieldPackageName string
+			ElementName      string
+			Extensions       []extensionTemplateData
+			Tower            bool
+		}{packageName, F.PackageName, F.ElementName, data, false}
+		for _, d := range data {
+			doc.Tower = doc.Tower || !d.OverField
+		}
+		pathSrc := filepath.Join(outputDir, "doc.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{extension.Doc}, doc, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
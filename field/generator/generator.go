@@ -14,6 +14,7 @@ import (
 	"github.com/consensys/gnark-crypto/field/generator/config"
 	"github.com/consensys/gnark-crypto/field/generator/internal/addchain"
 	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/f31"
 )
 
 // GenerateFF will generate go (and .s) files in outputDir for modulus (in base 10)
@@ -23,6 +24,10 @@ import (
 //	fp, _ = config.NewField("fp", "Element", fpModulus")
 //	generator.GenerateFF(fp, filepath.Join(baseDir, "fp"))
 func GenerateFF(F *config.FieldConfig, outputDir string) error {
+	if F.F31 {
+		return generateF31(F, outputDir)
+	}
+
 	// source file templates
 	sourceFiles := []string{
 		element.Base,
@@ -44,16 +49,21 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.Reduce,
 		element.Test,
 		element.InverseTests,
+		element.TestCT,
 	}
 	// output files
 	eName := strings.ToLower(F.ElementName)
 
 	pathSrc := filepath.Join(outputDir, eName+".go")
 	pathSrcVector := filepath.Join(outputDir, "vector.go")
+	pathSrcVectorAmd64 := filepath.Join(outputDir, "vector_amd64.go")
+	pathSrcVectorPureGo := filepath.Join(outputDir, "vector_purego.go")
 	pathSrcFixedExp := filepath.Join(outputDir, eName+"_exp.go")
+	pathSrcCT := filepath.Join(outputDir, eName+"_ct.go")
 	pathSrcArith := filepath.Join(outputDir, "arith.go")
 	pathTest := filepath.Join(outputDir, eName+"_test.go")
 	pathTestVector := filepath.Join(outputDir, "vector_test.go")
+	pathTestVectorAmd64 := filepath.Join(outputDir, "vector_amd64_test.go")
 
 	// remove old format generated files
 	oldFiles := []string{"_mul.go", "_mul_amd64.go",
@@ -103,11 +113,38 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	// generate vector operations
+	if !F.ASM {
+		_ = os.Remove(pathSrcVectorAmd64)
+	} else {
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrcVectorAmd64, []string{element.VectorOpsAmd64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+	{
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		if F.ASM {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64 purego"))
+		}
+		if err := bavard.GenerateFromString(pathSrcVectorPureGo, []string{element.VectorOpsPureGo}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	// generate arithmetics source file
 	if err := bavard.GenerateFromString(pathSrcArith, []string{element.Arith}, F, bavardOpts...); err != nil {
 		return err
 	}
 
+	// generate constant-time source file
+	if err := bavard.GenerateFromString(pathSrcCT, []string{element.CT}, F, bavardOpts...); err != nil {
+		return err
+	}
+
 	// generate fixed exp source file
 	if F.UseAddChain {
 		if err := bavard.GenerateFromString(pathSrcFixedExp, []string{element.FixedExp}, F, bavardOpts...); err != nil {
@@ -124,6 +161,17 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	if !F.ASM {
+		_ = os.Remove(pathTestVectorAmd64)
+	} else {
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathTestVectorAmd64, []string{element.TestVectorOpsAmd64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	// if we generate assembly code
 	if F.ASM {
 		// generate ops.s
@@ -267,6 +315,54 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	return nil
 }
 
+// generateF31 generates the field element of a modulus on at most 31 bits;
+// elements are stored on a uint32, and there is no assembly.
+func generateF31(F *config.FieldConfig, outputDir string) error {
+	eName := strings.ToLower(F.ElementName)
+
+	funcs := template.FuncMap{}
+	funcs["shorten"] = shorten
+	funcs["ltu64"] = func(a, b uint64) bool {
+		return a < b
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(F.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+		bavard.Funcs(funcs),
+	}
+
+	// remove the files of the generic (uint64) implementation
+	for _, of := range []string{eName + "_ops_purego.go", eName + "_exp.go", "arith.go"} {
+		_ = os.Remove(filepath.Join(outputDir, of))
+	}
+
+	toGenerate := []struct {
+		path string
+		src  string
+	}{
+		{filepath.Join(outputDir, eName+".go"), f31.Base},
+		{filepath.Join(outputDir, "vector.go"), f31.Vector},
+		{filepath.Join(outputDir, "doc.go"), f31.Doc},
+		{filepath.Join(outputDir, eName+"_ct.go"), f31.CT},
+		{filepath.Join(outputDir, eName+"_test.go"), f31.Test},
+		{filepath.Join(outputDir, eName+"_ct_test.go"), f31.TestCT},
+		{filepath.Join(outputDir, "vector_test.go"), f31.TestVector},
+	}
+	for _, g := range toGenerate {
+		if err := bavard.GenerateFromString(g.path, []string{g.src}, F, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
 func shorten(input string) string {
 	const maxLen = 15
 	if len(input) > maxLen {
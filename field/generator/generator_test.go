@@ -100,3 +100,44 @@ func TestIntegration(t *testing.T) {
 	}
 
 }
+
+// TestTowerIntegration generates the tower Fp → Fp2 → Fp6 → Fp12 of BN254, over the fp
+// package of ecc/bn254, and runs its tests
+func TestTowerIntegration(t *testing.T) {
+	const towerDir = "integration_test_tower"
+	os.RemoveAll(towerDir)
+	defer os.RemoveAll(towerDir)
+
+	fp, err := field.NewFieldConfig("fp", "Element", "21888242871839275222246405745257275088696311157297823662689037894645226208583", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2 := field.NewTower(fp, 2, -1)
+	e6, err := field.NewTowerOf(&e2, 3, 9, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e12, err := field.NewTowerOf(&e6, 2, 0, 0, 1, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = GenerateTower(fp, "github.com/consensys/gnark-crypto/ecc/bn254/fp", towerDir, "fptower", e2, e6, e12); err != nil {
+		t.Fatal(err)
+	}
+
+	// the levels must extend the previous one
+	if err = GenerateTower(fp, "github.com/consensys/gnark-crypto/ecc/bn254/fp", towerDir, "fptower", e2, e12); err == nil {
+		t.Fatal("generating a tower with a missing level should fail")
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "test", "-short", filepath.Join(wd, towerDir))
+	out, err := cmd.CombinedOutput()
+	fmt.Println(string(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,8 @@ import 	"golang.org/x/sys/cpu"
 
 var (
 	supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
+	supportAvx512 = cpu.X86.HasAVX512F
+	supportAvx2 = cpu.X86.HasAVX2
 	_ = supportAdx
 )
 `
@@ -18,6 +20,8 @@ const AsmNoAdx = `
 // this ensures we test all asm path.
 var (
 	supportAdx = false
+	supportAvx512 = false
+	supportAvx2 = false
 	_ = supportAdx
 )
 `
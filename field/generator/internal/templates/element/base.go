@@ -12,9 +12,13 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	{{- if .SqrtTables}}
+	"sync"
+	{{- end}}
 
 	"github.com/consensys/gnark-crypto/field/hash"
 	"github.com/consensys/gnark-crypto/field/pool"
+	"github.com/consensys/gnark-crypto/utils"
 	"github.com/bits-and-blooms/bitset"
 )
 
@@ -30,6 +34,8 @@ import (
 // Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// The methods with a CT suffix (InverseCT, ExpCT, ...) run in constant time with respect to their
+// inputs, and should be used instead of their variable-time counterparts on secret values.
 type {{.ElementName}} [{{.NbWords}}]uint64
 
 const (
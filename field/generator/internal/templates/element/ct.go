@@ -0,0 +1,278 @@
+package element
+
+// CT is the constant-time API of the field element: the operations don't branch on, nor
+// access memory at addresses depending on, their secret inputs.
+const CT = `
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// qMinusTwo is the exponent q-2 used by InverseCT, in little-endian 64-bit words
+var qMinusTwo = func() (e [Limbs]uint64) {
+	var b uint64
+	e[0], b = bits.Sub64(q{{.ElementName}}[0], 2, 0)
+	for i := 1; i < Limbs; i++ {
+		e[i], b = bits.Sub64(q{{.ElementName}}[i], 0, b)
+	}
+	return
+}()
+
+// InverseCT z = x⁻¹ (mod q) and returns z, or z = 0 if x == 0
+//
+// It computes x^(q-2) in constant time with respect to x; it is much slower than Inverse
+// and should be used when x is secret.
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.expCT(x, &qMinusTwo)
+}
+
+// ExpCT z = xᵏ (mod q) and returns z
+//
+// It runs in constant time with respect to x and |k|: all the Bytes bytes of the exponent are
+// processed, whatever its bit length. If k is negative, x is inverted with InverseCT; the
+// sign of k is not secret. It panics if |k| ⩾ 2^(8⋅Bytes).
+func (z *{{.ElementName}}) ExpCT(x {{.ElementName}}, k *big.Int) *{{.ElementName}} {
+	var abs big.Int
+	abs.Abs(k)
+	var buf [Bytes]byte
+	abs.FillBytes(buf[:])
+	if k.Sign() == -1 {
+		x.InverseCT(&x)
+	}
+
+	var e [Limbs]uint64
+	for i := 0; i < Limbs; i++ {
+		e[i] = binary.BigEndian.Uint64(buf[Bytes-8*(i+1):])
+	}
+	return z.expCT(&x, &e)
+}
+
+// expCT z = xᵉ (mod q), where e is in little-endian 64-bit words, with a fixed 4-bit window:
+// all the bits of e are processed, and the window is read from the table with lookupCT.
+func (z *{{.ElementName}}) expCT(x *{{.ElementName}}, e *[Limbs]uint64) *{{.ElementName}} {
+	var table [16]{{.ElementName}}
+	table[0].SetOne()
+	table[1] = *x
+	for i := 2; i < len(table); i++ {
+		table[i].MulCT(&table[i-1], x)
+	}
+
+	var res, t {{.ElementName}}
+	res.SetOne()
+	for i := Limbs - 1; i >= 0; i-- {
+		for j := 60; j >= 0; j -= 4 {
+			res.MulCT(&res, &res)
+			res.MulCT(&res, &res)
+			res.MulCT(&res, &res)
+			res.MulCT(&res, &res)
+			t.lookupCT(&table, (e[i]>>j)&0xf)
+			res.MulCT(&res, &t)
+		}
+	}
+	*z = res
+	return z
+}
+
+// lookupCT sets z = table[i], reading all the entries of the table
+func (z *{{.ElementName}}) lookupCT(table *[16]{{.ElementName}}, i uint64) {
+	z.SetZero()
+	for j := range table {
+		z.Select(subtle.ConstantTimeEq(int32(j), int32(i)), z, &table[j])
+	}
+}
+
+// MulCT z = x * y (mod q) and returns z
+//
+// It uses the textbook CIOS algorithm; unlike Mul, the final conditional subtraction of q is
+// done with a mask, without a branch.
+func (z *{{.ElementName}}) MulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var t [Limbs + 2]uint64
+	for i := 0; i < Limbs; i++ {
+		// t = t + x * y[i]
+		var C uint64
+		for j := 0; j < Limbs; j++ {
+			C, t[j] = madd2(x[j], y[i], t[j], C)
+		}
+		t[Limbs], C = bits.Add64(t[Limbs], C, 0)
+		t[Limbs+1] = C
+
+		// t = (t + m * q) / 2⁶⁴, with m such that the division is exact
+		m := t[0] * qInvNeg
+		C = madd0(m, q{{.ElementName}}[0], t[0])
+		for j := 1; j < Limbs; j++ {
+			C, t[j-1] = madd2(m, q{{.ElementName}}[j], t[j], C)
+		}
+		t[Limbs-1], C = bits.Add64(t[Limbs], C, 0)
+		t[Limbs] = t[Limbs+1] + C
+	}
+
+	// t < 2q; s = t - q, and the subtraction borrows iff t < q
+	var s {{.ElementName}}
+	var b uint64
+	for j := 0; j < Limbs; j++ {
+		s[j], b = bits.Sub64(t[j], q{{.ElementName}}[j], b)
+	}
+	_, b = bits.Sub64(t[Limbs], 0, b)
+
+	mask := -b // all ones if t < q
+	for j := 0; j < Limbs; j++ {
+		z[j] = (t[j] & mask) | (s[j] &^ mask)
+	}
+	return z
+}
+
+// AddCT z = x + y (mod q) and returns z
+//
+// Unlike Add, the conditional subtraction of q is done with a mask, without a branch.
+func (z *{{.ElementName}}) AddCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var t, s {{.ElementName}}
+	var c, b uint64
+	for j := 0; j < Limbs; j++ {
+		t[j], c = bits.Add64(x[j], y[j], c)
+	}
+	for j := 0; j < Limbs; j++ {
+		s[j], b = bits.Sub64(t[j], q{{.ElementName}}[j], b)
+	}
+	_, b = bits.Sub64(c, 0, b)
+
+	mask := -b // all ones if x + y < q
+	for j := 0; j < Limbs; j++ {
+		z[j] = (t[j] & mask) | (s[j] &^ mask)
+	}
+	return z
+}
+
+// SubCT z = x - y (mod q) and returns z
+//
+// Unlike Sub, q is added back with a mask, without a branch.
+func (z *{{.ElementName}}) SubCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b, c uint64
+	for j := 0; j < Limbs; j++ {
+		z[j], b = bits.Sub64(x[j], y[j], b)
+	}
+	mask := -b // all ones if x < y
+	for j := 0; j < Limbs; j++ {
+		z[j], c = bits.Add64(z[j], q{{.ElementName}}[j]&mask, c)
+	}
+	return z
+}
+`
+
+// TestCT tests the constant-time API against the variable-time one
+const TestCT = `
+
+// ctOps{{.ElementName}} are the constant-time operations and their variable-time counterparts
+var ctOps{{.ElementName}} = []struct {
+	name    string
+	ct, ref func(z, x, y *{{.ElementName}}) *{{.ElementName}}
+}{
+	{"AddCT", (*{{.ElementName}}).AddCT, (*{{.ElementName}}).Add},
+	{"SubCT", (*{{.ElementName}}).SubCT, (*{{.ElementName}}).Sub},
+	{"MulCT", (*{{.ElementName}}).MulCT, (*{{.ElementName}}).Mul},
+}
+
+func Test{{toTitle .ElementName}}InverseCT(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var z, expected {{.ElementName}}
+	z.InverseCT(&{{.ElementName}}{})
+	assert.True(z.IsZero(), "0⁻¹ should be 0")
+
+	values := append([]{{.ElementName}}{}, staticTestValues...)
+	for i := 0; i < 10; i++ {
+		var r {{.ElementName}}
+		r.SetRandom()
+		values = append(values, r)
+	}
+	for _, x := range values {
+		z.InverseCT(&x)
+		expected.Inverse(&x)
+		assert.True(z.Equal(&expected), "InverseCT and Inverse should match")
+	}
+}
+
+func Test{{toTitle .ElementName}}ExpCT(t *testing.T) {
+	t.Parallel()
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("ExpCT(x, k) == Exp(x, k)", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.ExpCT(a.element, &b.bigint)
+			d.Exp(a.element, &b.bigint)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("ExpCT(x, -k) == Exp(x, -k)", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var nb big.Int
+			nb.Neg(&b.bigint)
+			var c, d {{.ElementName}}
+			c.ExpCT(a.element, &nb)
+			d.Exp(a.element, &nb)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("AddCT, SubCT and MulCT match Add, Sub and Mul", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			for _, op := range ctOps{{.ElementName}} {
+				op.ct(&c, &a.element, &b.element)
+				op.ref(&d, &a.element, &b.element)
+				if !c.Equal(&d) {
+					return false
+				}
+			}
+			return true
+		},
+		genA, genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	assert := require.New(t)
+	for _, x := range staticTestValues {
+		for _, y := range staticTestValues {
+			for _, op := range ctOps{{.ElementName}} {
+				var c, d {{.ElementName}}
+				op.ct(&c, &x, &y)
+				op.ref(&d, &x, &y)
+				assert.True(c.Equal(&d), op.name+" should match its variable-time counterpart")
+			}
+		}
+	}
+	var c, d {{.ElementName}}
+	c.ExpCT(One(), big.NewInt(0))
+	assert.True(c.IsOne(), "x⁰ should be 1")
+
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 8*Bytes)
+	assert.Panics(func() { c.ExpCT(d, tooLarge) }, "ExpCT should panic on a too large exponent")
+}
+
+func Benchmark{{toTitle .ElementName}}InverseCT(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.InverseCT(&x)
+	}
+}
+`
@@ -86,6 +86,86 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 			return z.Set(&beta)
 		}
 		return nil
+	{{- else if .SqrtTables}}
+		// q ≡ 1 (mod 4), q-1 = 2ᵉ * s with s odd and a large e
+		// using the table-based Tonelli-Shanks algorithm of https://eprint.iacr.org/2020/1407:
+		// y = x^((s+1)/2) satisfies y² = x * b with b = xˢ = gᵏ a 2ᵉ-th root of unity,
+		// and the discrete logarithm k is computed {{.SqrtTablesWindow}} bits at a time.
+		if x.IsZero() {
+			return z.SetZero()
+		}
+		tables := getSqrtTables{{.ElementName}}()
+
+		var y, b, w {{.ElementName}}
+		// w = x^((s-1)/2))
+		{{- if .UseAddChain}}
+		w.expBySqrtExp(*x)
+		{{- else}}
+		w.Exp(*x, _bSqrtExponent{{.ElementName}})
+		{{- end}}
+
+		// y = x^((s+1)/2)) = w * x
+		y.Mul(x, &w)
+
+		// b = xˢ = w * w * x = y * x
+		b.Mul(&w, &y)
+
+		// bs[l] = b^(2^(sqrtWindow*l))
+		var bs [sqrtNbWindows]{{.ElementName}}
+		bs[0] = b
+		for l := 1; l < sqrtNbWindows; l++ {
+			bs[l] = bs[l-1]
+			for i := 0; i < sqrtWindow; i++ {
+				bs[l].Square(&bs[l])
+			}
+		}
+
+		// k is split in windows of sqrtFirstWindow, sqrtWindow, ..., sqrtWindow bits, starting
+		// from the least significant bits. If k' is the value of the windows k₀, ..., kᵢ₋₁
+		// already known, (b * g^(-k'))^(2^(sqrtWindow*(sqrtNbWindows-1-i))) lies in the
+		// subgroup of order 2^sqrtWindow, where its discrete logarithm gives kᵢ.
+		var k [sqrtNbWindows]uint64
+		for i := 0; i < sqrtNbWindows; i++ {
+			l := sqrtNbWindows - 1 - i
+			t := bs[l]
+			for j := 0; j < i; j++ {
+				t.Mul(&t, &tables.table(j, l)[k[j]])
+			}
+			k[i] = tables.dlog[t]
+			if i == 0 {
+				// the first window is in the subgroup of order 2^sqrtFirstWindow
+				k[0] >>= sqrtWindow - sqrtFirstWindow
+			}
+		}
+
+		if k[0]&1 == 1 {
+			// k is odd, x is not a square
+			return nil
+		}
+
+		// y = y * g^(-k/2)
+		isOne := true
+		for i := 0; i < sqrtNbWindows; i++ {
+			d := k[i] >> 1
+			if i+1 < sqrtNbWindows {
+				if i == 0 {
+					d |= (k[1] & 1) << (sqrtFirstWindow - 1)
+				} else {
+					d |= (k[i+1] & 1) << (sqrtWindow - 1)
+				}
+			}
+			if d != 0 {
+				y.Mul(&y, &tables.table(i, 0)[d])
+				isOne = false
+			}
+		}
+
+		// return the same root as the Tonelli-Shanks algorithm, x^((s+1)/2) * gᵗ with 0 ≤ t < 2ᵉ⁻¹:
+		// since g^(2ᵉ⁻¹) = -1, g^(-k/2) = -g^(2ᵉ⁻¹-k/2) when k ≠ 0
+		if !isOne {
+			y.Neg(&y)
+		}
+		return z.Set(&y)
 	{{- else if .SqrtTonelliShanks}}
 		// q ≡ 1 (mod 4)
 		// see modSqrtTonelliShanks in math/big/int.go
@@ -157,6 +237,213 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 	{{- end}}
 }
 
+{{- if .SqrtTables}}
+
+const (
+	sqrtWindow = {{.SqrtTablesWindow}}
+	sqrtFirstWindow = {{.SqrtTablesFirstWindow}}
+	sqrtNbWindows = {{.SqrtTablesNbWindows}}
+)
+
+// sqrtTables holds the precomputed tables of Sqrt, with g = nonResidueˢ of order 2ᵉ
+type sqrtTables struct {
+	// a[l][j] = g^(-j * 2^(sqrtWindow*l))
+	// b[l][j] = g^(-j * 2^(sqrtFirstWindow+sqrtWindow*l))
+	a, b [sqrtNbWindows - 1][1 << sqrtWindow]{{.ElementName}}
+
+	// dlog[hʲ] = j, with h = g^(2^(e-sqrtWindow)) of order 2^sqrtWindow
+	dlog map[{{.ElementName}}]uint64
+}
+
+// table returns the table of the powers of g^(-2^(o+sqrtWindow*l)), where o is the offset of
+// the i-th window of the discrete logarithm
+func (t *sqrtTables) table(i, l int) *[1 << sqrtWindow]{{.ElementName}} {
+	if i == 0 {
+		return &t.a[l]
+	}
+	return &t.b[i-1+l]
+}
+
+var (
+	_sqrtTables{{.ElementName}} *sqrtTables
+	_sqrtTablesOnce{{.ElementName}} sync.Once
+)
+
+// getSqrtTables{{.ElementName}} returns the tables used by Sqrt, computing them on first use
+func getSqrtTables{{.ElementName}}() *sqrtTables {
+	_sqrtTablesOnce{{.ElementName}}.Do(func() {
+		t := new(sqrtTables)
+
+		// g = nonResidue ^ s
+		var g = {{.ElementName}}{
+			{{- range $i := .SqrtG}}
+			{{$i}},{{end}}
+		}
+
+		// fill sets table[j] = baseʲ and returns base^(2^sqrtWindow)
+		fill := func(table *[1 << sqrtWindow]{{.ElementName}}, base {{.ElementName}}) {{.ElementName}} {
+			table[0].SetOne()
+			for j := 1; j < len(table); j++ {
+				table[j].Mul(&table[j-1], &base)
+			}
+			for j := 0; j < sqrtWindow; j++ {
+				base.Square(&base)
+			}
+			return base
+		}
+
+		var base {{.ElementName}}
+		base.Inverse(&g)
+		for l := range t.a {
+			base = fill(&t.a[l], base)
+		}
+		base.Inverse(&g)
+		for j := 0; j < sqrtFirstWindow; j++ {
+			base.Square(&base)
+		}
+		for l := range t.b {
+			base = fill(&t.b[l], base)
+		}
+
+		// h = g^(2^(e-sqrtWindow))
+		h := g
+		for j := 0; j < {{.SqrtE}}-sqrtWindow; j++ {
+			h.Square(&h)
+		}
+		t.dlog = make(map[{{.ElementName}}]uint64, 1 << sqrtWindow)
+		hj := One()
+		for j := uint64(0); j < 1 << sqrtWindow; j++ {
+			t.dlog[hj] = j
+			hj.Mul(&hj, &h)
+		}
+
+		_sqrtTables{{.ElementName}} = t
+	})
+	return _sqrtTables{{.ElementName}}
+}
+{{- end}}
+
+// BatchSqrt returns a new slice with the square roots of the elements of a, computed in parallel.
+// isSquare[i] is false if a[i] is not a square, in which case res[i] is 0.
+func BatchSqrt(a []{{.ElementName}}) (res []{{.ElementName}}, isSquare []bool) {
+	res = make([]{{.ElementName}}, len(a))
+	isSquare = make([]bool, len(a))
+	utils.Parallelize(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			isSquare[i] = res[i].Sqrt(&a[i]) != nil
+		}
+	})
+	return
+}
+
+{{- if or .CbrtQ2Mod3 .CbrtTonelliShanks}}
+
+var _bCbrtExponent{{.ElementName}} *big.Int
+
+func init() {
+	_bCbrtExponent{{.ElementName}}, _ = new(big.Int).SetString("{{.CbrtExponent}}", 16)
+}
+
+// Cbrt z = ∛x (mod q)
+// if the cube root doesn't exist (x is not a cube mod q)
+// Cbrt leaves z unchanged and returns nil
+func (z *{{.ElementName}}) Cbrt(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .CbrtQ2Mod3}}
+		// q ≡ 2 (mod 3), every element has a unique cube root
+		// using  z = x^((2q-1)/3) (mod q)
+		var y {{.ElementName}}
+		y.Exp(*x, _bCbrtExponent{{.ElementName}})
+		return z.Set(&y)
+	{{- else}}
+		// q ≡ 1 (mod 3), q-1 = 3ᵉ * s with s prime to 3
+		// using the cube root variant of Tonelli-Shanks: y is a cube root of x * b where b is
+		// a 3ᵉ-th root of unity, which is corrected by powers of g.
+		var y, b, w, t, t2 {{.ElementName}}
+		// w = x^⌊s/3⌋
+		w.Exp(*x, _bCbrtExponent{{.ElementName}})
+		{{- if eq .CbrtSMod3 1}}
+
+		// s ≡ 1 (mod 3)
+		// y = x^((2s+1)/3) = w² * x
+		y.Square(&w).Mul(&y, x)
+
+		// b = x²ˢ = (w³ * x)² = (y * w)²
+		b.Mul(&y, &w).Square(&b)
+		{{- else}}
+
+		// s ≡ 2 (mod 3)
+		// y = x^((s+1)/3) = w * x
+		y.Mul(&w, x)
+
+		// b = xˢ = w³ * x² = y² * w
+		b.Square(&y).Mul(&b, &w)
+		{{- end}}
+
+		// g = nonCubicResidue ^ s
+		var g = {{.ElementName}}{
+			{{- range $i := .CbrtG}}
+			{{$i}},{{end}}
+		}
+		// ω = g^(3ᵉ⁻¹), a primitive cube root of unity
+		var omega = {{.ElementName}}{
+			{{- range $i := .CbrtOmega}}
+			{{$i}},{{end}}
+		}
+		r := uint64({{.CbrtE}})
+
+		// x is a cube iff b^(3ᵉ⁻¹) = 1
+		t = b
+		for i := uint64(0); i < r-1; i++ {
+			t2.Square(&t)
+			t.Mul(&t, &t2)
+		}
+		if t.IsZero() {
+			return z.SetZero()
+		}
+		if !t.IsOne() {
+			return nil
+		}
+		for {
+			// b is of order 3ᵐ, and β = b^(3ᵐ⁻¹) ∈ {ω, ω²} if m > 0
+			var m uint64
+			var beta {{.ElementName}}
+			t = b
+			for !t.IsOne() {
+				beta = t
+				t2.Square(&t)
+				t.Mul(&t, &t2)
+				m++
+			}
+
+			if m == 0 {
+				return z.Set(&y)
+			}
+			// t = g^(3^(r-m-1)), with t^(3ᵐ) = ω
+			t = g
+			for i := r - m - 1; i > 0; i-- {
+				t2.Square(&t)
+				t.Mul(&t, &t2)
+			}
+			// choose d ∈ {t, t²} such that d^(3ᵐ) = β², then b * d³ is of order < 3ᵐ
+			if beta.Equal(&omega) {
+				t.Square(&t)
+			}
+			y.Mul(&y, &t)
+			t2.Square(&t)
+			t.Mul(&t, &t2)
+			b.Mul(&b, &t)
+
+			// g = g^(3^(r-m)) is of order 3ᵐ
+			for i := r - m; i > 0; i-- {
+				t2.Square(&g)
+				g.Mul(&g, &t2)
+			}
+			r = m
+		}
+	{{- end}}
+}
+{{- end}}
+
 
 
 `
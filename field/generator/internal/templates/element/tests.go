@@ -195,6 +195,32 @@ func Benchmark{{toTitle .ElementName}}Sqrt(b *testing.B) {
 	}
 }
 
+func Benchmark{{toTitle .ElementName}}BatchSqrt(b *testing.B) {
+	const n = 1 << 10
+	a := make([]{{.ElementName}}, n)
+	for i := range a {
+		a[i].SetRandom()
+		a[i].Square(&a[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchSqrt(a)
+	}
+}
+
+{{- if or .CbrtQ2Mod3 .CbrtTonelliShanks}}
+
+func Benchmark{{toTitle .ElementName}}Cbrt(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetUint64(8)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Cbrt(&a)
+	}
+}
+{{- end}}
+
 func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
 	x := {{.ElementName}}{
 		{{- range $i := .RSquare}}
@@ -631,6 +657,108 @@ func Test{{toTitle .ElementName}}Legendre(t *testing.T) {
 	
 }
 
+func Test{{toTitle .ElementName}}BatchSqrt(t *testing.T) {
+	t.Parallel()
+
+	a := make([]{{.ElementName}}, 0, len(staticTestValues) + 64)
+	a = append(a, staticTestValues...)
+	for i := 0; i < 64; i++ {
+		var e {{.ElementName}}
+		e.SetRandom()
+		if i%2 == 0 {
+			e.Square(&e)
+		}
+		a = append(a, e)
+	}
+
+	res, isSquare := BatchSqrt(a)
+	for i := range a {
+		var expected {{.ElementName}}
+		if (expected.Sqrt(&a[i]) != nil) != isSquare[i] {
+			t.Fatal("BatchSqrt and Sqrt disagree on quadratic residuosity")
+		}
+		if !res[i].Equal(&expected) {
+			t.Fatal("BatchSqrt and Sqrt results don't match")
+		}
+	}
+}
+
+{{- if or .CbrtQ2Mod3 .CbrtTonelliShanks}}
+
+func Test{{toTitle .ElementName}}Cbrt(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	// x is a cube iff x = 0 or x^((q-1)/gcd(3, q-1)) = 1
+	isCube := func(x *big.Int) bool {
+		var e big.Int
+		e.Sub(Modulus(), big.NewInt(1))
+		{{- if .CbrtTonelliShanks}}
+		e.Div(&e, big.NewInt(3))
+		{{- end}}
+		return x.Sign() == 0 || e.Exp(x, &e, Modulus()).Cmp(big.NewInt(1)) == 0
+	}
+
+	properties.Property("Cbrt: z³ must be equal to x when x is a cube, Cbrt must return nil otherwise", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			if c.Cbrt(&a.element) == nil {
+				return !isCube(&a.bigint)
+			}
+			d.Square(&c).Mul(&d, &c)
+			return isCube(&a.bigint) && d.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("Cbrt: must find a cube root of x³", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d, e {{.ElementName}}
+			d.Square(&a.element).Mul(&d, &a.element)
+			if c.Cbrt(&d) == nil {
+				return false
+			}
+			e.Square(&c).Mul(&e, &c)
+			return e.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("Cbrt: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			b := a.element
+			b.Cbrt(&a.element)
+			a.element.Cbrt(&a.element)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, a := range staticTestValues {
+		var c, d, e {{.ElementName}}
+		d.Square(&a).Mul(&d, &a)
+		if c.Cbrt(&d) == nil {
+			t.Fatal("Cbrt failed special test values")
+		}
+		e.Square(&c).Mul(&e, &c)
+		if !e.Equal(&d) {
+			t.Fatal("Cbrt failed special test values")
+		}
+	}
+}
+{{- end}}
+
 func Test{{toTitle .ElementName}}BitLen(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
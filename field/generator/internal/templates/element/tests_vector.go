@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"sort"
 	"reflect"
+	"math/big"
 )
 
 
@@ -60,4 +61,260 @@ func TestVectorEmptyRoundTrip(t *testing.T) {
 	assert.True(reflect.DeepEqual(v1,v2))
 }
 
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	// lengths around the group sizes of the assembly kernels, and across the chunks
+	// of the inner product
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 15, 17, 257, 1025} {
+		a, b := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+
+		var s {{.ElementName}}
+		s.SetRandom()
+
+		sum, diff, prod, scaled := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		sum.Add(a, b)
+		diff.Sub(a, b)
+		prod.Mul(a, b)
+		scaled.ScalarMul(a, &s)
+
+		var expectedSum, expectedInnerProduct {{.ElementName}}
+		for i := 0; i < n; i++ {
+			var tmp {{.ElementName}}
+			assert.True(sum[i].Equal(tmp.Add(&a[i], &b[i])), "Add mismatch at index %d (n = %d)", i, n)
+			assert.True(diff[i].Equal(tmp.Sub(&a[i], &b[i])), "Sub mismatch at index %d (n = %d)", i, n)
+			assert.True(prod[i].Equal(tmp.Mul(&a[i], &b[i])), "Mul mismatch at index %d (n = %d)", i, n)
+			assert.True(scaled[i].Equal(tmp.Mul(&a[i], &s)), "ScalarMul mismatch at index %d (n = %d)", i, n)
+			expectedSum.Add(&expectedSum, &a[i])
+			expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+
+		s = a.Sum()
+		assert.True(s.Equal(&expectedSum), "Sum mismatch (n = %d)", n)
+		s = a.InnerProduct(b)
+		assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch (n = %d)", n)
+
+		// in place
+		sum.Sub(sum, b)
+		assert.True(reflect.DeepEqual(sum, a), "in place Sub mismatch (n = %d)", n)
+	}
+
+	// q - 1 everywhere, whose Sum and InnerProduct are -n and n
+	const n = 1025
+	a, b := make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetInt64(-1)
+	}
+	var s, expected {{.ElementName}}
+	expected.SetInt64(-n)
+	s = a.Sum()
+	assert.True(s.Equal(&expected), "Sum mismatch on q - 1")
+	expected.SetInt64(n)
+	s = a.InnerProduct(a)
+	assert.True(s.Equal(&expected), "InnerProduct mismatch on q - 1")
+	b.Add(a, a)
+	b.Sub(b, a)
+	assert.True(reflect.DeepEqual(a, b), "Add / Sub mismatch on q - 1")
+
+	assert.Panics(func() { b.Add(a, b[1:]) })
+	assert.Panics(func() { b.Sub(a, b[1:]) })
+	assert.Panics(func() { b.Mul(a, b[1:]) })
+	short := b[1:]
+	assert.Panics(func() { short.ScalarMul(a, &s) })
+	assert.Panics(func() { a.InnerProduct(b[1:]) })
+}
+
+func TestVectorBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[n/2].SetZero()
+
+	expected := BatchInvert(a)
+	inv := make(Vector, n)
+	inv.BatchInvert(a)
+	assert.True(reflect.DeepEqual(Vector(expected), inv))
+
+	// in place
+	a.BatchInvert(a)
+	assert.True(reflect.DeepEqual(a, inv))
+
+	assert.Panics(func() { a.BatchInvert(inv[1:]) })
+}
+
+func TestVectorCanonicalLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 1000
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[1].SetOne()
+
+	expected := make([]uint64, Limbs*n)
+	for i := 0; i < n; i++ {
+		var b big.Int
+		a[i].BigInt(&b)
+		for j, w := range b.Bits() {
+			expected[Limbs*i+j] = uint64(w)
+		}
+	}
+	limbs := a.ToCanonicalLimbs()
+	assert.Equal(expected, limbs)
+
+	var v Vector
+	assert.NoError(v.FromCanonicalLimbs(limbs))
+	assert.True(reflect.DeepEqual(a, v))
+
+	// values ⩾ q are rejected
+	bad := make([]uint64, len(limbs))
+	copy(bad, limbs)
+	copy(bad[Limbs*(n/2):], q{{.ElementName}}[:])
+	err := v.FromCanonicalLimbs(bad)
+	assert.Error(err)
+	assert.Contains(err.Error(), "element 500")
+	assert.True(reflect.DeepEqual(a, v), "the vector should be left unchanged on error")
+	{{- if gt .NbWords 1}}
+	assert.Error(v.FromCanonicalLimbs(limbs[1:]), "the number of limbs must be a multiple of Limbs")
+	assert.Panics(func() { VectorFromMontgomeryLimbs(limbs[1:]) })
+	{{- end}}
+
+	// empty vectors
+	assert.NoError(v.FromCanonicalLimbs(nil))
+	assert.Equal(0, len(v))
+	assert.Equal(0, len(Vector{}.ToCanonicalLimbs()))
+}
+
+func TestVectorMontgomeryLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+
+	limbs := a.MontgomeryLimbs()
+	assert.Equal(Limbs*n, len(limbs))
+	for i := 0; i < n; i++ {
+		assert.Equal(a[i][:], limbs[Limbs*i:Limbs*(i+1)])
+	}
+
+	// the views alias the vector
+	v := VectorFromMontgomeryLimbs(limbs)
+	assert.True(reflect.DeepEqual(a, v))
+	v[3].SetOne()
+	assert.True(a[3].IsOne())
+
+	assert.Nil(Vector{}.MontgomeryLimbs())
+	assert.Nil(VectorFromMontgomeryLimbs(nil))
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 20
+	a, c, res := make(Vector, n), make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		c[i].SetRandom()
+	}
+
+	b.Run("Add", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("Sub", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Sub(a, c)
+		}
+	})
+	b.Run("ScalarMul", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.ScalarMul(a, &c[0])
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("Sum", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = a.Sum()
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+}
+
+`
+
+const TestVectorOpsAmd64 = `
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestVectorSumAVX2 checks Sum and InnerProduct with the AVX2 kernels, which are not used
+// on the CPUs supporting AVX-512, against the generic implementation.
+func TestVectorSumAVX2(t *testing.T) {
+	if !supportAvx2 {
+		t.Skip("AVX2 is not supported")
+	}
+	assert := require.New(t)
+
+	defer func(avx512 bool) {
+		supportAvx512 = avx512
+	}(supportAvx512)
+	supportAvx512 = false
+
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 15, 17, 257, 1025} {
+		a, b := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+
+		// the generic implementations accumulate in their result
+		var expectedSum, expectedInnerProduct {{.ElementName}}
+		sumVecGeneric(&expectedSum, a)
+		innerProductVecGeneric(&expectedInnerProduct, a, b)
+		s := a.Sum()
+		assert.True(s.Equal(&expectedSum), "Sum mismatch (n = %d)", n)
+		s = a.InnerProduct(b)
+		assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch (n = %d)", n)
+	}
+
+	// the largest limbs, q - 1 in Montgomery form, maximize the accumulators and the carries
+	const n = 1025
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i] = {{.ElementName}}{ {{- range $i := .NbWordsIndexesFull}}{{if $i}}, {{end}}q{{$i}}{{if eq $i 0}} - 1{{end}}{{end}} }
+	}
+	var expectedSum, expectedInnerProduct {{.ElementName}}
+	sumVecGeneric(&expectedSum, a)
+	innerProductVecGeneric(&expectedInnerProduct, a, a)
+	s := a.Sum()
+	assert.True(s.Equal(&expectedSum), "Sum mismatch on the largest limbs")
+	s = a.InnerProduct(a)
+	assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch on the largest limbs")
+}
+
 `
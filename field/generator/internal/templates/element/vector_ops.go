@@ -0,0 +1,233 @@
+package element
+
+// VectorOpsAmd64 is included with AMD64 builds when the field has assembly
+const VectorOpsAmd64 = `
+import (
+	"math/bits"
+)
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !supportAdx {
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+	scalarMulVec(&(*vector)[0], &a[0], b, n)
+}
+
+//go:noescape
+func scalarMulVec(res, a, b *{{.ElementName}}, n uint64)
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !supportAdx {
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+	mulVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func mulVec(res, a, b *{{.ElementName}}, n uint64)
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	v := *vector
+	if !supportAvx512 && !supportAvx2 {
+		sumVecGeneric(&res, v)
+		return
+	}
+
+	// the kernels process groups of 4 (AVX-512) or 2 (AVX2) elements, and the lanes
+	// of the accumulators don't overflow for less than 2³² elements.
+	const blockSize = 1 << 31
+	for len(v) >= 4 {
+		n := len(v)
+		if n > blockSize {
+			n = blockSize
+		}
+		n -= n % 4
+
+		var t [8 * Limbs]uint64
+		if supportAvx512 {
+			sumVecAVX512(&t, &v[0], uint64(n))
+		} else {
+			sumVecAVX2(&t, &v[0], uint64(n))
+		}
+		var s {{.ElementName}}
+		reduceSumVec(&s, &t)
+		res.Add(&res, &s)
+
+		v = v[n:]
+	}
+	for i := 0; i < len(v); i++ {
+		res.Add(&res, &v[i])
+	}
+	return
+}
+
+//go:noescape
+func sumVecAVX512(t *[8 * Limbs]uint64, a *{{.ElementName}}, n uint64)
+
+//go:noescape
+func sumVecAVX2(t *[8 * Limbs]uint64, a *{{.ElementName}}, n uint64)
+
+// reduceSumVec sets z to the sum accumulated by the sumVec kernels: t[m] is a partial
+// sum of the 32-bit words at position m mod 2*Limbs of the elements.
+func reduceSumVec(z *{{.ElementName}}, t *[8 * Limbs]uint64) {
+	// w[j] is the sum of the j-th 32-bit words of the elements
+	var w [2 * Limbs]uint64
+	for m := 0; m < len(t); m++ {
+		w[m%len(w)] += t[m]
+	}
+
+	// the sum is lo + hi⋅2^(64*Limbs)
+	var lo {{.ElementName}}
+	var hi, c0, c1 uint64
+	for k := 0; k < Limbs; k++ {
+		lo[k], c0 = bits.Add64(w[2*k], w[2*k+1]<<32, 0)
+		lo[k], c1 = bits.Add64(lo[k], hi, 0)
+		hi = w[2*k+1]>>32 + c0 + c1
+	}
+
+	// the elements are in Montgomery form, and so is their sum; lo may not be reduced,
+	// lo mod q = (lo⋅r⁻¹)⋅r²⋅r⁻¹ is obtained with a Montgomery reduction and multiplication,
+	// and hi⋅r mod q is the Montgomery form of hi.
+	fromMont(&lo)
+	lo.Mul(&lo, &rSquare)
+	z.SetUint64(hi)
+	z.Add(z, &lo)
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	v := *vector
+	if len(v) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	if !supportAdx {
+		innerProductVecGeneric(&res, v, other)
+		return
+	}
+
+	// the products are computed by chunks, which are summed with the Sum kernels
+	const chunkSize = 256
+	var buf [chunkSize]{{.ElementName}}
+	for start := 0; start < len(v); start += chunkSize {
+		end := start + chunkSize
+		if end > len(v) {
+			end = len(v)
+		}
+		products := Vector(buf[:end-start])
+		mulVec(&products[0], &v[start], &other[start], uint64(end-start))
+		s := products.Sum()
+		res.Add(&res, &s)
+	}
+	return
+}
+`
+
+// VectorOpsPureGo is included with non-AMD64 builds, purego builds, or fields without assembly
+const VectorOpsPureGo = `
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	addVecGeneric(*vector, a, b)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	subVecGeneric(*vector, a, b)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	scalarMulVecGeneric(*vector, a, b)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	mulVecGeneric(*vector, a, b)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	sumVecGeneric(&res, *vector)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	innerProductVecGeneric(&res, *vector, other)
+	return
+}
+`
@@ -0,0 +1,437 @@
+package extension
+
+const Base = `
+import (
+	"math/big"
+
+	"{{.FieldPackagePath}}"
+)
+
+{{ $elem := .BaseType -}}
+{{ $name := .Name -}}
+{{ $var := .Var -}}
+{{ $c0 := index .Coords 0 -}}
+{{ $c1 := index .Coords 1 -}}
+
+// {{$name}} is a degree {{.Degree}} extension of {{$elem}}, as {{.BaseName}}[{{$var}}]/({{.Polynomial}}).
+//
+// The element {{range $i, $c := .Coords}}{{if $i}} + {{end}}{{$c}}{{if eq $i 1}}⋅{{$var}}{{else if $i}}⋅{{$var}}{{supScr $i}}{{end}}{{end}} is
+// stored as its coordinates in the basis (1{{range $i, $c := .Coords}}{{if eq $i 1}}, {{$var}}{{else if $i}}, {{$var}}{{supScr $i}}{{end}}{{end}}).
+type {{$name}} struct {
+	{{range $i, $c := .Coords}}{{if $i}}, {{end}}{{$c}}{{end}} {{$elem}}
+}
+
+// nonResidue{{$name}} is {{$var}}{{supScr .Degree}} = {{.RootOf}}
+var nonResidue{{$name}} = {{.NonResidue}}
+
+{{- if .OverField}}
+
+// frobeniusCoefficients{{$name}}[i] = {{.RootOf}}^(i(p-1)/{{.Degree}}), so that ({{$var}}ⁱ)ᵖ = frobeniusCoefficients{{$name}}[i]⋅{{$var}}ⁱ
+{{- else}}
+
+// frobeniusCoefficients{{$name}}[i] = {{.RootOf}}^⌊i⋅p/{{.Degree}}⌋, so that ({{$var}}ⁱ)ᵖ = frobeniusCoefficients{{$name}}[i]⋅{{$var}}^(i⋅p mod {{.Degree}})
+{{- end}}
+var frobeniusCoefficients{{$name}} = [{{.Degree}}]{{$elem}}{
+	{{- range .FrobeniusCoefficients}}
+	{{.}},
+	{{- end}}
+}
+
+// mulByNonResidue{{$name}} sets z = {{.RootOf}}⋅x
+func mulByNonResidue{{$name}}(z, x *{{$elem}}) {
+	z.Mul(x, &nonResidue{{$name}})
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{$name}}) Equal(x *{{$name}}) bool {
+	return {{range $i, $c := .Coords}}{{if $i}} && {{end}}z.{{$c}}.Equal(&x.{{$c}}){{end}}
+}
+
+// SetZero sets z to 0 and returns z
+func (z *{{$name}}) SetZero() *{{$name}} {
+	{{- range .Coords}}
+	z.{{.}}.SetZero()
+	{{- end}}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *{{$name}}) SetOne() *{{$name}} {
+	{{- range $i, $c := .Coords}}
+	{{- if $i}}
+	z.{{$c}}.SetZero()
+	{{- else}}
+	z.{{$c}}.SetOne()
+	{{- end}}
+	{{- end}}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *{{$name}}) Set(x *{{$name}}) *{{$name}} {
+	*z = *x
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *{{$name}}) SetRandom() (*{{$name}}, error) {
+	{{- range .Coords}}
+	if _, err := z.{{.}}.SetRandom(); err != nil {
+		return nil, err
+	}
+	{{- end}}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *{{$name}}) IsZero() bool {
+	return {{range $i, $c := .Coords}}{{if $i}} && {{end}}z.{{$c}}.IsZero(){{end}}
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *{{$name}}) IsOne() bool {
+	return {{range $i, $c := .Coords}}{{if $i}} && z.{{$c}}.IsZero(){{else}}z.{{$c}}.IsOne(){{end}}{{end}}
+}
+
+// Add sets z = x + y and returns z
+func (z *{{$name}}) Add(x, y *{{$name}}) *{{$name}} {
+	{{- range .Coords}}
+	z.{{.}}.Add(&x.{{.}}, &y.{{.}})
+	{{- end}}
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *{{$name}}) Sub(x, y *{{$name}}) *{{$name}} {
+	{{- range .Coords}}
+	z.{{.}}.Sub(&x.{{.}}, &y.{{.}})
+	{{- end}}
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *{{$name}}) Double(x *{{$name}}) *{{$name}} {
+	{{- range .Coords}}
+	z.{{.}}.Double(&x.{{.}})
+	{{- end}}
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *{{$name}}) Neg(x *{{$name}}) *{{$name}} {
+	{{- range .Coords}}
+	z.{{.}}.Neg(&x.{{.}})
+	{{- end}}
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{$name}}) String() string {
+	return {{range $i, $c := .Coords}}{{if $i}} + "+" + {{end}}z.{{$c}}.String(){{if eq $i 1}} + "*{{$var}}"{{else if $i}} + "*{{$var}}{{supScr $i}}"{{end}}{{end}}
+}
+
+// MulByElement sets z = x⋅y, y in the base field, and returns z
+func (z *{{$name}}) MulByElement(x *{{$name}}, y *{{.ElementType}}) *{{$name}} {
+	yCopy := *y
+	{{- range .Coords}}
+	{{- if $.OverField}}
+	z.{{.}}.Mul(&x.{{.}}, &yCopy)
+	{{- else}}
+	z.{{.}}.MulByElement(&x.{{.}}, &yCopy)
+	{{- end}}
+	{{- end}}
+	return z
+}
+
+{{- if eq .Degree 2}}
+
+// Mul sets z = x⋅y and returns z
+func (z *{{$name}}) Mul(x, y *{{$name}}) *{{$name}} {
+	// Karatsuba
+	var a, b, c {{$elem}}
+	a.Add(&x.{{$c0}}, &x.{{$c1}})
+	b.Add(&y.{{$c0}}, &y.{{$c1}})
+	a.Mul(&a, &b)
+	b.Mul(&x.{{$c0}}, &y.{{$c0}})
+	c.Mul(&x.{{$c1}}, &y.{{$c1}})
+	z.{{$c1}}.Sub(&a, &b).Sub(&z.{{$c1}}, &c)
+	mulByNonResidue{{$name}}(&c, &c)
+	z.{{$c0}}.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *{{$name}}) Square(x *{{$name}}) *{{$name}} {
+	var a, b {{$elem}}
+	a.Mul(&x.{{$c0}}, &x.{{$c1}}).Double(&a)
+	b.Square(&x.{{$c1}})
+	mulByNonResidue{{$name}}(&b, &b)
+	z.{{$c0}}.Square(&x.{{$c0}}).Add(&z.{{$c0}}, &b)
+	z.{{$c1}} = a
+	return z
+}
+
+// Norm returns the norm x₀² - {{.RootOf}}x₁² of x, in {{if .OverField}}the base field{{else}}{{.BaseName}}{{end}}
+func (z *{{$name}}) Norm() {{$elem}} {
+	var n, t {{$elem}}
+	n.Square(&z.{{$c0}})
+	t.Square(&z.{{$c1}})
+	mulByNonResidue{{$name}}(&t, &t)
+	n.Sub(&n, &t)
+	return n
+}
+
+// Inverse sets z = 1/x and returns z. If x is 0, z is set to 0.
+func (z *{{$name}}) Inverse(x *{{$name}}) *{{$name}} {
+	// 1/(x₀ + x₁{{$var}}) = (x₀ - x₁{{$var}})/(x₀² - {{.RootOf}}x₁²)
+	n := x.Norm()
+	n.Inverse(&n)
+	z.{{$c0}}.Mul(&x.{{$c0}}, &n)
+	z.{{$c1}}.Mul(&x.{{$c1}}, &n).Neg(&z.{{$c1}})
+	return z
+}
+
+// Conjugate sets z to the conjugate x₀ - x₁{{$var}} of x and returns z
+func (z *{{$name}}) Conjugate(x *{{$name}}) *{{$name}} {
+	z.{{$c0}} = x.{{$c0}}
+	z.{{$c1}}.Neg(&x.{{$c1}})
+	return z
+}
+
+{{- if .OverField}}
+
+// Frobenius sets z = xᵖ and returns z. It is the conjugate of x, since uᵖ = -u.
+func (z *{{$name}}) Frobenius(x *{{$name}}) *{{$name}} {
+	return z.Conjugate(x)
+}
+{{- end}}
+
+{{- else if eq .Degree 3}}
+{{- $c2 := index .Coords 2}}
+
+// Mul sets z = x⋅y and returns z
+func (z *{{$name}}) Mul(x, y *{{$name}}) *{{$name}} {
+	// Karatsuba, see https://eprint.iacr.org/2006/471.pdf, section 4
+	var v0, v1, v2, t0, t1, c0, c1, c2 {{$elem}}
+	v0.Mul(&x.{{$c0}}, &y.{{$c0}})
+	v1.Mul(&x.{{$c1}}, &y.{{$c1}})
+	v2.Mul(&x.{{$c2}}, &y.{{$c2}})
+
+	// c₀ = v₀ + {{.RootOf}}((x₁ + x₂)(y₁ + y₂) - v₁ - v₂)
+	t0.Add(&x.{{$c1}}, &x.{{$c2}})
+	t1.Add(&y.{{$c1}}, &y.{{$c2}})
+	c0.Mul(&t0, &t1).Sub(&c0, &v1).Sub(&c0, &v2)
+	mulByNonResidue{{$name}}(&c0, &c0)
+	c0.Add(&c0, &v0)
+
+	// c₁ = (x₀ + x₁)(y₀ + y₁) - v₀ - v₁ + {{.RootOf}}v₂
+	t0.Add(&x.{{$c0}}, &x.{{$c1}})
+	t1.Add(&y.{{$c0}}, &y.{{$c1}})
+	c1.Mul(&t0, &t1).Sub(&c1, &v0).Sub(&c1, &v1)
+	mulByNonResidue{{$name}}(&t0, &v2)
+	c1.Add(&c1, &t0)
+
+	// c₂ = (x₀ + x₂)(y₀ + y₂) - v₀ + v₁ - v₂
+	t0.Add(&x.{{$c0}}, &x.{{$c2}})
+	t1.Add(&y.{{$c0}}, &y.{{$c2}})
+	c2.Mul(&t0, &t1).Sub(&c2, &v0).Add(&c2, &v1).Sub(&c2, &v2)
+
+	z.{{$c0}}, z.{{$c1}}, z.{{$c2}} = c0, c1, c2
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *{{$name}}) Square(x *{{$name}}) *{{$name}} {
+	// CH-SQR2, see https://eprint.iacr.org/2006/471.pdf, section 4
+	var s0, s1, s2, s3, s4 {{$elem}}
+	s0.Square(&x.{{$c0}})
+	s1.Mul(&x.{{$c0}}, &x.{{$c1}}).Double(&s1)
+	s2.Sub(&x.{{$c0}}, &x.{{$c1}}).Add(&s2, &x.{{$c2}}).Square(&s2)
+	s3.Mul(&x.{{$c1}}, &x.{{$c2}}).Double(&s3)
+	s4.Square(&x.{{$c2}})
+
+	// z₂ = s₁ + s₂ + s₃ - s₀ - s₄
+	z.{{$c2}}.Add(&s1, &s2).Add(&z.{{$c2}}, &s3).Sub(&z.{{$c2}}, &s0).Sub(&z.{{$c2}}, &s4)
+	// z₀ = s₀ + {{.RootOf}}s₃
+	mulByNonResidue{{$name}}(&s3, &s3)
+	z.{{$c0}}.Add(&s0, &s3)
+	// z₁ = s₁ + {{.RootOf}}s₄
+	mulByNonResidue{{$name}}(&s4, &s4)
+	z.{{$c1}}.Add(&s1, &s4)
+	return z
+}
+
+// adjugate returns the coordinates of x⋅N(x)/x, N being the norm
+func (z *{{$name}}) adjugate() (c0, c1, c2 {{$elem}}) {
+	var t {{$elem}}
+
+	// c₀ = x₀² - {{.RootOf}}x₁x₂
+	c0.Square(&z.{{$c0}})
+	t.Mul(&z.{{$c1}}, &z.{{$c2}})
+	mulByNonResidue{{$name}}(&t, &t)
+	c0.Sub(&c0, &t)
+
+	// c₁ = {{.RootOf}}x₂² - x₀x₁
+	c1.Square(&z.{{$c2}})
+	mulByNonResidue{{$name}}(&c1, &c1)
+	t.Mul(&z.{{$c0}}, &z.{{$c1}})
+	c1.Sub(&c1, &t)
+
+	// c₂ = x₁² - x₀x₂
+	c2.Square(&z.{{$c1}})
+	t.Mul(&z.{{$c0}}, &z.{{$c2}})
+	c2.Sub(&c2, &t)
+	return
+}
+
+// norm returns the norm of x from its adjugate
+func (z *{{$name}}) norm(c0, c1, c2 *{{$elem}}) {{$elem}} {
+	// N(x) = x₀c₀ + {{.RootOf}}(x₂c₁ + x₁c₂)
+	var n, t {{$elem}}
+	n.Mul(&z.{{$c2}}, c1)
+	t.Mul(&z.{{$c1}}, c2)
+	n.Add(&n, &t)
+	mulByNonResidue{{$name}}(&n, &n)
+	t.Mul(&z.{{$c0}}, c0)
+	n.Add(&n, &t)
+	return n
+}
+
+// Norm returns the norm {{if .OverField}}x⋅xᵖ⋅xᵖ² of x, in the base field{{else}}of x, in {{.BaseName}}{{end}}
+func (z *{{$name}}) Norm() {{$elem}} {
+	c0, c1, c2 := z.adjugate()
+	return z.norm(&c0, &c1, &c2)
+}
+
+// Inverse sets z = 1/x and returns z. If x is 0, z is set to 0.
+func (z *{{$name}}) Inverse(x *{{$name}}) *{{$name}} {
+	c0, c1, c2 := x.adjugate()
+	n := x.norm(&c0, &c1, &c2)
+	n.Inverse(&n)
+	z.{{$c0}}.Mul(&c0, &n)
+	z.{{$c1}}.Mul(&c1, &n)
+	z.{{$c2}}.Mul(&c2, &n)
+	return z
+}
+
+{{- if .OverField}}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *{{$name}}) Frobenius(x *{{$name}}) *{{$name}} {
+	z.{{$c0}} = x.{{$c0}}
+	z.{{$c1}}.Mul(&x.{{$c1}}, &frobeniusCoefficients{{$name}}[1])
+	z.{{$c2}}.Mul(&x.{{$c2}}, &frobeniusCoefficients{{$name}}[2])
+	return z
+}
+
+// FrobeniusSquare sets z = xᵖ² and returns z
+func (z *{{$name}}) FrobeniusSquare(x *{{$name}}) *{{$name}} {
+	// with γᵢ = frobeniusCoefficients{{$name}}[i], uᵖ² = γ₁²u = γ₂u and
+	// (u²)ᵖ² = γ₂²u² = γ₁u², since γ₁³ = 1
+	z.{{$c0}} = x.{{$c0}}
+	z.{{$c1}}.Mul(&x.{{$c1}}, &frobeniusCoefficients{{$name}}[2])
+	z.{{$c2}}.Mul(&x.{{$c2}}, &frobeniusCoefficients{{$name}}[1])
+	return z
+}
+{{- end}}
+
+{{- end}}
+
+{{- if not .OverField}}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *{{$name}}) Frobenius(x *{{$name}}) *{{$name}} {
+	// (∑ xᵢ{{$var}}ⁱ)ᵖ = ∑ xᵢᵖ⋅({{$var}}ⁱ)ᵖ, see frobeniusCoefficients{{$name}}
+	var r {{$name}}
+	{{- range .FrobeniusTerms}}
+	r.{{.Dst}}.Frobenius(&x.{{.Src}})
+	{{- if not .IsOne}}
+	r.{{.Dst}}.Mul(&r.{{.Dst}}, &frobeniusCoefficients{{$name}}[{{.Index}}])
+	{{- end}}
+	{{- end}}
+	*z = r
+	return z
+}
+{{- end}}
+
+// Div sets z = x/y and returns z
+func (z *{{$name}}) Div(x, y *{{$name}}) *{{$name}} {
+	var r {{$name}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *{{$name}}) Exp(x {{$name}}, k *big.Int) *{{$name}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Select is conditional move.
+// If cond = 0, it sets z to caseZ and returns it. otherwise caseNz.
+func (z *{{$name}}) Select(cond int, caseZ *{{$name}}, caseNz *{{$name}}) *{{$name}} {
+	{{- range .Coords}}
+	z.{{.}}.Select(cond, &caseZ.{{.}}, &caseNz.{{.}})
+	{{- end}}
+	return z
+}
+
+// BatchInvert{{$name}} returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert{{$name}}(a []{{$name}}) []{{$name}} {
+	res := make([]{{$name}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator {{$name}}
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+`
@@ -0,0 +1,533 @@
+package extension
+
+const Tests = `
+import (
+	"math/big"
+	"testing"
+
+	"{{.FieldPackagePath}}"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+{{ $elem := .BaseType -}}
+{{ $name := .Name -}}
+{{ $c0 := index .Coords 0 -}}
+{{ $vector := print "Vector" .Name -}}
+
+// ------------------------------------------------------------
+// tests
+
+func Test{{$name}}ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen{{$name}}()
+	genB := gen{{$name}}()
+
+	properties.Property("[{{$name}}] Having the receiver as operand (addition) should output the same result", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c, d {{$name}}
+			d.Set(a)
+			c.Add(a, b)
+			a.Add(a, b)
+			b.Add(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (sub) should output the same result", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c, d {{$name}}
+			d.Set(a)
+			c.Sub(a, b)
+			a.Sub(a, b)
+			b.Sub(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c, d {{$name}}
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (neg) should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Neg(a)
+			a.Neg(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (double) should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Double(a)
+			a.Double(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] Having the receiver as operand (frobenius) should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{$name}}Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen{{$name}}()
+	genB := gen{{$name}}()
+	genE := genElement()
+
+	properties.Property("[{{$name}}] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c {{$name}}
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] mul should match the schoolbook multiplication mod {{.Polynomial}}", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c {{$name}}
+			c.Mul(a, b)
+			d := mul{{$name}}Schoolbook(a, b)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] mul should be commutative", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c, d {{$name}}
+			c.Mul(a, b)
+			d.Mul(b, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] square and mul should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b, c {{$name}}
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] double and add should output the same result", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b, c {{$name}}
+			b.Add(a, a)
+			c.Double(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] mulByElement should match mul by an embedded element", prop.ForAll(
+		func(a *{{$name}}, e {{.ElementType}}) bool {
+			var b, c {{$name}}
+			b.{{.ElementPath}} = e
+			b.Mul(a, &b)
+			c.MulByElement(a, &e)
+			return b.Equal(&c)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[{{$name}}] x⋅x⁻¹ should be 1", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Inverse(a).Mul(&b, a)
+			return a.IsZero() || b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b {{$name}}
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$name}}] div & mul should leave an element invariant", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c {{$name}}
+			c.Div(a, b).Mul(&c, b)
+			return b.IsZero() || c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$name}}] frobenius should match exp by p", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b, c {{$name}}
+			b.Frobenius(a)
+			c.Exp(*a, {{.FieldPackageName}}.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	{{- if and .OverField (eq .Degree 3)}}
+
+	properties.Property("[{{$name}}] frobeniusSquare should match frobenius twice", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b, c {{$name}}
+			b.FrobeniusSquare(a)
+			c.Frobenius(a).Frobenius(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+	{{- end}}
+
+	{{- if .OverField}}
+
+	properties.Property("[{{$name}}] norm should be the product of the conjugates", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b, c {{$name}}
+			c.Set(a)
+			{{- if eq .Degree 2}}
+			b.Frobenius(a)
+			c.Mul(&c, &b)
+			{{- else}}
+			b.Frobenius(a)
+			c.Mul(&c, &b)
+			b.FrobeniusSquare(a)
+			c.Mul(&c, &b)
+			{{- end}}
+			n := a.Norm()
+			b.SetZero()
+			b.{{$c0}} = n
+			return b.Equal(&c)
+		},
+		genA,
+	))
+	{{- else}}
+
+	properties.Property("[{{$name}}] norm should be x^(1+q+...+q^{{sub .Degree 1}}), q being the order of {{$elem}}", prop.ForAll(
+		func(a *{{$name}}) bool {
+			var b, c {{$name}}
+			e, _ := new(big.Int).SetString("{{.NormExponent}}", 10)
+			c.Exp(*a, e)
+			n := a.Norm()
+			b.{{$c0}} = n
+			return b.Equal(&c)
+		},
+		genA,
+	))
+	{{- end}}
+
+	properties.Property("[{{$name}}] exp by a negative exponent should match the inverse of exp", prop.ForAll(
+		func(a *{{$name}}, k int64) bool {
+			var b, c {{$name}}
+			e := big.NewInt(k)
+			b.Exp(*a, e)
+			c.Exp(*a, e.Neg(e)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(int64(genParams.NextUint64()>>1), gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[{{$name}}] exp should be a morphism", prop.ForAll(
+		func(a *{{$name}}, k, l uint32) bool {
+			var b, c, d {{$name}}
+			b.Exp(*a, new(big.Int).SetUint64(uint64(k)))
+			c.Exp(*a, new(big.Int).SetUint64(uint64(l)))
+			b.Mul(&b, &c)
+			d.Exp(*a, new(big.Int).SetUint64(uint64(k)+uint64(l)))
+			return b.Equal(&d)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}).Map(func(v uint64) uint32 { return uint32(v) }),
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}).Map(func(v uint64) uint32 { return uint32(v) }),
+	))
+
+	properties.Property("[{{$name}}] select should pick the right operand", prop.ForAll(
+		func(a, b *{{$name}}) bool {
+			var c, d {{$name}}
+			c.Select(0, a, b)
+			d.Select(1, a, b)
+			return c.Equal(a) && d.Equal(b)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestBatchInvert{{$name}}(t *testing.T) {
+	const n = 10
+	a := make([]{{$name}}, n)
+	for i := 0; i < n; i++ {
+		if i == n/2 {
+			continue // test the handling of zeroes
+		}
+		if _, err := a[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res := BatchInvert{{$name}}(a)
+
+	for i := 0; i < n; i++ {
+		var expected {{$name}}
+		expected.Inverse(&a[i])
+		if !res[i].Equal(&expected) {
+			t.Fatalf("BatchInvert{{$name}}: wrong inverse at index %d", i)
+		}
+	}
+}
+
+func Test{{$vector}}Ops(t *testing.T) {
+	const n = 10
+	a, b := random{{$vector}}(t, n), random{{$vector}}(t, n)
+	var s {{$name}}
+	if _, err := s.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	var e {{.ElementType}}
+	if _, err := e.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, diff, prod, scaled, scaledByElement := make({{$vector}}, n), make({{$vector}}, n), make({{$vector}}, n), make({{$vector}}, n), make({{$vector}}, n)
+	sum.Add(a, b)
+	diff.Sub(a, b)
+	prod.Mul(a, b)
+	scaled.ScalarMul(a, &s)
+	scaledByElement.MulByElement(a, &e)
+
+	var expectedSum, expectedInnerProduct {{$name}}
+	for i := 0; i < n; i++ {
+		var tmp {{$name}}
+		if !sum[i].Equal(tmp.Add(&a[i], &b[i])) {
+			t.Fatalf("{{$vector}}.Add: wrong result at index %d", i)
+		}
+		if !diff[i].Equal(tmp.Sub(&a[i], &b[i])) {
+			t.Fatalf("{{$vector}}.Sub: wrong result at index %d", i)
+		}
+		if !prod[i].Equal(tmp.Mul(&a[i], &b[i])) {
+			t.Fatalf("{{$vector}}.Mul: wrong result at index %d", i)
+		}
+		if !scaled[i].Equal(tmp.Mul(&a[i], &s)) {
+			t.Fatalf("{{$vector}}.ScalarMul: wrong result at index %d", i)
+		}
+		if !scaledByElement[i].Equal(tmp.MulByElement(&a[i], &e)) {
+			t.Fatalf("{{$vector}}.MulByElement: wrong result at index %d", i)
+		}
+		expectedSum.Add(&expectedSum, &a[i])
+		expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+	}
+
+	if s := a.Sum(); !s.Equal(&expectedSum) {
+		t.Fatal("{{$vector}}.Sum: wrong result")
+	}
+	if ip := a.InnerProduct(b); !ip.Equal(&expectedInnerProduct) {
+		t.Fatal("{{$vector}}.InnerProduct: wrong result")
+	}
+	if a.Len() != n {
+		t.Fatal("{{$vector}}.Len: wrong result")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func Benchmark{{$name}}Mul(b *testing.B) {
+	var x, y {{$name}}
+	_, _ = x.SetRandom()
+	_, _ = y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func Benchmark{{$name}}Square(b *testing.B) {
+	var x {{$name}}
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func Benchmark{{$name}}Inverse(b *testing.B) {
+	var x {{$name}}
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+// ------------------------------------------------------------
+// helpers
+
+func gen{{$name}}() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var z {{$name}}
+		{{- range .Leaves}}
+		z.{{.}}.SetUint64(genParams.NextUint64())
+		{{- end}}
+		return gopter.NewGenResult(&z, gopter.NoShrinker)
+	}
+}
+
+func random{{$vector}}(t *testing.T, n int) {{$vector}} {
+	v := make({{$vector}}, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return v
+}
+
+// mul{{$name}}Schoolbook is a reference multiplication, reducing the schoolbook
+// product of the polynomials x and y modulo {{.Polynomial}}
+func mul{{$name}}Schoolbook(x, y *{{$name}}) {{$name}} {
+	a := [{{.Degree}}]{{$elem}}{ {{- range $i, $c := .Coords}}{{if $i}}, {{end}}x.{{$c}}{{end}} }
+	b := [{{.Degree}}]{{$elem}}{ {{- range $i, $c := .Coords}}{{if $i}}, {{end}}y.{{$c}}{{end}} }
+	var c [2*{{.Degree}} - 1]{{$elem}}
+	for i := 0; i < {{.Degree}}; i++ {
+		for j := 0; j < {{.Degree}}; j++ {
+			var t {{$elem}}
+			t.Mul(&a[i], &b[j])
+			c[i+j].Add(&c[i+j], &t)
+		}
+	}
+	for i := 2*{{.Degree}} - 2; i >= {{.Degree}}; i-- {
+		var t {{$elem}}
+		t.Mul(&c[i], &nonResidue{{$name}})
+		c[i-{{.Degree}}].Add(&c[i-{{.Degree}}], &t)
+	}
+	return {{$name}}{ {{- range $i, $c := .Coords}}{{if $i}}, {{end}}{{$c}}: c[{{$i}}]{{end}} }
+}
+`
+
+// TestsCommon holds the helpers shared by the tests of all the extensions
+const TestsCommon = `
+import (
+	"{{.FieldPackagePath}}"
+	"github.com/leanovate/gopter"
+)
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 50
+)
+
+func genElement() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e {{.ElementType}}
+		e.SetUint64(genParams.NextUint64())
+		return gopter.NewGenResult(e, gopter.NoShrinker)
+	}
+}
+`
+
+// Doc is the package documentation of the extensions
+const Doc = `
+// Package {{.PackageName}} provides field extensions of {{.FieldPackageName}}.{{.ElementName}}:
+{{- range .Extensions}}
+//   - {{.Name}} = {{.BaseName}}[{{.Var}}]/({{.Polynomial}})
+{{- end}}
+//
+{{- if .Tower}}
+// The extensions form a tower: each one is obtained by adjoining a root of an
+// irreducible binomial to the base field, or to the previous extension.
+{{- else}}
+// The extensions are obtained by adjoining a root of an irreducible binomial
+// to the base field, which is what protocols such as FRI or sumcheck over a small
+// field need to sample challenges with enough entropy.
+{{- end}}
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package {{.PackageName}}
+`
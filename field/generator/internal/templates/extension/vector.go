@@ -0,0 +1,93 @@
+package extension
+
+// Vector is appended to Base, from which it gets its imports
+const Vector = `
+{{ $name := .Name -}}
+{{ $vector := print "Vector" .Name -}}
+
+// {{$vector}} represents a slice of {{$name}}.
+type {{$vector}} []{{$name}}
+
+// Add adds two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *{{$vector}}) Add(a, b {{$vector}}) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Add(&a[i], &b[i])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *{{$vector}}) Sub(a, b {{$vector}}) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Sub(&a[i], &b[i])
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *{{$vector}}) Mul(a, b {{$vector}}) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *{{$vector}}) ScalarMul(a {{$vector}}, b *{{$name}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], b)
+	}
+}
+
+// MulByElement multiplies a vector by an element of the base field element-wise
+// and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *{{$vector}}) MulByElement(a {{$vector}}, b *{{.ElementType}}) {
+	if len(a) != len(*vector) {
+		panic("vector.MulByElement: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].MulByElement(&a[i], b)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *{{$vector}}) Sum() (res {{$name}}) {
+	for i := 0; i < len(*vector); i++ {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *{{$vector}}) InnerProduct(other {{$vector}}) (res {{$name}}) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp {{$name}}
+	for i := 0; i < len(*vector); i++ {
+		tmp.Mul(&(*vector)[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+
+// Len is the number of elements in the collection.
+func (vector {{$vector}}) Len() int {
+	return len(vector)
+}
+`
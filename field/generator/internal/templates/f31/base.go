@@ -0,0 +1,969 @@
+package f31
+
+// Base is the source of a field element on a single 32-bit word, for moduli of at most 31 bits.
+//
+// Elements are in Montgomery form, with r = 2³²; since q < 2³¹, sums of two elements don't overflow
+// a uint32, and products of two elements (plus the Montgomery correction m⋅q) don't overflow a uint64.
+const Base = `
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/consensys/gnark-crypto/field/hash"
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// {{.ElementName}} represents a field element stored on 1 word (uint32)
+//
+// {{.ElementName}} are assumed to be in Montgomery form in all methods.
+//
+// Modulus q =
+//
+// 	q[base10] = {{.Modulus}}
+// 	q[base16] = 0x{{.ModulusHex}}
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// The methods with a CT suffix (InverseCT, ExpCT, ...) run in constant time with respect to their
+// inputs, and should be used instead of their variable-time counterparts on secret values.
+type {{.ElementName}} [1]uint32
+
+const (
+	Limbs = 1  // number of 32 bits words needed to represent a {{.ElementName}}
+	Bits  = {{.NbBits}} // number of bits needed to represent a {{.ElementName}}
+	Bytes = {{.NbBytes}} // number of bytes needed to represent a {{.ElementName}}
+)
+
+// Field modulus q
+const (
+	q0 uint32 = {{index .Q 0}}
+	q  uint32 = q0
+)
+
+var q{{.ElementName}} = {{.ElementName}}{
+	q0,
+}
+
+var _modulus big.Int // q stored as big.Int
+
+// Modulus returns q as a big.Int
+//
+// 	q[base10] = {{.Modulus}}
+// 	q[base16] = 0x{{.ModulusHex}}
+func Modulus() *big.Int {
+	return new(big.Int).Set(&_modulus)
+}
+
+// q + r'.r = 1, i.e., qInvNeg = - q⁻¹ mod r
+// used for Montgomery reduction
+const qInvNeg uint32 = {{index .QInverse 0}}
+
+func init() {
+	_modulus.SetString("{{.ModulusHex}}", 16)
+}
+
+// New{{.ElementName}} returns a new {{.ElementName}} from a uint64 value
+//
+// it is equivalent to
+// 		var v {{.ElementName}}
+// 		v.SetUint64(...)
+func New{{.ElementName}}(v uint64) {{.ElementName}} {
+	var z {{.ElementName}}
+	z.SetUint64(v)
+	return z
+}
+
+// SetUint64 sets z to v and returns z
+func (z *{{.ElementName}}) SetUint64(v uint64) *{{.ElementName}} {
+	//  sets z to v mod q (non-Montgomery form) and convert z to Montgomery form
+	*z = {{.ElementName}}{uint32(v % uint64(q))}
+	return z.Mul(z, &rSquare) // z.toMont()
+}
+
+// SetInt64 sets z to v and returns z
+func (z *{{.ElementName}}) SetInt64(v int64) *{{.ElementName}} {
+
+	// absolute value of v
+	m := v >> 63
+	z.SetUint64(uint64((v ^ m) - m))
+
+	if m != 0 {
+		// v is negative
+		z.Neg(z)
+	}
+
+	return z
+}
+
+// Set z = x and returns z
+func (z *{{.ElementName}}) Set(x *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = x[0]
+	return z
+}
+
+// SetInterface converts provided interface into {{.ElementName}}
+// returns an error if provided type is not supported
+// supported types:
+//	{{.ElementName}}
+//	*{{.ElementName}}
+//	uint64
+//	int
+//	string (see SetString for valid formats)
+//	*big.Int
+//	big.Int
+//	[]byte
+func (z *{{.ElementName}}) SetInterface(i1 interface{}) (*{{.ElementName}}, error) {
+	if i1 == nil {
+		return nil, errors.New("can't set {{.PackageName}}.{{.ElementName}} with <nil>")
+	}
+
+	switch c1 := i1.(type) {
+	case {{.ElementName}}:
+		return z.Set(&c1), nil
+	case *{{.ElementName}}:
+		if c1 == nil {
+			return nil, errors.New("can't set {{.PackageName}}.{{.ElementName}} with <nil>")
+		}
+		return z.Set(c1), nil
+	case uint8:
+		return z.SetUint64(uint64(c1)), nil
+	case uint16:
+		return z.SetUint64(uint64(c1)), nil
+	case uint32:
+		return z.SetUint64(uint64(c1)), nil
+	case uint:
+		return z.SetUint64(uint64(c1)), nil
+	case uint64:
+		return z.SetUint64(c1), nil
+	case int8:
+		return z.SetInt64(int64(c1)), nil
+	case int16:
+		return z.SetInt64(int64(c1)), nil
+	case int32:
+		return z.SetInt64(int64(c1)), nil
+	case int64:
+		return z.SetInt64(c1), nil
+	case int:
+		return z.SetInt64(int64(c1)), nil
+	case string:
+		return z.SetString(c1)
+	case *big.Int:
+		if c1 == nil {
+			return nil, errors.New("can't set {{.PackageName}}.{{.ElementName}} with <nil>")
+		}
+		return z.SetBigInt(c1), nil
+	case big.Int:
+		return z.SetBigInt(&c1), nil
+	case []byte:
+		return z.SetBytes(c1), nil
+	default:
+		return nil, errors.New("can't set {{.PackageName}}.{{.ElementName}} from type " + reflect.TypeOf(i1).String())
+	}
+}
+
+// SetZero z = 0
+func (z *{{.ElementName}}) SetZero() *{{.ElementName}} {
+	z[0] = 0
+	return z
+}
+
+// SetOne z = 1 (in Montgomery form)
+func (z *{{.ElementName}}) SetOne() *{{.ElementName}} {
+	z[0] = {{index .One 0}}
+	return z
+}
+
+// Div z = x*y⁻¹ (mod q)
+func (z *{{.ElementName}}) Div(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var yInv {{.ElementName}}
+	yInv.Inverse(y)
+	z.Mul(x, &yInv)
+	return z
+}
+
+// Equal returns z == x; constant-time
+func (z *{{.ElementName}}) Equal(x *{{.ElementName}}) bool {
+	return z.NotEqual(x) == 0
+}
+
+// NotEqual returns 0 if and only if z == x; constant-time
+func (z *{{.ElementName}}) NotEqual(x *{{.ElementName}}) uint64 {
+	return uint64(z[0] ^ x[0])
+}
+
+// IsZero returns z == 0
+func (z *{{.ElementName}}) IsZero() bool {
+	return z[0] == 0
+}
+
+// IsOne returns z == 1
+func (z *{{.ElementName}}) IsOne() bool {
+	return z[0] == {{index .One 0}}
+}
+
+// IsUint64 reports whether z can be represented as an uint64.
+func (z *{{.ElementName}}) IsUint64() bool {
+	return true
+}
+
+// Uint64 returns the uint64 representation of x.
+func (z *{{.ElementName}}) Uint64() uint64 {
+	return z.Bits()[0]
+}
+
+// FitsOnOneWord reports whether z words (except the least significant word) are 0
+//
+// It is the responsibility of the caller to convert from Montgomery to Regular form if needed.
+func (z *{{.ElementName}}) FitsOnOneWord() bool {
+	return true
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//   -1 if z <  x
+//    0 if z == x
+//   +1 if z >  x
+//
+func (z *{{.ElementName}}) Cmp(x *{{.ElementName}}) int {
+	_z := z.Bits()
+	_x := x.Bits()
+	if _z[0] > _x[0] {
+		return 1
+	} else if _z[0] < _x[0] {
+		return -1
+	}
+	return 0
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *{{.ElementName}}) LexicographicallyLargest() bool {
+	// we check if the element is larger than (q-1) / 2
+	_z := z.Bits()
+	return _z[0] >= {{index .QMinusOneHalvedP 0}}
+}
+
+// SetRandom sets z to a uniform random value in [0, q).
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *{{.ElementName}}) SetRandom() (*{{.ElementName}}, error) {
+	// this code is generated for all modulus
+	// and derived from go/src/crypto/rand/util.go
+
+	// bitLen is the maximum bit length needed to encode a value < q.
+	const bitLen = {{.NbBits}}
+
+	var bytes [4]byte
+
+	for {
+		if _, err := io.ReadFull(rand.Reader, bytes[:]); err != nil {
+			return nil, err
+		}
+
+		// Clear unused bits to increase probability that the candidate is < q.
+		z[0] = binary.LittleEndian.Uint32(bytes[:]) & (1<<bitLen - 1)
+
+		if !z.smallerThanModulus() {
+			continue // ignore the candidate and re-sample
+		}
+
+		return z, nil
+	}
+}
+
+// smallerThanModulus returns true if z < q
+// This is not constant time
+func (z *{{.ElementName}}) smallerThanModulus() bool {
+	return z[0] < q
+}
+
+// One returns 1
+func One() {{.ElementName}} {
+	var one {{.ElementName}}
+	one.SetOne()
+	return one
+}
+
+// Halve sets z to z / 2 (mod q)
+func (z *{{.ElementName}}) Halve() {
+	if z[0]&1 == 1 {
+		// z = z + q; since q < 2³¹ there is no carry
+		z[0] += q
+	}
+	// z = z >> 1
+	z[0] >>= 1
+}
+
+// fromMont converts z in place (i.e. mutates) from Montgomery to regular representation
+// sets and returns z = z * 1
+func (z *{{.ElementName}}) fromMont() *{{.ElementName}} {
+	fromMont(z)
+	return z
+}
+
+// Add z = x + y (mod q)
+func (z *{{.ElementName}}) Add(x, y *{{.ElementName}}) *{{.ElementName}} {
+	// x + y < 2q < 2³², there is no carry
+	z[0] = x[0] + y[0]
+	if z[0] >= q {
+		z[0] -= q
+	}
+	return z
+}
+
+// Double z = x + x (mod q), aka Lsh 1
+func (z *{{.ElementName}}) Double(x *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = x[0] << 1
+	if z[0] >= q {
+		z[0] -= q
+	}
+	return z
+}
+
+// Sub z = x - y (mod q)
+func (z *{{.ElementName}}) Sub(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b uint32
+	z[0], b = bits.Sub32(x[0], y[0], 0)
+	if b != 0 {
+		z[0] += q
+	}
+	return z
+}
+
+// Neg z = q - x
+func (z *{{.ElementName}}) Neg(x *{{.ElementName}}) *{{.ElementName}} {
+	if x.IsZero() {
+		z.SetZero()
+		return z
+	}
+	z[0] = q - x[0]
+	return z
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *{{.ElementName}}) Select(c int, x0 *{{.ElementName}}, x1 *{{.ElementName}}) *{{.ElementName}} {
+	cC := uint32((int64(c) | -int64(c)) >> 63) // "canonicized" into: 0 if c=0, -1 otherwise
+	z[0] = x0[0] ^ cC&(x0[0]^x1[0])
+	return z
+}
+
+// Mul z = x * y (mod q)
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = montReduce(uint64(x[0]) * uint64(y[0]))
+	return z
+}
+
+// Square z = x * x (mod q)
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = montReduce(uint64(x[0]) * uint64(x[0]))
+	return z
+}
+
+// montReduce returns v⋅r⁻¹ (mod q), for v < 2q²
+func montReduce(v uint64) uint32 {
+	// textbook Montgomery reduction (REDC):
+	// m = (v * qInvNeg) mod r, then (v + m * q) / r < v / r + q < 2q.
+	// v + m * q < 2q² + r * q < 2⁶⁴, there is no overflow.
+	m := uint32(v) * qInvNeg
+	t := uint32((v + uint64(m)*uint64(q)) >> 32)
+	if t >= q {
+		t -= q
+	}
+	return t
+}
+
+// MulBy3 x *= 3 (mod q)
+func MulBy3(x *{{.ElementName}}) {
+	var y {{.ElementName}}
+	y.Double(x)
+	x.Add(x, &y)
+}
+
+// MulBy5 x *= 5 (mod q)
+func MulBy5(x *{{.ElementName}}) {
+	var y {{.ElementName}}
+	y.Double(x).Double(&y)
+	x.Add(x, &y)
+}
+
+// MulBy13 x *= 13 (mod q)
+func MulBy13(x *{{.ElementName}}) {
+	var y {{.ElementName}}
+	y.SetUint64(13)
+	x.Mul(x, &y)
+}
+
+// Butterfly sets
+//
+//	a = a + b (mod q)
+//	b = a - b (mod q)
+func Butterfly(a, b *{{.ElementName}}) {
+	t := *a
+	a.Add(a, b)
+	b.Sub(&t, b)
+}
+
+func fromMont(z *{{.ElementName}}) {
+	z[0] = montReduce(uint64(z[0]))
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+func BatchInvert(a []{{.ElementName}}) []{{.ElementName}} {
+	res := make([]{{.ElementName}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := bitset.New(uint(len(a)))
+	accumulator := One()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes.Set(uint(i))
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes.Test(uint(i)) {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// BitLen returns the minimum number of bits needed to represent z
+// returns 0 if z == 0
+func (z *{{.ElementName}}) BitLen() int {
+	return bits.Len32(z[0])
+}
+
+// Hash msg to count prime field elements.
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
+func Hash(msg, dst []byte, count int) ([]{{.ElementName}}, error) {
+	// 128 bits of security
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
+	const Bytes = 1 + (Bits-1)/8
+	const L = 16 + Bytes
+
+	lenInBytes := count * L
+	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, lenInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// get temporary big int from the pool
+	vv := pool.BigInt.Get()
+
+	res := make([]{{.ElementName}}, count)
+	for i := 0; i < count; i++ {
+		vv.SetBytes(pseudoRandomBytes[i*L : (i+1)*L])
+		res[i].SetBigInt(vv)
+	}
+
+	// release object into pool
+	pool.BigInt.Put(vv)
+
+	return res, nil
+}
+
+// Exp z = xᵏ (mod q)
+func (z *{{.ElementName}}) Exp(x {{.ElementName}}, k *big.Int) *{{.ElementName}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// expByUint64 sets z = xᵉ (mod q) and returns z; e is public, it is used for
+// the fixed exponents of the field (Legendre symbol, square root, inverse)
+func (z *{{.ElementName}}) expByUint64(x {{.ElementName}}, e uint64) *{{.ElementName}} {
+	z.SetOne()
+	for i := bits.Len64(e) - 1; i >= 0; i-- {
+		z.Square(z)
+		if (e>>uint(i))&1 == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// rSquare where r is the Montgommery constant
+// see section 2.3.2 of Tolga Acar's thesis
+// https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
+var rSquare = {{.ElementName}}{
+	{{index .RSquare 0}},
+}
+
+// toMont converts z to Montgomery form
+// sets and returns z = z * r²
+func (z *{{.ElementName}}) toMont() *{{.ElementName}} {
+	return z.Mul(z, &rSquare)
+}
+
+// String returns the decimal representation of z as generated by
+// z.Text(10).
+func (z *{{.ElementName}}) String() string {
+	return z.Text(10)
+}
+
+// toBigInt returns z as a big.Int in Montgomery form
+func (z *{{.ElementName}}) toBigInt(res *big.Int) *big.Int {
+	return res.SetUint64(uint64(z[0]))
+}
+
+// Text returns the string representation of z in the given base.
+// Base must be between 2 and 36, inclusive. The result uses the
+// lower-case letters 'a' to 'z' for digit values 10 to 35.
+// No prefix (such as "0x") is added to the string. If z is a nil
+// pointer it returns "<nil>".
+{{- $noNeg := ltu64 (index $.Q 0) 1000000}}
+{{- if not $noNeg}}
+// If base == 10 and -z fits in a uint16 prefix "-" is added to the string.
+{{- end}}
+func (z *{{.ElementName}}) Text(base int) string {
+	if base < 2 || base > 36 {
+		panic("invalid base")
+	}
+	if z == nil {
+		return "<nil>"
+	}
+
+	{{- if not $noNeg}}
+
+	const maxUint16 = 65535
+	if base == 10 {
+		var zzNeg {{.ElementName}}
+		zzNeg.Neg(z)
+		zzNeg.fromMont()
+		if zzNeg[0] <= maxUint16 && zzNeg[0] != 0 {
+			return "-" + strconv.FormatUint(uint64(zzNeg[0]), base)
+		}
+	}
+	{{- end}}
+	zz := z.Bits()
+	return strconv.FormatUint(zz[0], base)
+}
+
+// BigInt sets and return z as a *big.Int
+func (z *{{.ElementName}}) BigInt(res *big.Int) *big.Int {
+	_z := *z
+	_z.fromMont()
+	return _z.toBigInt(res)
+}
+
+// ToBigIntRegular returns z as a big.Int in regular form
+//
+// Deprecated: use BigInt(*big.Int) instead
+func (z {{.ElementName}}) ToBigIntRegular(res *big.Int) *big.Int {
+	z.fromMont()
+	return z.toBigInt(res)
+}
+
+// Bits provides access to z by returning its value as a little-endian [1]uint64 array.
+// Bits is intended to support implementation of missing low-level {{.ElementName}}
+// functionality outside this package; it should be avoided otherwise.
+func (z *{{.ElementName}}) Bits() [1]uint64 {
+	_z := *z
+	fromMont(&_z)
+	return [1]uint64{uint64(_z[0])}
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *{{.ElementName}}) Bytes() (res [Bytes]byte) {
+	BigEndian.PutElement(&res, *z)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice
+func (z *{{.ElementName}}) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian unsigned integer,
+// sets z to that value, and returns z.
+func (z *{{.ElementName}}) SetBytes(e []byte) *{{.ElementName}} {
+	if len(e) == Bytes {
+		// fast path
+		v, err := BigEndian.Element((*[Bytes]byte)(e))
+		if err == nil {
+			*z = v
+			return z
+		}
+	}
+
+	// slow path.
+	// get a big int from our pool
+	vv := pool.BigInt.Get()
+	vv.SetBytes(e)
+
+	// set big int
+	z.SetBigInt(vv)
+
+	// put temporary object back in pool
+	pool.BigInt.Put(vv)
+
+	return z
+}
+
+// SetBytesCanonical interprets e as the bytes of a big-endian {{.NbBytes}}-byte integer.
+// If e is not a {{.NbBytes}}-byte slice or encodes a value higher than q,
+// SetBytesCanonical returns an error.
+func (z *{{.ElementName}}) SetBytesCanonical(e []byte) error {
+	if len(e) != Bytes {
+		return errors.New("invalid {{.PackageName}}.{{.ElementName}} encoding")
+	}
+	v, err := BigEndian.Element((*[Bytes]byte)(e))
+	if err != nil {
+		return err
+	}
+	*z = v
+	return nil
+}
+
+// SetBigInt sets z to v and returns z
+func (z *{{.ElementName}}) SetBigInt(v *big.Int) *{{.ElementName}} {
+	z.SetZero()
+
+	var zero big.Int
+
+	// fast path
+	c := v.Cmp(&_modulus)
+	if c == 0 {
+		// v == 0
+		return z
+	} else if c != 1 && v.Cmp(&zero) != -1 {
+		// 0 < v < q
+		return z.setBigInt(v)
+	}
+
+	// get temporary big int from the pool
+	vv := pool.BigInt.Get()
+
+	// copy input + modular reduction
+	vv.Mod(v, &_modulus)
+
+	// set big int byte value
+	z.setBigInt(vv)
+
+	// release object into pool
+	pool.BigInt.Put(vv)
+	return z
+}
+
+// setBigInt assumes 0 ⩽ v < q
+func (z *{{.ElementName}}) setBigInt(v *big.Int) *{{.ElementName}} {
+	z[0] = uint32(v.Uint64())
+	return z.toMont()
+}
+
+// SetString creates a big.Int with number and calls SetBigInt on z
+//
+// The number prefix determines the actual base: A prefix of
+// ”0b” or ”0B” selects base 2, ”0”, ”0o” or ”0O” selects base 8,
+// and ”0x” or ”0X” selects base 16. Otherwise, the selected base is 10
+// and no prefix is accepted.
+//
+// For base 16, lower and upper case letters are considered the same:
+// The letters 'a' to 'f' and 'A' to 'F' represent digit values 10 to 15.
+//
+// An underscore character ”_” may appear between a base
+// prefix and an adjacent digit, and between successive digits; such
+// underscores do not change the value of the number.
+// Incorrect placement of underscores is reported as a panic if there
+// are no other errors.
+//
+// If the number is invalid this method leaves z unchanged and returns nil, error.
+func (z *{{.ElementName}}) SetString(number string) (*{{.ElementName}}, error) {
+	// get temporary big int from the pool
+	vv := pool.BigInt.Get()
+
+	if _, ok := vv.SetString(number, 0); !ok {
+		return nil, errors.New("{{.ElementName}}.SetString failed -> can't parse number into a big.Int " + number)
+	}
+
+	z.SetBigInt(vv)
+
+	// release object into pool
+	pool.BigInt.Put(vv)
+
+	return z, nil
+}
+
+// MarshalJSON returns json encoding of z (z.Text(10))
+// If z == nil, returns null
+func (z *{{.ElementName}}) MarshalJSON() ([]byte, error) {
+	if z == nil {
+		return []byte("null"), nil
+	}
+	const maxSafeBound = 15 // we encode it as number if it's small
+	s := z.Text(10)
+	if len(s) <= maxSafeBound {
+		return []byte(s), nil
+	}
+	var sbb strings.Builder
+	sbb.WriteByte('"')
+	sbb.WriteString(s)
+	sbb.WriteByte('"')
+	return []byte(sbb.String()), nil
+}
+
+// UnmarshalJSON accepts numbers and strings as input
+// See {{.ElementName}}.SetString for valid prefixes (0x, 0b, ...)
+func (z *{{.ElementName}}) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) > Bits*3 {
+		return errors.New("value too large (max = {{.ElementName}}.Bits * 3)")
+	}
+
+	// we accept numbers and strings, remove leading and trailing quotes if any
+	if len(s) > 0 && s[0] == '"' {
+		s = s[1:]
+	}
+	if len(s) > 0 && s[len(s)-1] == '"' {
+		s = s[:len(s)-1]
+	}
+
+	// get temporary big int from the pool
+	vv := pool.BigInt.Get()
+
+	if _, ok := vv.SetString(s, 0); !ok {
+		return errors.New("can't parse into a big.Int: " + s)
+	}
+
+	z.SetBigInt(vv)
+
+	// release object into pool
+	pool.BigInt.Put(vv)
+	return nil
+}
+
+// A ByteOrder specifies how to convert byte slices into a {{.ElementName}}
+type ByteOrder interface {
+	Element(*[Bytes]byte) ({{.ElementName}}, error)
+	PutElement(*[Bytes]byte, {{.ElementName}})
+	String() string
+}
+
+// BigEndian is the big-endian implementation of ByteOrder and AppendByteOrder.
+var BigEndian bigEndian
+
+type bigEndian struct{}
+
+// Element interpret b is a big-endian {{.NbBytes}}-byte slice.
+// If b encodes a value higher than q, Element returns error.
+func (bigEndian) Element(b *[Bytes]byte) ({{.ElementName}}, error) {
+	var z {{.ElementName}}
+	z[0] = binary.BigEndian.Uint32((*b)[0:4])
+
+	if !z.smallerThanModulus() {
+		return {{.ElementName}}{}, errors.New("invalid {{.PackageName}}.{{.ElementName}} encoding")
+	}
+
+	z.toMont()
+	return z, nil
+}
+
+func (bigEndian) PutElement(b *[Bytes]byte, e {{.ElementName}}) {
+	e.fromMont()
+	binary.BigEndian.PutUint32((*b)[0:4], e[0])
+}
+
+func (bigEndian) String() string { return "BigEndian" }
+
+// LittleEndian is the little-endian implementation of ByteOrder and AppendByteOrder.
+var LittleEndian littleEndian
+
+type littleEndian struct{}
+
+func (littleEndian) Element(b *[Bytes]byte) ({{.ElementName}}, error) {
+	var z {{.ElementName}}
+	z[0] = binary.LittleEndian.Uint32((*b)[0:4])
+
+	if !z.smallerThanModulus() {
+		return {{.ElementName}}{}, errors.New("invalid {{.PackageName}}.{{.ElementName}} encoding")
+	}
+
+	z.toMont()
+	return z, nil
+}
+
+func (littleEndian) PutElement(b *[Bytes]byte, e {{.ElementName}}) {
+	e.fromMont()
+	binary.LittleEndian.PutUint32((*b)[0:4], e[0])
+}
+
+func (littleEndian) String() string { return "LittleEndian" }
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *{{.ElementName}}) Legendre() int {
+	var l {{.ElementName}}
+	// z^((q-1)/2)
+	l.expByUint64(*z, 0x{{.LegendreExponent}})
+
+	if l.IsZero() {
+		return 0
+	}
+
+	// if l == 1
+	if l.IsOne() {
+		return 1
+	}
+	return -1
+}
+
+// Sqrt z = √x (mod q)
+// if the square root doesn't exist (x is not a square mod q)
+// Sqrt leaves z unchanged and returns nil
+func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .SqrtQ3Mod4}}
+	// q ≡ 3 (mod 4)
+	// using  z ≡ ± x^((p+1)/4) (mod q)
+	var y, square {{.ElementName}}
+	y.expByUint64(*x, 0x{{.SqrtQ3Mod4Exponent}})
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	square.Square(&y)
+	if square.Equal(x) {
+		return z.Set(&y)
+	}
+	return nil
+	{{- else if .SqrtAtkin}}
+	// q ≡ 5 (mod 8)
+	// see modSqrt5Mod8Prime in math/big/int.go
+	var one, alpha, beta, tx, square {{.ElementName}}
+	one.SetOne()
+	tx.Double(x)
+	alpha.expByUint64(tx, 0x{{.SqrtAtkinExponent}})
+	beta.Square(&alpha).
+		Mul(&beta, &tx).
+		Sub(&beta, &one).
+		Mul(&beta, x).
+		Mul(&beta, &alpha)
+
+	// as we didn't compute the legendre symbol, ensure we found beta such that beta * beta = x
+	square.Square(&beta)
+	if square.Equal(x) {
+		return z.Set(&beta)
+	}
+	return nil
+	{{- else if .SqrtTonelliShanks}}
+	// q ≡ 1 (mod 4)
+	// see modSqrtTonelliShanks in math/big/int.go
+	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+
+	var y, b, t, w {{.ElementName}}
+	// w = x^((s-1)/2))
+	w.expByUint64(*x, 0x{{.SqrtSMinusOneOver2}})
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	// g = nonResidue ^ s
+	var g = {{.ElementName}}{
+		{{index .SqrtG 0}},
+	}
+	r := uint64({{.SqrtE}})
+
+	// compute legendre symbol
+	// t = x^((q-1)/2) = r-1 squaring of xˢ
+	t = b
+	for i := uint64(0); i < r-1; i++ {
+		t.Square(&t)
+	}
+	if t.IsZero() {
+		return z.SetZero()
+	}
+	if !t.IsOne() {
+		// t != 1, we don't have a square root
+		return nil
+	}
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1)) (mod q)
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+	{{- else}}
+	panic("not implemented")
+	{{- end}}
+}
+
+// Inverse z = x⁻¹ (mod q)
+//
+// if x == 0, sets and returns z = x
+func (z *{{.ElementName}}) Inverse(x *{{.ElementName}}) *{{.ElementName}} {
+	// x⁻¹ = x^(q-2) (mod q); on a single 32-bit word, the exponentiation is
+	// competitive with a binary extended GCD
+	if x.IsZero() {
+		z.SetZero()
+		return z
+	}
+	return z.expByUint64(*x, uint64(q-2))
+}
+`
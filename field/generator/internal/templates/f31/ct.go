@@ -0,0 +1,139 @@
+package f31
+
+// CT is the constant-time API of a field element on a single 32-bit word; the products are
+// reduced with montReduceBranchless.
+const CT = `
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"math/big"
+)
+
+// InverseCT z = x⁻¹ (mod q) and returns z, or z = 0 if x == 0
+//
+// It computes x^(q-2) in constant time with respect to x; it is much slower than Inverse
+// and should be used when x is secret.
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.expCT(x, q-2)
+}
+
+// ExpCT z = xᵏ (mod q) and returns z
+//
+// It runs in constant time with respect to x and |k|: all the bits of a Bytes bytes exponent
+// are processed, whatever its bit length. If k is negative, x is inverted with InverseCT; the
+// sign of k is not secret. It panics if |k| ⩾ 2^(8⋅Bytes).
+func (z *{{.ElementName}}) ExpCT(x {{.ElementName}}, k *big.Int) *{{.ElementName}} {
+	var abs big.Int
+	abs.Abs(k)
+	var buf [Bytes]byte
+	abs.FillBytes(buf[:])
+	if k.Sign() == -1 {
+		x.InverseCT(&x)
+	}
+	return z.expCT(&x, binary.BigEndian.Uint32(buf[:]))
+}
+
+// expCT z = xᵉ (mod q) with a fixed 4-bit window: all the bits of e are processed, and the
+// window is read from the table with lookupCT.
+func (z *{{.ElementName}}) expCT(x *{{.ElementName}}, e uint32) *{{.ElementName}} {
+	var table [16]{{.ElementName}}
+	table[0].SetOne()
+	table[1] = *x
+	for i := 2; i < len(table); i++ {
+		table[i][0] = montReduceBranchless(uint64(table[i-1][0]) * uint64(x[0]))
+	}
+
+	var res, t {{.ElementName}}
+	res.SetOne()
+	for j := 28; j >= 0; j -= 4 {
+		for k := 0; k < 4; k++ {
+			res[0] = montReduceBranchless(uint64(res[0]) * uint64(res[0]))
+		}
+		t.lookupCT(&table, (e>>j)&0xf)
+		res[0] = montReduceBranchless(uint64(res[0]) * uint64(t[0]))
+	}
+	*z = res
+	return z
+}
+
+// lookupCT sets z = table[i], reading all the entries of the table
+func (z *{{.ElementName}}) lookupCT(table *[16]{{.ElementName}}, i uint32) {
+	z.SetZero()
+	for j := range table {
+		z.Select(subtle.ConstantTimeEq(int32(j), int32(i)), z, &table[j])
+	}
+}
+`
+
+// TestCT tests the constant-time API against the variable-time one
+const TestCT = `
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
+)
+
+func Test{{toTitle .ElementName}}InverseCT(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var z, expected {{.ElementName}}
+	z.InverseCT(&{{.ElementName}}{})
+	assert.True(z.IsZero(), "0⁻¹ should be 0")
+
+	for _, v := range []uint64{1, 2, uint64(q - 1), uint64(q - 2)} {
+		x := New{{.ElementName}}(v)
+		z.InverseCT(&x)
+		expected.Inverse(&x)
+		assert.True(z.Equal(&expected), "InverseCT and Inverse should match")
+	}
+}
+
+func Test{{toTitle .ElementName}}ExpCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT(x) == Inverse(x)", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.InverseCT(&a.element)
+			d.Inverse(&a.element)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("ExpCT(x, ±k) == Exp(x, ±k)", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var nb big.Int
+			nb.Neg(&b.bigint)
+			var c, d, e, f {{.ElementName}}
+			c.ExpCT(a.element, &b.bigint)
+			d.Exp(a.element, &b.bigint)
+			e.ExpCT(a.element, &nb)
+			f.Exp(a.element, &nb)
+			return c.Equal(&d) && e.Equal(&f)
+		},
+		genA, genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	assert := require.New(t)
+	var c {{.ElementName}}
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 8*Bytes)
+	assert.Panics(func() { c.ExpCT(One(), tooLarge) }, "ExpCT should panic on a too large exponent")
+}
+`
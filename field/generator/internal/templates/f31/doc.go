@@ -0,0 +1,45 @@
+package f31
+
+// Doc is the package documentation of a field on a single 32-bit word
+const Doc = `
+// Package {{.PackageName}} contains field arithmetic operations for modulus = 0x{{shorten .ModulusHex}}.
+//
+// The API is similar to math/big (big.Int), but the operations are significantly faster.
+//
+// The modulus is hardcoded in all the operations.
+//
+// The modulus fits on 31 bits: field elements are stored on a single 32-bit word, and assumed
+// to be in Montgomery form (with r = 2³²) in all methods:
+// 	type {{.ElementName}} [1]uint32
+//
+// Vector provides element-wise arithmetic (Add, Sub, Mul, ScalarMul) and reductions (Sum,
+// InnerProduct) on whole slices, with lazy and branch-free modular reductions.
+//
+// Usage
+//
+// Example API signature:
+// 	// Mul z = x * y (mod q)
+// 	func (z *Element) Mul(x, y *Element) *Element
+//
+// and can be used like so:
+// 	var a, b Element
+// 	a.SetUint64(2)
+// 	b.SetString("984896738")
+// 	a.Mul(a, b)
+// 	a.Sub(a, a)
+// 	 .Add(a, b)
+// 	 .Inv(a)
+// 	b.Exp(b, new(big.Int).SetUint64(42))
+//
+// Modulus q =
+//
+// 	q[base10] = {{.Modulus}}
+// 	q[base16] = 0x{{.ModulusHex}}
+//
+// Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// The methods with a CT suffix (InverseCT, ExpCT, ...) run in constant time with respect to their
+// inputs, and should be used instead of their variable-time counterparts on secret values.
+package {{.PackageName}}
+`
@@ -0,0 +1,694 @@
+package f31
+
+// Test is the source of the tests of a field on a single 32-bit word; operations are checked
+// against math/big.
+const Test = `
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------------------------------------------------------------------------------------
+// benchmarks
+
+var benchRes{{.ElementName}} {{.ElementName}}
+
+func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
+	x := {{.ElementName}}{ {{index .RSquare 0}} }
+	benchRes{{.ElementName}}.SetOne()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Mul(&benchRes{{.ElementName}}, &x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Add(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Add(&x, &benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Inverse(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Inverse(&x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Sqrt(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Sqrt(&a)
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// Gopter tests
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+func Test{{toTitle .ElementName}}Cmp(t *testing.T) {
+	var x, y {{.ElementName}}
+
+	if x.Cmp(&y) != 0 {
+		t.Fatal("x == y")
+	}
+
+	one := One()
+	y.Sub(&y, &one)
+
+	if x.Cmp(&y) != -1 {
+		t.Fatal("x < y")
+	}
+	if y.Cmp(&x) != 1 {
+		t.Fatal("x < y")
+	}
+}
+
+func Test{{toTitle .ElementName}}NegZero(t *testing.T) {
+	var a, b {{.ElementName}}
+	b.SetZero()
+	for a.IsZero() {
+		a.SetRandom()
+	}
+	a.Neg(&b)
+	if !a.IsZero() {
+		t.Fatal("neg(0) != 0")
+	}
+}
+
+func Test{{toTitle .ElementName}}SetRandom(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		var x {{.ElementName}}
+		if _, err := x.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		if !x.smallerThanModulus() {
+			t.Fatal("SetRandom should output a value smaller than q")
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	binaryOps := []struct {
+		name string
+		op   func(z, x, y *{{.ElementName}}) *{{.ElementName}}
+		ref  func(z, x, y *big.Int) *big.Int
+	}{
+		{"Add", (*{{.ElementName}}).Add, (*big.Int).Add},
+		{"Sub", (*{{.ElementName}}).Sub, (*big.Int).Sub},
+		{"Mul", (*{{.ElementName}}).Mul, (*big.Int).Mul},
+		{"Div", (*{{.ElementName}}).Div, func(z, x, y *big.Int) *big.Int {
+			var yInv big.Int
+			if yInv.ModInverse(y, Modulus()) == nil {
+				return z.SetUint64(0)
+			}
+			return z.Mul(x, &yInv)
+		}},
+	}
+	for _, bop := range binaryOps {
+		bop := bop
+		properties.Property(bop.name+": should match math/big", prop.ForAll(
+			func(a, b testPair{{.ElementName}}) bool {
+				var c {{.ElementName}}
+				bop.op(&c, &a.element, &b.element)
+				var expected big.Int
+				bop.ref(&expected, &a.bigint, &b.bigint).Mod(&expected, Modulus())
+				return c.BigInt(new(big.Int)).Cmp(&expected) == 0
+			},
+			genA,
+			genB,
+		))
+
+		properties.Property(bop.name+": having the receiver as operand should output the same result", prop.ForAll(
+			func(a, b testPair{{.ElementName}}) bool {
+				var c, d {{.ElementName}}
+				bop.op(&c, &a.element, &b.element)
+				d = a.element
+				bop.op(&d, &d, &b.element)
+				return c.Equal(&d)
+			},
+			genA,
+			genB,
+		))
+	}
+
+	unaryOps := []struct {
+		name string
+		op   func(z, x *{{.ElementName}}) *{{.ElementName}}
+		ref  func(z, x *big.Int) *big.Int
+	}{
+		{"Square", (*{{.ElementName}}).Square, func(z, x *big.Int) *big.Int { return z.Mul(x, x) }},
+		{"Double", (*{{.ElementName}}).Double, func(z, x *big.Int) *big.Int { return z.Lsh(x, 1) }},
+		{"Neg", (*{{.ElementName}}).Neg, (*big.Int).Neg},
+		{"Inverse", (*{{.ElementName}}).Inverse, func(z, x *big.Int) *big.Int {
+			if z.ModInverse(x, Modulus()) == nil {
+				return z.SetUint64(0)
+			}
+			return z
+		}},
+	}
+	for _, uop := range unaryOps {
+		uop := uop
+		properties.Property(uop.name+": should match math/big", prop.ForAll(
+			func(a testPair{{.ElementName}}) bool {
+				var c {{.ElementName}}
+				uop.op(&c, &a.element)
+				var expected big.Int
+				uop.ref(&expected, &a.bigint).Mod(&expected, Modulus())
+				return c.BigInt(new(big.Int)).Cmp(&expected) == 0
+			},
+			genA,
+		))
+	}
+
+	properties.Property("Halve: 2 * (x / 2) == x", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			c := a.element
+			c.Halve()
+			c.Double(&c)
+			return c.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("Exp: should match math/big", prop.ForAll(
+		func(a testPair{{.ElementName}}, e int64) bool {
+			var c {{.ElementName}}
+			c.Exp(a.element, big.NewInt(e))
+			var expected big.Int
+			if e < 0 {
+				if expected.ModInverse(&a.bigint, Modulus()) == nil {
+					return c.IsZero()
+				}
+				expected.Exp(&expected, big.NewInt(-e), Modulus())
+			} else {
+				expected.Exp(&a.bigint, big.NewInt(e), Modulus())
+			}
+			return c.BigInt(new(big.Int)).Cmp(&expected) == 0
+		},
+		genA,
+		ggen.Int64Range(-1<<40, 1<<40),
+	))
+
+	properties.Property("Legendre: should match math/big", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			return a.element.Legendre() == big.Jacobi(&a.bigint, Modulus())
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt: should match math/big", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c {{.ElementName}}
+			var expected big.Int
+			if expected.ModSqrt(&a.bigint, Modulus()) == nil {
+				return c.Sqrt(&a.element) == nil
+			}
+			if c.Sqrt(&a.element) == nil {
+				return false
+			}
+			var square {{.ElementName}}
+			square.Square(&c)
+			return square.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("LexicographicallyLargest: should match math/big", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var halfQ big.Int
+			halfQ.Rsh(Modulus(), 1)
+			return a.element.LexicographicallyLargest() == (a.bigint.Cmp(&halfQ) == 1)
+		},
+		genA,
+	))
+
+	properties.Property("Butterfly: should output a+b and a-b", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			a0, b0 := a.element, b.element
+			var s, d {{.ElementName}}
+			s.Add(&a0, &b0)
+			d.Sub(&a0, &b0)
+			Butterfly(&a0, &b0)
+			return a0.Equal(&s) && b0.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("MulBy3, MulBy5, MulBy13: should match Mul", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			ok := true
+			for _, c := range []struct {
+				f func(*{{.ElementName}})
+				v uint64
+			}{ {MulBy3, 3}, {MulBy5, 5}, {MulBy13, 13} } {
+				x := a.element
+				c.f(&x)
+				y := New{{.ElementName}}(c.v)
+				y.Mul(&y, &a.element)
+				ok = ok && x.Equal(&y)
+			}
+			return ok
+		},
+		genA,
+	))
+
+	properties.Property("Select: should pick the right operand", prop.ForAll(
+		func(a, b testPair{{.ElementName}}, c int) bool {
+			var z {{.ElementName}}
+			z.Select(c, &a.element, &b.element)
+			if c == 0 {
+				return z.Equal(&a.element)
+			}
+			return z.Equal(&b.element)
+		},
+		genA,
+		genB,
+		ggen.OneConstOf(0, 1, -1, 42),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}Conversions(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SetBytes(Bytes()) should stay constant", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var b {{.ElementName}}
+			bytes := a.element.Bytes()
+			b.SetBytes(bytes[:])
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetBytesCanonical(Bytes()) should stay constant", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var b {{.ElementName}}
+			bytes := a.element.Bytes()
+			if err := b.SetBytesCanonical(bytes[:]); err != nil {
+				return false
+			}
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("LittleEndian round trip should stay constant", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var bytes [Bytes]byte
+			LittleEndian.PutElement(&bytes, a.element)
+			b, err := LittleEndian.Element(&bytes)
+			return err == nil && a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetBigInt(BigInt()) should stay constant", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var b {{.ElementName}}
+			b.SetBigInt(&a.bigint)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetString(Text()) should stay constant", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var b {{.ElementName}}
+			if _, err := b.SetString(a.element.String()); err != nil {
+				return false
+			}
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetUint64 and SetInt64 should match math/big", prop.ForAll(
+		func(v int64) bool {
+			var a, b {{.ElementName}}
+			a.SetInt64(v)
+			b.SetUint64(uint64(v))
+			var expectedA, expectedB big.Int
+			expectedA.SetInt64(v).Mod(&expectedA, Modulus())
+			expectedB.SetUint64(uint64(v)).Mod(&expectedB, Modulus())
+			return a.BigInt(new(big.Int)).Cmp(&expectedA) == 0 && b.BigInt(new(big.Int)).Cmp(&expectedB) == 0
+		},
+		ggen.Int64(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// non canonical encodings
+	var invalid [Bytes]byte
+	for i := range invalid {
+		invalid[i] = 0xff
+	}
+	var e {{.ElementName}}
+	if err := e.SetBytesCanonical(invalid[:]); err == nil {
+		t.Fatal("SetBytesCanonical should fail on a value larger than q")
+	}
+	if err := e.SetBytesCanonical(invalid[1:]); err == nil {
+		t.Fatal("SetBytesCanonical should fail on a short slice")
+	}
+}
+
+func Test{{toTitle .ElementName}}BatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]{{.ElementName}}, 10)
+	for i := range a {
+		if i%3 == 0 {
+			continue // test the handling of zeroes
+		}
+		a[i].SetRandom()
+	}
+
+	aInv := BatchInvert(a)
+
+	assert.True(len(aInv) == len(a))
+	for i := range a {
+		var expected {{.ElementName}}
+		expected.Inverse(&a[i])
+		assert.True(aInv[i].Equal(&expected), "batchInvert != invert")
+	}
+}
+
+func Test{{toTitle .ElementName}}JSON(t *testing.T) {
+	assert := require.New(t)
+
+	type S struct {
+		A {{.ElementName}}
+		B [3]{{.ElementName}}
+		C *{{.ElementName}}
+		D *{{.ElementName}}
+	}
+
+	// encode to JSON
+	var s S
+	s.A.SetString("-1")
+	s.B[2].SetUint64(42)
+	s.D = new({{.ElementName}}).SetUint64(8000)
+
+	encoded, err := json.Marshal(&s)
+	assert.NoError(err)
+	{{- $noNeg := ltu64 (index $.Q 0) 1000000}}
+	// we may need to adjust "42" and "8000" values for some moduli; see Text() method for more details.
+	formatValue := func(v int64) string {
+		var a big.Int
+		a.SetInt64(v)
+		a.Mod(&a, Modulus())
+		{{- if not $noNeg}}
+		const maxUint16 = 65535
+		var aNeg big.Int
+		aNeg.Neg(&a).Mod(&aNeg, Modulus())
+		if aNeg.Uint64() != 0 && aNeg.Uint64() <= maxUint16 {
+			return "-" + aNeg.Text(10)
+		}
+		{{- end}}
+		return a.Text(10)
+	}
+	expected := fmt.Sprintf("{\"A\":%s,\"B\":[0,0,%s],\"C\":null,\"D\":%s}", formatValue(-1), formatValue(42), formatValue(8000))
+	assert.Equal(expected, string(encoded))
+
+	// decode valid
+	var decoded S
+	err = json.Unmarshal([]byte(expected), &decoded)
+	assert.NoError(err)
+
+	assert.Equal(s, decoded, "element -> json -> element round trip failed")
+}
+
+type testPair{{.ElementName}} struct {
+	element {{.ElementName}}
+	bigint  big.Int
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPair{{.ElementName}}
+
+		g.element = {{.ElementName}}{uint32(genParams.NextUint64() % uint64(q))}
+
+		g.element.BigInt(&g.bigint)
+		genResult := gopter.NewGenResult(g, gopter.NoShrinker)
+		return genResult
+	}
+}
+`
+
+// TestVector is the source of the tests of the Vector type of a field on a single 32-bit word
+const TestVector = `
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := make(Vector, 3)
+	v[0].SetUint64(2)
+	v[1].SetUint64(3)
+	v[2].SetUint64(1)
+
+	sort.Sort(v)
+
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 3)
+	v1[0].SetUint64(2)
+	v1[1].SetUint64(3)
+	v1[2].SetUint64(1)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+}
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	// odd length, with the extreme values
+	const n = 1025
+	a, b := make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		b[i].SetRandom()
+	}
+	a[0].SetUint64(0)
+	a[1].SetInt64(-1)
+	b[1].SetInt64(-1)
+	b[2].SetInt64(-1)
+
+	var s {{.ElementName}}
+	s.SetRandom()
+
+	sum, diff, prod, scaled := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+	sum.Add(a, b)
+	diff.Sub(a, b)
+	prod.Mul(a, b)
+	scaled.ScalarMul(a, &s)
+
+	var expectedSum, expectedInnerProduct {{.ElementName}}
+	for i := 0; i < n; i++ {
+		var tmp {{.ElementName}}
+		assert.True(sum[i].Equal(tmp.Add(&a[i], &b[i])), "Add mismatch at index %d", i)
+		assert.True(diff[i].Equal(tmp.Sub(&a[i], &b[i])), "Sub mismatch at index %d", i)
+		assert.True(prod[i].Equal(tmp.Mul(&a[i], &b[i])), "Mul mismatch at index %d", i)
+		assert.True(scaled[i].Equal(tmp.Mul(&a[i], &s)), "ScalarMul mismatch at index %d", i)
+		expectedSum.Add(&expectedSum, &a[i])
+		expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+	}
+
+	s = a.Sum()
+	assert.True(s.Equal(&expectedSum), "Sum mismatch")
+	s = a.InnerProduct(b)
+	assert.True(s.Equal(&expectedInnerProduct), "InnerProduct mismatch")
+
+	// q - 1 everywhere maximizes the accumulators
+	for i := 0; i < n; i++ {
+		a[i].SetInt64(-1)
+	}
+	var expected {{.ElementName}}
+	expected.SetInt64(-n)
+	s = a.Sum()
+	assert.True(s.Equal(&expected), "Sum mismatch on q - 1")
+	expected.SetInt64(n)
+	s = a.InnerProduct(a)
+	assert.True(s.Equal(&expected), "InnerProduct mismatch on q - 1")
+
+	assert.Panics(func() { sum.Add(a, b[1:]) })
+	assert.Panics(func() { a.InnerProduct(b[1:]) })
+}
+
+func TestVectorBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[n/2].SetZero()
+
+	expected := BatchInvert(a)
+	inv := make(Vector, n)
+	inv.BatchInvert(a)
+	assert.True(reflect.DeepEqual(Vector(expected), inv))
+
+	// in place
+	a.BatchInvert(a)
+	assert.True(reflect.DeepEqual(a, inv))
+
+	assert.Panics(func() { a.BatchInvert(inv[1:]) })
+}
+
+func TestVectorCanonicalLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 1000
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+	a[0].SetZero()
+	a[1].SetOne()
+
+	expected := make([]uint32, 1*n)
+	for i := 0; i < n; i++ {
+		expected[i] = uint32(a[i].Uint64())
+	}
+	limbs := a.ToCanonicalLimbs()
+	assert.Equal(expected, limbs)
+
+	var v Vector
+	assert.NoError(v.FromCanonicalLimbs(limbs))
+	assert.True(reflect.DeepEqual(a, v))
+
+	// values ⩾ q are rejected
+	bad := make([]uint32, len(limbs))
+	copy(bad, limbs)
+	bad[n/2] = q
+	err := v.FromCanonicalLimbs(bad)
+	assert.Error(err)
+	assert.Contains(err.Error(), "element 500")
+	assert.True(reflect.DeepEqual(a, v), "the vector should be left unchanged on error")
+
+	// empty vectors
+	assert.NoError(v.FromCanonicalLimbs(nil))
+	assert.Equal(0, len(v))
+	assert.Equal(0, len(Vector{}.ToCanonicalLimbs()))
+}
+
+func TestVectorMontgomeryLimbs(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 100
+	a := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+	}
+
+	limbs := a.MontgomeryLimbs()
+	assert.Equal(1*n, len(limbs))
+	for i := 0; i < n; i++ {
+		assert.Equal(a[i][:], limbs[1*i:1*(i+1)])
+	}
+
+	// the views alias the vector
+	v := VectorFromMontgomeryLimbs(limbs)
+	assert.True(reflect.DeepEqual(a, v))
+	v[3].SetOne()
+	assert.True(a[3].IsOne())
+
+	assert.Nil(Vector{}.MontgomeryLimbs())
+	assert.Nil(VectorFromMontgomeryLimbs(nil))
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 20
+	a, c, res := make(Vector, n), make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		c[i].SetRandom()
+	}
+
+	b.Run("Add", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+}
+`
@@ -0,0 +1,165 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// goffConfig describes the fields to generate in one invocation; for instance, the BN254
+// base field and its tower:
+//
+//	{
+//		"fields": [{
+//			"modulus": "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+//			"package": "fp",
+//			"element": "Element",
+//			"output": "./fp",
+//			"tower": {
+//				"importPath": "example.com/bn254/fp",
+//				"package": "fptower",
+//				"output": "./fptower",
+//				"extensions": [
+//					{"degree": 2, "nonResidue": ["-1"]},
+//					{"degree": 3, "nonResidue": ["9", "1"]},
+//					{"degree": 2, "nonResidue": ["0", "0", "1", "0", "0", "0"]}
+//				]
+//			}
+//		}]
+//	}
+//
+// The output directories are relative to the directory of the configuration file.
+type goffConfig struct {
+	Fields []fieldConfig `json:"fields"`
+}
+
+type fieldConfig struct {
+	Modulus string       `json:"modulus"`
+	Package string       `json:"package"`
+	Element string       `json:"element"`
+	Output  string       `json:"output"`
+	Tower   *towerConfig `json:"tower,omitempty"`
+
+	// packages generated along with the field, e.g. "importPath": "example.com/bn254/fr",
+	// "fft": true, "polynomial": true, "mimc": true
+	packagesConfig
+}
+
+// towerConfig describes a tower of extensions of a field: the first extension extends the
+// field, and each following one the previous extension. The non-residue of an extension is
+// given by its coordinates over the base field, in the basis of the extension it extends.
+type towerConfig struct {
+	ImportPath string            `json:"importPath"` // import path of the package of the field
+	Package    string            `json:"package"`
+	Output     string            `json:"output"`
+	Extensions []extensionConfig `json:"extensions"`
+}
+
+type extensionConfig struct {
+	Degree     uint8    `json:"degree"`
+	NonResidue []string `json:"nonResidue"`
+}
+
+var errMissingField = errors.New("a field must have a modulus, a package, an element and an output")
+
+// generateFromConfig generates the fields described in the configuration file at path
+func generateFromConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var conf goffConfig
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return fmt.Errorf("invalid configuration file: %w", err)
+	}
+	if len(conf.Fields) == 0 {
+		return errors.New("no field to generate")
+	}
+
+	dir := filepath.Dir(path)
+	resolve := func(p string) string {
+		if filepath.IsAbs(p) {
+			return filepath.Clean(p)
+		}
+		return filepath.Join(dir, p)
+	}
+
+	for _, f := range conf.Fields {
+		if f.Modulus == "" || f.Package == "" || f.Element == "" || f.Output == "" {
+			return errMissingField
+		}
+		F, err := field.NewFieldConfig(strings.ToLower(f.Package), f.Element, f.Modulus, false)
+		if err != nil {
+			return err
+		}
+		if err := generator.GenerateFF(F, resolve(f.Output)); err != nil {
+			return err
+		}
+		if err := generatePackages(F, resolve(f.Output), f.packagesConfig); err != nil {
+			return fmt.Errorf("packages of %s: %w", f.Package, err)
+		}
+		if f.Tower == nil {
+			continue
+		}
+
+		tower, err := newTower(F, f.Tower)
+		if err != nil {
+			return fmt.Errorf("tower of %s: %w", f.Package, err)
+		}
+		if err := generator.GenerateTower(F, f.Tower.ImportPath, resolve(f.Tower.Output), strings.ToLower(f.Tower.Package), tower...); err != nil {
+			return fmt.Errorf("tower of %s: %w", f.Package, err)
+		}
+	}
+	return nil
+}
+
+// newTower returns the extensions of the tower described by conf
+func newTower(F *field.FieldConfig, conf *towerConfig) ([]field.Extension, error) {
+	if conf.ImportPath == "" || conf.Package == "" || conf.Output == "" {
+		return nil, errors.New("a tower must have an import path, a package and an output")
+	}
+	if len(conf.Extensions) == 0 {
+		return nil, errors.New("no extension to generate")
+	}
+
+	tower := make([]field.Extension, len(conf.Extensions))
+	for i, e := range conf.Extensions {
+		if i == 0 {
+			if len(e.NonResidue) != 1 {
+				return nil, errors.New("the non-residue of the first extension must be an element of the field")
+			}
+			rootOf, err := strconv.ParseInt(e.NonResidue[0], 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("the non-residue of the first extension must be a small integer: %w", err)
+			}
+			tower[0] = field.NewTower(F, e.Degree, rootOf)
+			continue
+		}
+		var err error
+		if tower[i], err = field.NewTowerOfString(&tower[i-1], e.Degree, e.NonResidue...); err != nil {
+			return nil, err
+		}
+	}
+	return tower, nil
+}
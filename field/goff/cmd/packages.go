@@ -0,0 +1,128 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
+	"github.com/consensys/gnark-crypto/internal/generator/fft"
+	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+)
+
+// minTwoAdicity is the smallest 2-adicity of q-1 for which the fft package is generated: the
+// generated tests use domains of size up to 2¹⁰.
+const minTwoAdicity = 10
+
+// packagesConfig selects the packages generated along with a field, in sub-directories of
+// its output directory: fft (domains and FFT), polynomial and mimc (hash function).
+type packagesConfig struct {
+	ImportPath string `json:"importPath,omitempty"` // import path of the package of the field
+	FFT        bool   `json:"fft,omitempty"`
+	Polynomial bool   `json:"polynomial,omitempty"`
+	MiMC       bool   `json:"mimc,omitempty"`
+	Generator  uint64 `json:"generator,omitempty"` // generator of 𝔽*; if 0, goff looks for the smallest one
+}
+
+func (conf *packagesConfig) empty() bool {
+	return !conf.FFT && !conf.Polynomial && !conf.MiMC
+}
+
+// generatePackages generates the packages selected by conf for the field F, whose package
+// is in outputDir
+func generatePackages(F *field.FieldConfig, outputDir string, conf packagesConfig) error {
+	if conf.empty() {
+		return nil
+	}
+	if conf.ImportPath == "" {
+		return errors.New("the fft, polynomial and mimc packages need the import path of the field")
+	}
+
+	fieldDependency := config.FieldDependency{
+		FieldPackagePath: conf.ImportPath,
+		FieldPackageName: F.PackageName,
+		ElementType:      F.PackageName + "." + F.ElementName,
+	}
+	modulus := F.ModulusBig.String()
+
+	// the generators read their templates from disk
+	tmpDir, err := os.MkdirTemp("", "goff")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bgen := bavard.NewBatchGenerator("ConsenSys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	if conf.FFT {
+		generator := conf.Generator
+		if generator == 0 {
+			if generator, err = config.MultiplicativeGenerator(modulus); err != nil {
+				return fmt.Errorf("fft: %w: pass the generator of the multiplicative group with --generator, or \"generator\" in the configuration file", err)
+			}
+		}
+		fftConf, err := fft.NewConfig(fieldDependency, modulus, generator, "")
+		if err != nil {
+			return fmt.Errorf("fft: %w", err)
+		}
+		if fftConf.LogTwoOrderMaxTwoAdicSubgroup < minTwoAdicity {
+			return fmt.Errorf("fft: the 2-adicity of q-1 is %d, at least %d is needed", fftConf.LogTwoOrderMaxTwoAdicSubgroup, minTwoAdicity)
+		}
+		templateDir, err := config.ExtractTemplates(fft.Templates, filepath.Join(tmpDir, "fft"))
+		if err != nil {
+			return err
+		}
+		if err := fft.GenerateWithTemplates(fftConf, filepath.Join(outputDir, "fft"), templateDir, bgen); err != nil {
+			return fmt.Errorf("fft: %w", err)
+		}
+	}
+
+	if conf.Polynomial {
+		templateDir, err := config.ExtractTemplates(polynomial.Templates, filepath.Join(tmpDir, "polynomial"))
+		if err != nil {
+			return err
+		}
+		if err := polynomial.GenerateWithTemplates(fieldDependency, filepath.Join(outputDir, "polynomial"), templateDir, true, bgen); err != nil {
+			return fmt.Errorf("polynomial: %w", err)
+		}
+	}
+
+	if conf.MiMC {
+		mimcConf, err := mimc.NewConfig(fieldDependency, modulus)
+		if err != nil {
+			return fmt.Errorf("mimc: %w", err)
+		}
+		templateDir, err := config.ExtractTemplates(mimc.Templates, filepath.Join(tmpDir, "mimc"))
+		if err != nil {
+			return err
+		}
+		if err := mimc.GenerateWithTemplates(mimcConf, filepath.Join(outputDir, "mimc"), templateDir, bgen); err != nil {
+			return fmt.Errorf("mimc: %w", err)
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// TestGeneratePackages generates the fft package of the scalar field of BN254 over the fr
+// package of ecc/bn254, and runs its tests. goff can't find the generator of 𝔽ᵣ* as r-1 has a
+// prime factor of about 2⁵⁰, so it must be given.
+func TestGeneratePackages(t *testing.T) {
+	const outputDir = "integration_test"
+	os.RemoveAll(outputDir)
+	defer os.RemoveAll(outputDir)
+
+	fr, err := field.NewFieldConfig("fr", "Element", "21888242871839275222246405745257275088548364400416034343698204186575808495617", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := packagesConfig{
+		ImportPath: "github.com/consensys/gnark-crypto/ecc/bn254/fr",
+		FFT:        true,
+	}
+	if err = generatePackages(fr, outputDir, conf); err == nil || !strings.Contains(err.Error(), "--generator") {
+		t.Fatal("generating the fft package without the generator of 𝔽ᵣ* should fail", err)
+	}
+	conf.Generator = 5
+	if err = generatePackages(fr, outputDir, conf); err != nil {
+		t.Fatal(err)
+	}
+
+	// the domain is generated from the given generator
+	domain, err := os.ReadFile(filepath.Join(outputDir, "fft", "domain.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(domain), "domain.FrMultiplicativeGen.SetUint64(5)") {
+		t.Fatal("wrong generator of the multiplicative group")
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "test", "-short", filepath.Join(wd, outputDir, "fft"))
+	out, err := cmd.CombinedOutput()
+	fmt.Println(string(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
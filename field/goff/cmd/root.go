@@ -40,6 +40,8 @@ var (
 	fOutputDir   string
 	fPackageName string
 	fElementName string
+	fConfig      string
+	fPackages    packagesConfig
 )
 
 func init() {
@@ -48,6 +50,12 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&fModulus, "modulus", "m", "", "field modulus (base 10)")
 	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path to create output files")
 	rootCmd.PersistentFlags().StringVarP(&fPackageName, "package", "p", "", "package name in generated files")
+	rootCmd.PersistentFlags().StringVarP(&fConfig, "config", "c", "", "JSON file describing the fields (and extension towers) to generate, instead of the other flags")
+	rootCmd.PersistentFlags().StringVarP(&fPackages.ImportPath, "import-path", "i", "", "import path of the generated package, needed by the fft, polynomial and mimc packages")
+	rootCmd.PersistentFlags().BoolVar(&fPackages.FFT, "fft", false, "also generate the fft package, if q-1 has enough 2-adicity")
+	rootCmd.PersistentFlags().BoolVar(&fPackages.Polynomial, "polynomial", false, "also generate the polynomial package")
+	rootCmd.PersistentFlags().BoolVar(&fPackages.MiMC, "mimc", false, "also generate the mimc package")
+	rootCmd.PersistentFlags().Uint64VarP(&fPackages.Generator, "generator", "g", 0, "generator of the multiplicative group, for the fft package (found by goff if omitted)")
 	if bits.UintSize != 64 {
 		panic("goff only supports 64bits architectures")
 	}
@@ -58,6 +66,14 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 	fmt.Println("running goff version", Version)
 	fmt.Println()
 
+	if fConfig != "" {
+		if err := generateFromConfig(fConfig); err != nil {
+			fmt.Printf("\n%s\n", err.Error())
+			os.Exit(-1)
+		}
+		return
+	}
+
 	// parse flags
 	if err := parseFlags(cmd); err != nil {
 		_ = cmd.Usage()
@@ -75,6 +91,10 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
 	}
+	if err := generatePackages(F, fOutputDir, fPackages); err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
 }
 
 func parseFlags(cmd *cobra.Command) error {
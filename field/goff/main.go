@@ -20,6 +20,24 @@
 //
 //	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element
 //
+// Several fields, and towers of extensions of them (e.g. Fp → Fp2 → Fp6 → Fp12), can be
+// generated in one invocation from a JSON configuration file:
+//
+//	goff -c ./fields.json
+//
+// See the documentation of goffConfig in cmd/config.go for the format of the file.
+//
+// The fft, polynomial and mimc packages of the field can be generated along with it, in
+// sub-directories of the output directory; they import the field package, whose import path
+// must then be given:
+//
+//	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element -i example.com/goldilocks --fft --polynomial --mimc
+//
+// The fft package needs q-1 to have a 2-adicity of at least 10. goff looks for the smallest
+// generator of the multiplicative group, which requires factoring q-1; this fails quickly when
+// q-1 has a prime factor of more than about 40 bits (the scalar fields of bn254 or bls12-377 for
+// instance), and the generator must then be given with --generator.
+//
 // # Warning
 //
 // The generated code has not been audited for all moduli (only bn254 and bls12-381) and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
@@ -0,0 +1,173 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// qMinusTwo is the exponent q-2 used by InverseCT, in little-endian 64-bit words
+var qMinusTwo = func() (e [Limbs]uint64) {
+	var b uint64
+	e[0], b = bits.Sub64(qElement[0], 2, 0)
+	for i := 1; i < Limbs; i++ {
+		e[i], b = bits.Sub64(qElement[i], 0, b)
+	}
+	return
+}()
+
+// InverseCT z = x⁻¹ (mod q) and returns z, or z = 0 if x == 0
+//
+// It computes x^(q-2) in constant time with respect to x; it is much slower than Inverse
+// and should be used when x is secret.
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expCT(x, &qMinusTwo)
+}
+
+// ExpCT z = xᵏ (mod q) and returns z
+//
+// It runs in constant time with respect to x and |k|: all the Bytes bytes of the exponent are
+// processed, whatever its bit length. If k is negative, x is inverted with InverseCT; the
+// sign of k is not secret. It panics if |k| ⩾ 2^(8⋅Bytes).
+func (z *Element) ExpCT(x Element, k *big.Int) *Element {
+	var abs big.Int
+	abs.Abs(k)
+	var buf [Bytes]byte
+	abs.FillBytes(buf[:])
+	if k.Sign() == -1 {
+		x.InverseCT(&x)
+	}
+
+	var e [Limbs]uint64
+	for i := 0; i < Limbs; i++ {
+		e[i] = binary.BigEndian.Uint64(buf[Bytes-8*(i+1):])
+	}
+	return z.expCT(&x, &e)
+}
+
+// expCT z = xᵉ (mod q), where e is in little-endian 64-bit words, with a fixed 4-bit window:
+// all the bits of e are processed, and the window is read from the table with lookupCT.
+func (z *Element) expCT(x *Element, e *[Limbs]uint64) *Element {
+	var table [16]Element
+	table[0].SetOne()
+	table[1] = *x
+	for i := 2; i < len(table); i++ {
+		table[i].MulCT(&table[i-1], x)
+	}
+
+	var res, t Element
+	res.SetOne()
+	for i := Limbs - 1; i >= 0; i-- {
+		for j := 60; j >= 0; j -= 4 {
+			res.MulCT(&res, &res)
+			res.MulCT(&res, &res)
+			res.MulCT(&res, &res)
+			res.MulCT(&res, &res)
+			t.lookupCT(&table, (e[i]>>j)&0xf)
+			res.MulCT(&res, &t)
+		}
+	}
+	*z = res
+	return z
+}
+
+// lookupCT sets z = table[i], reading all the entries of the table
+func (z *Element) lookupCT(table *[16]Element, i uint64) {
+	z.SetZero()
+	for j := range table {
+		z.Select(subtle.ConstantTimeEq(int32(j), int32(i)), z, &table[j])
+	}
+}
+
+// MulCT z = x * y (mod q) and returns z
+//
+// It uses the textbook CIOS algorithm; unlike Mul, the final conditional subtraction of q is
+// done with a mask, without a branch.
+func (z *Element) MulCT(x, y *Element) *Element {
+	var t [Limbs + 2]uint64
+	for i := 0; i < Limbs; i++ {
+		// t = t + x * y[i]
+		var C uint64
+		for j := 0; j < Limbs; j++ {
+			C, t[j] = madd2(x[j], y[i], t[j], C)
+		}
+		t[Limbs], C = bits.Add64(t[Limbs], C, 0)
+		t[Limbs+1] = C
+
+		// t = (t + m * q) / 2⁶⁴, with m such that the division is exact
+		m := t[0] * qInvNeg
+		C = madd0(m, qElement[0], t[0])
+		for j := 1; j < Limbs; j++ {
+			C, t[j-1] = madd2(m, qElement[j], t[j], C)
+		}
+		t[Limbs-1], C = bits.Add64(t[Limbs], C, 0)
+		t[Limbs] = t[Limbs+1] + C
+	}
+
+	// t < 2q; s = t - q, and the subtraction borrows iff t < q
+	var s Element
+	var b uint64
+	for j := 0; j < Limbs; j++ {
+		s[j], b = bits.Sub64(t[j], qElement[j], b)
+	}
+	_, b = bits.Sub64(t[Limbs], 0, b)
+
+	mask := -b // all ones if t < q
+	for j := 0; j < Limbs; j++ {
+		z[j] = (t[j] & mask) | (s[j] &^ mask)
+	}
+	return z
+}
+
+// AddCT z = x + y (mod q) and returns z
+//
+// Unlike Add, the conditional subtraction of q is done with a mask, without a branch.
+func (z *Element) AddCT(x, y *Element) *Element {
+	var t, s Element
+	var c, b uint64
+	for j := 0; j < Limbs; j++ {
+		t[j], c = bits.Add64(x[j], y[j], c)
+	}
+	for j := 0; j < Limbs; j++ {
+		s[j], b = bits.Sub64(t[j], qElement[j], b)
+	}
+	_, b = bits.Sub64(c, 0, b)
+
+	mask := -b // all ones if x + y < q
+	for j := 0; j < Limbs; j++ {
+		z[j] = (t[j] & mask) | (s[j] &^ mask)
+	}
+	return z
+}
+
+// SubCT z = x - y (mod q) and returns z
+//
+// Unlike Sub, q is added back with a mask, without a branch.
+func (z *Element) SubCT(x, y *Element) *Element {
+	var b, c uint64
+	for j := 0; j < Limbs; j++ {
+		z[j], b = bits.Sub64(x[j], y[j], b)
+	}
+	mask := -b // all ones if x < y
+	for j := 0; j < Limbs; j++ {
+		z[j], c = bits.Add64(z[j], qElement[j]&mask, c)
+	}
+	return z
+}
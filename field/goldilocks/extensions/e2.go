@@ -0,0 +1,356 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E2 is a degree 2 extension of goldilocks.Element, as goldilocks[u]/(u² - 7).
+//
+// The element A0 + A1⋅u is
+// stored as its coordinates in the basis (1, u).
+type E2 struct {
+	A0, A1 goldilocks.Element
+}
+
+// nonResidueE2 is u² = 7
+var nonResidueE2 = goldilocks.Element{30064771065}
+
+// frobeniusCoefficientsE2[i] = 7^(i(p-1)/2), so that (uⁱ)ᵖ = frobeniusCoefficientsE2[i]⋅uⁱ
+var frobeniusCoefficientsE2 = [2]goldilocks.Element{
+	{4294967295},
+	{18446744065119617026},
+}
+
+// mulByNonResidueE2 sets z = 7⋅x
+func mulByNonResidueE2(z, x *goldilocks.Element) {
+	z.Mul(x, &nonResidueE2)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	*z = *x
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
+
+// MulByElement sets z = x⋅y, y in the base field, and returns z
+func (z *E2) MulByElement(x *E2, y *goldilocks.Element) *E2 {
+	yCopy := *y
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	return z
+}
+
+// Mul sets z = x⋅y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	// Karatsuba
+	var a, b, c goldilocks.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	mulByNonResidueE2(&c, &c)
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E2) Square(x *E2) *E2 {
+	var a, b goldilocks.Element
+	a.Mul(&x.A0, &x.A1).Double(&a)
+	b.Square(&x.A1)
+	mulByNonResidueE2(&b, &b)
+	z.A0.Square(&x.A0).Add(&z.A0, &b)
+	z.A1 = a
+	return z
+}
+
+// Norm returns the norm x₀² - 7x₁² of x, in the base field
+func (z *E2) Norm() goldilocks.Element {
+	var n, t goldilocks.Element
+	n.Square(&z.A0)
+	t.Square(&z.A1)
+	mulByNonResidueE2(&t, &t)
+	n.Sub(&n, &t)
+	return n
+}
+
+// Inverse sets z = 1/x and returns z. If x is 0, z is set to 0.
+func (z *E2) Inverse(x *E2) *E2 {
+	// 1/(x₀ + x₁u) = (x₀ - x₁u)/(x₀² - 7x₁²)
+	n := x.Norm()
+	n.Inverse(&n)
+	z.A0.Mul(&x.A0, &n)
+	z.A1.Mul(&x.A1, &n).Neg(&z.A1)
+	return z
+}
+
+// Conjugate sets z to the conjugate x₀ - x₁u of x and returns z
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Frobenius sets z = xᵖ and returns z. It is the conjugate of x, since uᵖ = -u.
+func (z *E2) Frobenius(x *E2) *E2 {
+	return z.Conjugate(x)
+}
+
+// Div sets z = x/y and returns z
+func (z *E2) Div(x, y *E2) *E2 {
+	var r E2
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Select is conditional move.
+// If cond = 0, it sets z to caseZ and returns it. otherwise caseNz.
+func (z *E2) Select(cond int, caseZ *E2, caseNz *E2) *E2 {
+	z.A0.Select(cond, &caseZ.A0, &caseNz.A0)
+	z.A1.Select(cond, &caseZ.A1, &caseNz.A1)
+	return z
+}
+
+// BatchInvertE2 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE2(a []E2) []E2 {
+	res := make([]E2, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E2
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// VectorE2 represents a slice of E2.
+type VectorE2 []E2
+
+// Add adds two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE2) Add(a, b VectorE2) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Add(&a[i], &b[i])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE2) Sub(a, b VectorE2) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Sub(&a[i], &b[i])
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE2) Mul(a, b VectorE2) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE2) ScalarMul(a VectorE2, b *E2) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], b)
+	}
+}
+
+// MulByElement multiplies a vector by an element of the base field element-wise
+// and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE2) MulByElement(a VectorE2, b *goldilocks.Element) {
+	if len(a) != len(*vector) {
+		panic("vector.MulByElement: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].MulByElement(&a[i], b)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *VectorE2) Sum() (res E2) {
+	for i := 0; i < len(*vector); i++ {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE2) InnerProduct(other VectorE2) (res E2) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp E2
+	for i := 0; i < len(*vector); i++ {
+		tmp.Mul(&(*vector)[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+
+// Len is the number of elements in the collection.
+func (vector VectorE2) Len() int {
+	return len(vector)
+}
@@ -0,0 +1,462 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE2ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE2()
+	genB := genE2()
+
+	properties.Property("[E2] Having the receiver as operand (addition) should output the same result", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			d.Set(a)
+			c.Add(a, b)
+			a.Add(a, b)
+			b.Add(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (sub) should output the same result", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			d.Set(a)
+			c.Sub(a, b)
+			a.Sub(a, b)
+			b.Sub(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (neg) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Neg(a)
+			a.Neg(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (double) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Double(a)
+			a.Double(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (frobenius) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE2Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE2()
+	genB := genE2()
+	genE := genElement()
+
+	properties.Property("[E2] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] mul should match the schoolbook multiplication mod u² - 7", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Mul(a, b)
+			d := mulE2Schoolbook(a, b)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] mul should be commutative", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Mul(a, b)
+			d.Mul(b, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] square and mul should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] double and add should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Add(a, a)
+			c.Double(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] mulByElement should match mul by an embedded element", prop.ForAll(
+		func(a *E2, e goldilocks.Element) bool {
+			var b, c E2
+			b.A0 = e
+			b.Mul(a, &b)
+			c.MulByElement(a, &e)
+			return b.Equal(&c)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[E2] x⋅x⁻¹ should be 1", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Inverse(a).Mul(&b, a)
+			return a.IsZero() || b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E2] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] div & mul should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Div(a, b).Mul(&c, b)
+			return b.IsZero() || c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] frobenius should match exp by p", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Frobenius(a)
+			c.Exp(*a, goldilocks.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] norm should be the product of the conjugates", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			c.Set(a)
+			b.Frobenius(a)
+			c.Mul(&c, &b)
+			n := a.Norm()
+			b.SetZero()
+			b.A0 = n
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] exp by a negative exponent should match the inverse of exp", prop.ForAll(
+		func(a *E2, k int64) bool {
+			var b, c E2
+			e := big.NewInt(k)
+			b.Exp(*a, e)
+			c.Exp(*a, e.Neg(e)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(int64(genParams.NextUint64()>>1), gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[E2] exp should be a morphism", prop.ForAll(
+		func(a *E2, k, l uint32) bool {
+			var b, c, d E2
+			b.Exp(*a, new(big.Int).SetUint64(uint64(k)))
+			c.Exp(*a, new(big.Int).SetUint64(uint64(l)))
+			b.Mul(&b, &c)
+			d.Exp(*a, new(big.Int).SetUint64(uint64(k)+uint64(l)))
+			return b.Equal(&d)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}).Map(func(v uint64) uint32 { return uint32(v) }),
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}).Map(func(v uint64) uint32 { return uint32(v) }),
+	))
+
+	properties.Property("[E2] select should pick the right operand", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Select(0, a, b)
+			d.Select(1, a, b)
+			return c.Equal(a) && d.Equal(b)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestBatchInvertE2(t *testing.T) {
+	const n = 10
+	a := make([]E2, n)
+	for i := 0; i < n; i++ {
+		if i == n/2 {
+			continue // test the handling of zeroes
+		}
+		if _, err := a[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res := BatchInvertE2(a)
+
+	for i := 0; i < n; i++ {
+		var expected E2
+		expected.Inverse(&a[i])
+		if !res[i].Equal(&expected) {
+			t.Fatalf("BatchInvertE2: wrong inverse at index %d", i)
+		}
+	}
+}
+
+func TestVectorE2Ops(t *testing.T) {
+	const n = 10
+	a, b := randomVectorE2(t, n), randomVectorE2(t, n)
+	var s E2
+	if _, err := s.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	var e goldilocks.Element
+	if _, err := e.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, diff, prod, scaled, scaledByElement := make(VectorE2, n), make(VectorE2, n), make(VectorE2, n), make(VectorE2, n), make(VectorE2, n)
+	sum.Add(a, b)
+	diff.Sub(a, b)
+	prod.Mul(a, b)
+	scaled.ScalarMul(a, &s)
+	scaledByElement.MulByElement(a, &e)
+
+	var expectedSum, expectedInnerProduct E2
+	for i := 0; i < n; i++ {
+		var tmp E2
+		if !sum[i].Equal(tmp.Add(&a[i], &b[i])) {
+			t.Fatalf("VectorE2.Add: wrong result at index %d", i)
+		}
+		if !diff[i].Equal(tmp.Sub(&a[i], &b[i])) {
+			t.Fatalf("VectorE2.Sub: wrong result at index %d", i)
+		}
+		if !prod[i].Equal(tmp.Mul(&a[i], &b[i])) {
+			t.Fatalf("VectorE2.Mul: wrong result at index %d", i)
+		}
+		if !scaled[i].Equal(tmp.Mul(&a[i], &s)) {
+			t.Fatalf("VectorE2.ScalarMul: wrong result at index %d", i)
+		}
+		if !scaledByElement[i].Equal(tmp.MulByElement(&a[i], &e)) {
+			t.Fatalf("VectorE2.MulByElement: wrong result at index %d", i)
+		}
+		expectedSum.Add(&expectedSum, &a[i])
+		expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+	}
+
+	if s := a.Sum(); !s.Equal(&expectedSum) {
+		t.Fatal("VectorE2.Sum: wrong result")
+	}
+	if ip := a.InnerProduct(b); !ip.Equal(&expectedInnerProduct) {
+		t.Fatal("VectorE2.InnerProduct: wrong result")
+	}
+	if a.Len() != n {
+		t.Fatal("VectorE2.Len: wrong result")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE2Mul(b *testing.B) {
+	var x, y E2
+	_, _ = x.SetRandom()
+	_, _ = y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE2Square(b *testing.B) {
+	var x E2
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func BenchmarkE2Inverse(b *testing.B) {
+	var x E2
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+// ------------------------------------------------------------
+// helpers
+
+func genE2() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var z E2
+		z.A0.SetUint64(genParams.NextUint64())
+		z.A1.SetUint64(genParams.NextUint64())
+		return gopter.NewGenResult(&z, gopter.NoShrinker)
+	}
+}
+
+func randomVectorE2(t *testing.T, n int) VectorE2 {
+	v := make(VectorE2, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return v
+}
+
+// mulE2Schoolbook is a reference multiplication, reducing the schoolbook
+// product of the polynomials x and y modulo u² - 7
+func mulE2Schoolbook(x, y *E2) E2 {
+	a := [2]goldilocks.Element{x.A0, x.A1}
+	b := [2]goldilocks.Element{y.A0, y.A1}
+	var c [2*2 - 1]goldilocks.Element
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			var t goldilocks.Element
+			t.Mul(&a[i], &b[j])
+			c[i+j].Add(&c[i+j], &t)
+		}
+	}
+	for i := 2*2 - 2; i >= 2; i-- {
+		var t goldilocks.Element
+		t.Mul(&c[i], &nonResidueE2)
+		c[i-2].Add(&c[i-2], &t)
+	}
+	return E2{A0: c[0], A1: c[1]}
+}
@@ -0,0 +1,433 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E3 is a degree 3 extension of goldilocks.Element, as goldilocks[u]/(u³ - 7).
+//
+// The element A0 + A1⋅u + A2⋅u² is
+// stored as its coordinates in the basis (1, u, u²).
+type E3 struct {
+	A0, A1, A2 goldilocks.Element
+}
+
+// nonResidueE3 is u³ = 7
+var nonResidueE3 = goldilocks.Element{30064771065}
+
+// frobeniusCoefficientsE3[i] = 7^(i(p-1)/3), so that (uⁱ)ᵖ = frobeniusCoefficientsE3[i]⋅uⁱ
+var frobeniusCoefficientsE3 = [3]goldilocks.Element{
+	{4294967295},
+	{1},
+	{18446744065119617025},
+}
+
+// mulByNonResidueE3 sets z = 7⋅x
+func mulByNonResidueE3(z, x *goldilocks.Element) {
+	z.Mul(x, &nonResidueE3)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E3) SetZero() *E3 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E3) SetOne() *E3 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E3) Set(x *E3) *E3 {
+	*z = *x
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E3) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u" + "+" + z.A2.String() + "*u²"
+}
+
+// MulByElement sets z = x⋅y, y in the base field, and returns z
+func (z *E3) MulByElement(x *E3, y *goldilocks.Element) *E3 {
+	yCopy := *y
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// Mul sets z = x⋅y and returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Karatsuba, see https://eprint.iacr.org/2006/471.pdf, section 4
+	var v0, v1, v2, t0, t1, c0, c1, c2 goldilocks.Element
+	v0.Mul(&x.A0, &y.A0)
+	v1.Mul(&x.A1, &y.A1)
+	v2.Mul(&x.A2, &y.A2)
+
+	// c₀ = v₀ + 7((x₁ + x₂)(y₁ + y₂) - v₁ - v₂)
+	t0.Add(&x.A1, &x.A2)
+	t1.Add(&y.A1, &y.A2)
+	c0.Mul(&t0, &t1).Sub(&c0, &v1).Sub(&c0, &v2)
+	mulByNonResidueE3(&c0, &c0)
+	c0.Add(&c0, &v0)
+
+	// c₁ = (x₀ + x₁)(y₀ + y₁) - v₀ - v₁ + 7v₂
+	t0.Add(&x.A0, &x.A1)
+	t1.Add(&y.A0, &y.A1)
+	c1.Mul(&t0, &t1).Sub(&c1, &v0).Sub(&c1, &v1)
+	mulByNonResidueE3(&t0, &v2)
+	c1.Add(&c1, &t0)
+
+	// c₂ = (x₀ + x₂)(y₀ + y₂) - v₀ + v₁ - v₂
+	t0.Add(&x.A0, &x.A2)
+	t1.Add(&y.A0, &y.A2)
+	c2.Mul(&t0, &t1).Sub(&c2, &v0).Add(&c2, &v1).Sub(&c2, &v2)
+
+	z.A0, z.A1, z.A2 = c0, c1, c2
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E3) Square(x *E3) *E3 {
+	// CH-SQR2, see https://eprint.iacr.org/2006/471.pdf, section 4
+	var s0, s1, s2, s3, s4 goldilocks.Element
+	s0.Square(&x.A0)
+	s1.Mul(&x.A0, &x.A1).Double(&s1)
+	s2.Sub(&x.A0, &x.A1).Add(&s2, &x.A2).Square(&s2)
+	s3.Mul(&x.A1, &x.A2).Double(&s3)
+	s4.Square(&x.A2)
+
+	// z₂ = s₁ + s₂ + s₃ - s₀ - s₄
+	z.A2.Add(&s1, &s2).Add(&z.A2, &s3).Sub(&z.A2, &s0).Sub(&z.A2, &s4)
+	// z₀ = s₀ + 7s₃
+	mulByNonResidueE3(&s3, &s3)
+	z.A0.Add(&s0, &s3)
+	// z₁ = s₁ + 7s₄
+	mulByNonResidueE3(&s4, &s4)
+	z.A1.Add(&s1, &s4)
+	return z
+}
+
+// adjugate returns the coordinates of x⋅N(x)/x, N being the norm
+func (z *E3) adjugate() (c0, c1, c2 goldilocks.Element) {
+	var t goldilocks.Element
+
+	// c₀ = x₀² - 7x₁x₂
+	c0.Square(&z.A0)
+	t.Mul(&z.A1, &z.A2)
+	mulByNonResidueE3(&t, &t)
+	c0.Sub(&c0, &t)
+
+	// c₁ = 7x₂² - x₀x₁
+	c1.Square(&z.A2)
+	mulByNonResidueE3(&c1, &c1)
+	t.Mul(&z.A0, &z.A1)
+	c1.Sub(&c1, &t)
+
+	// c₂ = x₁² - x₀x₂
+	c2.Square(&z.A1)
+	t.Mul(&z.A0, &z.A2)
+	c2.Sub(&c2, &t)
+	return
+}
+
+// norm returns the norm of x from its adjugate
+func (z *E3) norm(c0, c1, c2 *goldilocks.Element) goldilocks.Element {
+	// N(x) = x₀c₀ + 7(x₂c₁ + x₁c₂)
+	var n, t goldilocks.Element
+	n.Mul(&z.A2, c1)
+	t.Mul(&z.A1, c2)
+	n.Add(&n, &t)
+	mulByNonResidueE3(&n, &n)
+	t.Mul(&z.A0, c0)
+	n.Add(&n, &t)
+	return n
+}
+
+// Norm returns the norm x⋅xᵖ⋅xᵖ² of x, in the base field
+func (z *E3) Norm() goldilocks.Element {
+	c0, c1, c2 := z.adjugate()
+	return z.norm(&c0, &c1, &c2)
+}
+
+// Inverse sets z = 1/x and returns z. If x is 0, z is set to 0.
+func (z *E3) Inverse(x *E3) *E3 {
+	c0, c1, c2 := x.adjugate()
+	n := x.norm(&c0, &c1, &c2)
+	n.Inverse(&n)
+	z.A0.Mul(&c0, &n)
+	z.A1.Mul(&c1, &n)
+	z.A2.Mul(&c2, &n)
+	return z
+}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobeniusCoefficientsE3[1])
+	z.A2.Mul(&x.A2, &frobeniusCoefficientsE3[2])
+	return z
+}
+
+// FrobeniusSquare sets z = xᵖ² and returns z
+func (z *E3) FrobeniusSquare(x *E3) *E3 {
+	// with γᵢ = frobeniusCoefficientsE3[i], uᵖ² = γ₁²u = γ₂u and
+	// (u²)ᵖ² = γ₂²u² = γ₁u², since γ₁³ = 1
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobeniusCoefficientsE3[2])
+	z.A2.Mul(&x.A2, &frobeniusCoefficientsE3[1])
+	return z
+}
+
+// Div sets z = x/y and returns z
+func (z *E3) Div(x, y *E3) *E3 {
+	var r E3
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Select is conditional move.
+// If cond = 0, it sets z to caseZ and returns it. otherwise caseNz.
+func (z *E3) Select(cond int, caseZ *E3, caseNz *E3) *E3 {
+	z.A0.Select(cond, &caseZ.A0, &caseNz.A0)
+	z.A1.Select(cond, &caseZ.A1, &caseNz.A1)
+	z.A2.Select(cond, &caseZ.A2, &caseNz.A2)
+	return z
+}
+
+// BatchInvertE3 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// VectorE3 represents a slice of E3.
+type VectorE3 []E3
+
+// Add adds two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE3) Add(a, b VectorE3) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Add(&a[i], &b[i])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE3) Sub(a, b VectorE3) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Sub(&a[i], &b[i])
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE3) Mul(a, b VectorE3) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE3) ScalarMul(a VectorE3, b *E3) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], b)
+	}
+}
+
+// MulByElement multiplies a vector by an element of the base field element-wise
+// and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE3) MulByElement(a VectorE3, b *goldilocks.Element) {
+	if len(a) != len(*vector) {
+		panic("vector.MulByElement: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].MulByElement(&a[i], b)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *VectorE3) Sum() (res E3) {
+	for i := 0; i < len(*vector); i++ {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE3) InnerProduct(other VectorE3) (res E3) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp E3
+	for i := 0; i < len(*vector); i++ {
+		tmp.Mul(&(*vector)[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+
+// Len is the number of elements in the collection.
+func (vector VectorE3) Len() int {
+	return len(vector)
+}
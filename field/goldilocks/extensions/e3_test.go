@@ -0,0 +1,475 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE3ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE3()
+	genB := genE3()
+
+	properties.Property("[E3] Having the receiver as operand (addition) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Add(a, b)
+			a.Add(a, b)
+			b.Add(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (sub) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Sub(a, b)
+			a.Sub(a, b)
+			b.Sub(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (neg) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Neg(a)
+			a.Neg(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (double) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Double(a)
+			a.Double(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (frobenius) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE3Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE3()
+	genB := genE3()
+	genE := genElement()
+
+	properties.Property("[E3] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] mul should match the schoolbook multiplication mod u³ - 7", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Mul(a, b)
+			d := mulE3Schoolbook(a, b)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] mul should be commutative", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.Mul(a, b)
+			d.Mul(b, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] double and add should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Add(a, a)
+			c.Double(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] mulByElement should match mul by an embedded element", prop.ForAll(
+		func(a *E3, e goldilocks.Element) bool {
+			var b, c E3
+			b.A0 = e
+			b.Mul(a, &b)
+			c.MulByElement(a, &e)
+			return b.Equal(&c)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[E3] x⋅x⁻¹ should be 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Mul(&b, a)
+			return a.IsZero() || b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E3] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] div & mul should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Div(a, b).Mul(&c, b)
+			return b.IsZero() || c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] frobenius should match exp by p", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Frobenius(a)
+			c.Exp(*a, goldilocks.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] frobeniusSquare should match frobenius twice", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.FrobeniusSquare(a)
+			c.Frobenius(a).Frobenius(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] norm should be the product of the conjugates", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			c.Set(a)
+			b.Frobenius(a)
+			c.Mul(&c, &b)
+			b.FrobeniusSquare(a)
+			c.Mul(&c, &b)
+			n := a.Norm()
+			b.SetZero()
+			b.A0 = n
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] exp by a negative exponent should match the inverse of exp", prop.ForAll(
+		func(a *E3, k int64) bool {
+			var b, c E3
+			e := big.NewInt(k)
+			b.Exp(*a, e)
+			c.Exp(*a, e.Neg(e)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(int64(genParams.NextUint64()>>1), gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[E3] exp should be a morphism", prop.ForAll(
+		func(a *E3, k, l uint32) bool {
+			var b, c, d E3
+			b.Exp(*a, new(big.Int).SetUint64(uint64(k)))
+			c.Exp(*a, new(big.Int).SetUint64(uint64(l)))
+			b.Mul(&b, &c)
+			d.Exp(*a, new(big.Int).SetUint64(uint64(k)+uint64(l)))
+			return b.Equal(&d)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}).Map(func(v uint64) uint32 { return uint32(v) }),
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}).Map(func(v uint64) uint32 { return uint32(v) }),
+	))
+
+	properties.Property("[E3] select should pick the right operand", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.Select(0, a, b)
+			d.Select(1, a, b)
+			return c.Equal(a) && d.Equal(b)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestBatchInvertE3(t *testing.T) {
+	const n = 10
+	a := make([]E3, n)
+	for i := 0; i < n; i++ {
+		if i == n/2 {
+			continue // test the handling of zeroes
+		}
+		if _, err := a[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res := BatchInvertE3(a)
+
+	for i := 0; i < n; i++ {
+		var expected E3
+		expected.Inverse(&a[i])
+		if !res[i].Equal(&expected) {
+			t.Fatalf("BatchInvertE3: wrong inverse at index %d", i)
+		}
+	}
+}
+
+func TestVectorE3Ops(t *testing.T) {
+	const n = 10
+	a, b := randomVectorE3(t, n), randomVectorE3(t, n)
+	var s E3
+	if _, err := s.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	var e goldilocks.Element
+	if _, err := e.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, diff, prod, scaled, scaledByElement := make(VectorE3, n), make(VectorE3, n), make(VectorE3, n), make(VectorE3, n), make(VectorE3, n)
+	sum.Add(a, b)
+	diff.Sub(a, b)
+	prod.Mul(a, b)
+	scaled.ScalarMul(a, &s)
+	scaledByElement.MulByElement(a, &e)
+
+	var expectedSum, expectedInnerProduct E3
+	for i := 0; i < n; i++ {
+		var tmp E3
+		if !sum[i].Equal(tmp.Add(&a[i], &b[i])) {
+			t.Fatalf("VectorE3.Add: wrong result at index %d", i)
+		}
+		if !diff[i].Equal(tmp.Sub(&a[i], &b[i])) {
+			t.Fatalf("VectorE3.Sub: wrong result at index %d", i)
+		}
+		if !prod[i].Equal(tmp.Mul(&a[i], &b[i])) {
+			t.Fatalf("VectorE3.Mul: wrong result at index %d", i)
+		}
+		if !scaled[i].Equal(tmp.Mul(&a[i], &s)) {
+			t.Fatalf("VectorE3.ScalarMul: wrong result at index %d", i)
+		}
+		if !scaledByElement[i].Equal(tmp.MulByElement(&a[i], &e)) {
+			t.Fatalf("VectorE3.MulByElement: wrong result at index %d", i)
+		}
+		expectedSum.Add(&expectedSum, &a[i])
+		expectedInnerProduct.Add(&expectedInnerProduct, tmp.Mul(&a[i], &b[i]))
+	}
+
+	if s := a.Sum(); !s.Equal(&expectedSum) {
+		t.Fatal("VectorE3.Sum: wrong result")
+	}
+	if ip := a.InnerProduct(b); !ip.Equal(&expectedInnerProduct) {
+		t.Fatal("VectorE3.InnerProduct: wrong result")
+	}
+	if a.Len() != n {
+		t.Fatal("VectorE3.Len: wrong result")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE3Mul(b *testing.B) {
+	var x, y E3
+	_, _ = x.SetRandom()
+	_, _ = y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE3Square(b *testing.B) {
+	var x E3
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func BenchmarkE3Inverse(b *testing.B) {
+	var x E3
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+// ------------------------------------------------------------
+// helpers
+
+func genE3() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var z E3
+		z.A0.SetUint64(genParams.NextUint64())
+		z.A1.SetUint64(genParams.NextUint64())
+		z.A2.SetUint64(genParams.NextUint64())
+		return gopter.NewGenResult(&z, gopter.NoShrinker)
+	}
+}
+
+func randomVectorE3(t *testing.T, n int) VectorE3 {
+	v := make(VectorE3, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return v
+}
+
+// mulE3Schoolbook is a reference multiplication, reducing the schoolbook
+// product of the polynomials x and y modulo u³ - 7
+func mulE3Schoolbook(x, y *E3) E3 {
+	a := [3]goldilocks.Element{x.A0, x.A1, x.A2}
+	b := [3]goldilocks.Element{y.A0, y.A1, y.A2}
+	var c [2*3 - 1]goldilocks.Element
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var t goldilocks.Element
+			t.Mul(&a[i], &b[j])
+			c[i+j].Add(&c[i+j], &t)
+		}
+	}
+	for i := 2*3 - 2; i >= 3; i-- {
+		var t goldilocks.Element
+		t.Mul(&c[i], &nonResidueE3)
+		c[i-3].Add(&c[i-3], &t)
+	}
+	return E3{A0: c[0], A1: c[1], A2: c[2]}
+}
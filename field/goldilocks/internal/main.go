@@ -18,4 +18,13 @@ func main() {
 		panic(err)
 	}
 	fmt.Println("successfully generated goldilocks field")
+
+	// 7 generates 𝔽*, and 2 and 3 divide p-1, so it is neither a square nor a cube
+	const rootOf = 7
+	e2 := config.NewTower(goldilocks, 2, rootOf)
+	e3 := config.NewTower(goldilocks, 3, rootOf)
+	if err := generator.GenerateExtensions(goldilocks, "github.com/consensys/gnark-crypto/field/goldilocks", "../extensions", e2, e3); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated goldilocks extensions")
 }
@@ -0,0 +1,137 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circlefft
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+	assert := require.New(t)
+
+	g, err := Generator(LogOrder)
+	assert.NoError(err)
+	assert.True(g.IsOnCircle())
+
+	// g has order exactly 2³¹
+	var minusOne, identity, p Point
+	minusOne.X.SetInt64(-1)
+	identity.SetIdentity()
+	p.ScalarMul(&g, 1<<30)
+	assert.True(p.Equal(&minusOne))
+	p.Double(&p)
+	assert.True(p.Equal(&identity))
+
+	_, err = Generator(LogOrder + 1)
+	assert.Error(err)
+}
+
+func TestPointArithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	g, _ := Generator(LogOrder)
+	var a, b, c, d Point
+	a.ScalarMul(&g, 123456789)
+	b.ScalarMul(&g, 987654321)
+	assert.True(a.IsOnCircle() && b.IsOnCircle())
+
+	// aᵏ⋅bᵏ = (a⋅b)ᵏ
+	c.Add(&a, &b).ScalarMul(&c, 42)
+	a.ScalarMul(&a, 42)
+	b.ScalarMul(&b, 42)
+	d.Add(&a, &b)
+	assert.True(c.Equal(&d))
+
+	// a⋅a⁻¹ = 1
+	var identity Point
+	identity.SetIdentity()
+	d.Conjugate(&a).Add(&d, &a)
+	assert.True(d.Equal(&identity))
+
+	// a² = a⋅a
+	c.Double(&a)
+	d.Add(&a, &a)
+	assert.True(c.Equal(&d))
+}
+
+func TestFFT(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []uint64{2, 4, 8, 32, 256} {
+		domain := NewDomain(n)
+		assert.Equal(n, domain.Cardinality)
+
+		coeffs := make([]mersenne31.Element, n)
+		for i := range coeffs {
+			coeffs[i].SetRandom()
+		}
+
+		evals := make([]mersenne31.Element, n)
+		copy(evals, coeffs)
+		domain.FFT(evals)
+
+		// the evaluations match the direct evaluation in the circle FFT basis
+		for i := uint64(0); i < n; i++ {
+			p := domain.Point(i)
+			assert.True(p.IsOnCircle())
+			expected := Evaluate(coeffs, p)
+			assert.True(evals[i].Equal(&expected), "n = %d, i = %d", n, i)
+		}
+
+		// the inverse FFT interpolates
+		domain.FFTInverse(evals)
+		for i := range coeffs {
+			assert.True(evals[i].Equal(&coeffs[i]), "n = %d, i = %d", n, i)
+		}
+	}
+}
+
+func TestDomainIsStandardPosition(t *testing.T) {
+	assert := require.New(t)
+
+	// the domain is stable by conjugation: the conjugate of Pᵢ is Pₙ₋₁₋ᵢ
+	const n = 64
+	domain := NewDomain(n)
+	for i := uint64(0); i < n; i++ {
+		var conj Point
+		p := domain.Point(i)
+		q := domain.Point(n - 1 - i)
+		conj.Conjugate(&p)
+		assert.True(conj.Equal(&q))
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const n = 1 << 16
+	domain := NewDomain(n)
+	a := make([]mersenne31.Element, n)
+	for i := range a {
+		a[i].SetRandom()
+	}
+
+	b.Run("FFT", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.FFT(a)
+		}
+	})
+	b.Run("FFTInverse", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.FFTInverse(a)
+		}
+	})
+}
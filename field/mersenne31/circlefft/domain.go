@@ -0,0 +1,239 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circlefft
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// Domain is a standard position coset D = Shift⋅⟨Generator⟩ of the circle group,
+// with a power of 2 cardinality N, Generator being of order N and Shift of order 2N.
+//
+// The i-th point of the domain is Pᵢ = Shift⋅Generatorⁱ = Shift²ⁱ⁺¹; D is stable
+// by conjugation (x, y) ↦ (x, -y), which maps Pᵢ to Pₙ₋₁₋ᵢ.
+//
+// Polynomials are represented by their N coefficients cⱼ in the circle FFT basis
+//
+//	bⱼ(x, y) = yʲ⁰ ⋅ xʲ¹ ⋅ π(x)ʲ² ⋅ π²(x)ʲ³ ⋯
+//
+// where jₖ is the k-th bit of j and π(x) = 2x² - 1 is the x-coordinate of the
+// doubling map. The span of the bⱼ has the same dimension as the space of
+// functions over D.
+type Domain struct {
+	Cardinality    uint64
+	CardinalityInv mersenne31.Element
+	Generator      Point // generator of the subgroup of order N
+	Shift          Point // element of order 2N, D = Shift⋅⟨Generator⟩
+
+	// twiddles[0][i] = y(Pᵢ), for i < N/2
+	// twiddles[k][i] = x(πᵏ⁻¹(Pᵢ)), for k ⩾ 1 and i < N/2ᵏ⁺¹
+	twiddles    [][]mersenne31.Element
+	twiddlesInv [][]mersenne31.Element
+}
+
+// NewDomain returns a standard position coset of the circle group of cardinality
+// the smallest power of 2 ⩾ m, and at least 2.
+//
+// It panics if m > 2³⁰.
+func NewDomain(m uint64) *Domain {
+	n := ecc.NextPowerOfTwo(m)
+	if n < 2 {
+		n = 2
+	}
+	logN := uint64(bits.TrailingZeros64(n))
+	if logN >= LogOrder {
+		panic(fmt.Sprintf("m (%d) is too big: the circle group has order 2³¹", m))
+	}
+
+	domain := &Domain{Cardinality: n}
+	domain.CardinalityInv.SetUint64(n).Inverse(&domain.CardinalityInv)
+	domain.Generator, _ = Generator(logN)
+	domain.Shift, _ = Generator(logN + 1)
+
+	domain.preComputeTwiddles()
+
+	return domain
+}
+
+// Point returns the i-th point Shift⋅Generatorⁱ of the domain
+func (d *Domain) Point(i uint64) Point {
+	var p Point
+	p.ScalarMul(&d.Generator, i%d.Cardinality)
+	return *p.Add(&p, &d.Shift)
+}
+
+// preComputeTwiddles computes the coordinates of the points of the successive
+// images of the domain by the doubling map, on which the layers of the FFT
+// operate
+func (d *Domain) preComputeTwiddles() {
+	logN := bits.TrailingZeros64(d.Cardinality)
+	d.twiddles = make([][]mersenne31.Element, logN)
+	d.twiddlesInv = make([][]mersenne31.Element, logN)
+
+	// the points of the first two layers are the Pᵢ = shift⋅gⁱ; the points of the
+	// next ones are their successive images by the doubling map
+	shift, g := d.Shift, d.Generator
+	for k := 0; k < logN; k++ {
+		if k >= 2 {
+			shift.Double(&shift)
+			g.Double(&g)
+		}
+		half := d.Cardinality >> (k + 1)
+		d.twiddles[k] = make([]mersenne31.Element, half)
+		p := shift
+		for i := uint64(0); i < half; i++ {
+			if k == 0 {
+				d.twiddles[k][i] = p.Y
+			} else {
+				d.twiddles[k][i] = p.X
+			}
+			p.Add(&p, &g)
+		}
+		d.twiddlesInv[k] = mersenne31.BatchInvert(d.twiddles[k])
+	}
+}
+
+// FFT computes the evaluations on the domain of the polynomial whose coefficients
+// in the circle FFT basis are a, in place: a[i] is set to the evaluation at the
+// i-th point of the domain.
+//
+// It panics if len(a) differs from the cardinality of the domain.
+func (d *Domain) FFT(a []mersenne31.Element) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("circlefft: the size of the input must match the cardinality of the domain")
+	}
+	BitReverse(a)
+
+	// a[i] = f₀(Pᵢ) + t⋅f₁(Pᵢ), a[L-1-i] = f₀(Pᵢ) - t⋅f₁(Pᵢ), where t = y(Pᵢ) on
+	// the first layer and x(Pᵢ) on the others; f₀ and f₁ are the halves of the block.
+	n := len(a)
+	for k := len(d.twiddles) - 1; k >= 0; k-- {
+		tw := d.twiddles[k]
+		l := n >> k
+		h := l / 2
+		for s := 0; s < n; s += l {
+			b := a[s : s+l]
+			if l == 2 {
+				var t mersenne31.Element
+				t.Mul(&b[1], &tw[0])
+				b[1].Sub(&b[0], &t)
+				b[0].Add(&b[0], &t)
+				continue
+			}
+			// the pairs (i, l-1-i) and (h-1-i, h+i) are processed together, so
+			// that the transform can be done in place
+			for i := 0; i < h/2; i++ {
+				j := h - 1 - i
+				var ti, tj mersenne31.Element
+				e0i, e0j := b[i], b[j]
+				ti.Mul(&b[h+i], &tw[i])
+				tj.Mul(&b[l-1-i], &tw[j])
+				b[i].Add(&e0i, &ti)
+				b[l-1-i].Sub(&e0i, &ti)
+				b[j].Add(&e0j, &tj)
+				b[h+i].Sub(&e0j, &tj)
+			}
+		}
+	}
+}
+
+// FFTInverse computes the coefficients in the circle FFT basis of the polynomial
+// whose evaluations on the domain are a, in place.
+//
+// It panics if len(a) differs from the cardinality of the domain.
+func (d *Domain) FFTInverse(a []mersenne31.Element) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("circlefft: the size of the input must match the cardinality of the domain")
+	}
+
+	// f₀(Pᵢ) = (a[i] + a[l-1-i]) / 2 and f₁(Pᵢ) = (a[i] - a[l-1-i]) / 2t, where t = y(Pᵢ)
+	// on the first layer and x(Pᵢ) on the others; f₀ and f₁ are stored in the two
+	// halves of the block, and the divisions by 2 are postponed.
+	n := len(a)
+	for k := 0; k < len(d.twiddlesInv); k++ {
+		tw := d.twiddlesInv[k]
+		l := n >> k
+		h := l / 2
+		for s := 0; s < n; s += l {
+			b := a[s : s+l]
+			if l == 2 {
+				u, v := b[0], b[1]
+				b[0].Add(&u, &v)
+				b[1].Sub(&u, &v).Mul(&b[1], &tw[0])
+				continue
+			}
+			for i := 0; i < h/2; i++ {
+				j := h - 1 - i
+				u0, v0 := b[i], b[l-1-i]
+				u1, v1 := b[j], b[h+i]
+				b[i].Add(&u0, &v0)
+				b[h+i].Sub(&u0, &v0).Mul(&b[h+i], &tw[i])
+				b[j].Add(&u1, &v1)
+				b[l-1-i].Sub(&u1, &v1).Mul(&b[l-1-i], &tw[j])
+			}
+		}
+	}
+
+	for i := range a {
+		a[i].Mul(&a[i], &d.CardinalityInv)
+	}
+	BitReverse(a)
+}
+
+// Evaluate returns the evaluation at p of the polynomial whose coefficients in
+// the circle FFT basis are coeffs. len(coeffs) must be a power of 2.
+func Evaluate(coeffs []mersenne31.Element, p Point) mersenne31.Element {
+	if len(coeffs) == 0 {
+		return mersenne31.Element{}
+	}
+	if bits.OnesCount(uint(len(coeffs))) != 1 {
+		panic("circlefft: the number of coefficients must be a power of 2")
+	}
+	folded := make([]mersenne31.Element, len(coeffs))
+	copy(folded, coeffs)
+
+	// fold the lowest bit of the index at each step: y first, then x, π(x), π²(x)…
+	v := p.Y
+	x := p.X
+	one := mersenne31.One()
+	for n := len(folded); n > 1; n /= 2 {
+		for i := 0; i < n/2; i++ {
+			var t mersenne31.Element
+			t.Mul(&folded[2*i+1], &v)
+			folded[i].Add(&folded[2*i], &t)
+		}
+		v = x
+		x.Square(&x).Double(&x).Sub(&x, &one)
+	}
+	return folded[0]
+}
+
+// BitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2
+func BitReverse(a []mersenne31.Element) {
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		irev := bits.Reverse64(i) >> nn
+		if irev > i {
+			a[i], a[irev] = a[irev], a[i]
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package circlefft provides the discrete Fourier transform over the circle
+// group of the Mersenne-31 field.
+//
+// The multiplicative group of 𝔽ₚ, p = 2³¹ - 1, has 2-adicity 1, so there is no
+// classical radix-2 FFT over it. The circle x² + y² = 1 over 𝔽ₚ is a cyclic
+// group of order p + 1 = 2³¹ though, and the circle FFT of Haböck, Levit and
+// Papini (https://eprint.iacr.org/2024/278) interpolates functions over its
+// subgroups' cosets.
+package circlefft
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// LogOrder is log₂ of the order p + 1 of the circle group
+const LogOrder = 31
+
+// Point is a point (X, Y) of the circle X² + Y² = 1 over 𝔽ₚ.
+//
+// The group law is (x₀, y₀)⋅(x₁, y₁) = (x₀x₁ - y₀y₁, x₀y₁ + y₀x₁), with identity (1, 0):
+// it is the multiplication of the complex numbers x + iy of norm 1.
+type Point struct {
+	X, Y mersenne31.Element
+}
+
+// generator of the circle group, of order 2³¹
+var generator = Point{
+	X: mersenne31.NewElement(2),
+	Y: mersenne31.NewElement(1268011823),
+}
+
+// Generator returns a generator of the subgroup of order 2ˡᵒᵍᴼʳᵈᵉʳ of the circle
+// group, for logOrder ⩽ 31
+func Generator(logOrder uint64) (Point, error) {
+	if logOrder > LogOrder {
+		return Point{}, errors.New("the circle group has order 2³¹")
+	}
+	g := generator
+	for i := logOrder; i < LogOrder; i++ {
+		g.Double(&g)
+	}
+	return g, nil
+}
+
+// SetIdentity sets p to (1, 0) and returns p
+func (p *Point) SetIdentity() *Point {
+	p.X.SetOne()
+	p.Y.SetZero()
+	return p
+}
+
+// Equal returns true if p equals q
+func (p *Point) Equal(q *Point) bool {
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
+}
+
+// IsOnCircle returns true if X² + Y² = 1
+func (p *Point) IsOnCircle() bool {
+	var x2, y2 mersenne31.Element
+	x2.Square(&p.X)
+	y2.Square(&p.Y)
+	x2.Add(&x2, &y2)
+	return x2.IsOne()
+}
+
+// Add sets p = a⋅b and returns p
+func (p *Point) Add(a, b *Point) *Point {
+	var x, y, t mersenne31.Element
+	x.Mul(&a.X, &b.X)
+	t.Mul(&a.Y, &b.Y)
+	x.Sub(&x, &t)
+	y.Mul(&a.X, &b.Y)
+	t.Mul(&a.Y, &b.X)
+	y.Add(&y, &t)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Double sets p = a² = (2x² - 1, 2xy) and returns p
+func (p *Point) Double(a *Point) *Point {
+	var x, y mersenne31.Element
+	one := mersenne31.One()
+	x.Square(&a.X).Double(&x).Sub(&x, &one)
+	y.Mul(&a.X, &a.Y).Double(&y)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Conjugate sets p = a⁻¹ = (x, -y) and returns p
+func (p *Point) Conjugate(a *Point) *Point {
+	p.X = a.X
+	p.Y.Neg(&a.Y)
+	return p
+}
+
+// ScalarMul sets p = aᵏ and returns p
+func (p *Point) ScalarMul(a *Point, k uint64) *Point {
+	var res Point
+	res.SetIdentity()
+	base := *a
+	for ; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			res.Add(&res, &base)
+		}
+		base.Double(&base)
+	}
+	*p = res
+	return p
+}
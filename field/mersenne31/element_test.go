@@ -0,0 +1,494 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mersenne31
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------------------------------------------------------------------------------------
+// benchmarks
+
+var benchResElement Element
+
+func BenchmarkElementMul(b *testing.B) {
+	x := Element{4}
+	benchResElement.SetOne()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Mul(&benchResElement, &x)
+	}
+}
+
+func BenchmarkElementAdd(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Add(&x, &benchResElement)
+	}
+}
+
+func BenchmarkElementInverse(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Inverse(&x)
+	}
+}
+
+func BenchmarkElementSqrt(b *testing.B) {
+	var a Element
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Sqrt(&a)
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// Gopter tests
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+func TestElementCmp(t *testing.T) {
+	var x, y Element
+
+	if x.Cmp(&y) != 0 {
+		t.Fatal("x == y")
+	}
+
+	one := One()
+	y.Sub(&y, &one)
+
+	if x.Cmp(&y) != -1 {
+		t.Fatal("x < y")
+	}
+	if y.Cmp(&x) != 1 {
+		t.Fatal("x < y")
+	}
+}
+
+func TestElementNegZero(t *testing.T) {
+	var a, b Element
+	b.SetZero()
+	for a.IsZero() {
+		a.SetRandom()
+	}
+	a.Neg(&b)
+	if !a.IsZero() {
+		t.Fatal("neg(0) != 0")
+	}
+}
+
+func TestElementSetRandom(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		var x Element
+		if _, err := x.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		if !x.smallerThanModulus() {
+			t.Fatal("SetRandom should output a value smaller than q")
+		}
+	}
+}
+
+func TestElementOps(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	binaryOps := []struct {
+		name string
+		op   func(z, x, y *Element) *Element
+		ref  func(z, x, y *big.Int) *big.Int
+	}{
+		{"Add", (*Element).Add, (*big.Int).Add},
+		{"Sub", (*Element).Sub, (*big.Int).Sub},
+		{"Mul", (*Element).Mul, (*big.Int).Mul},
+		{"Div", (*Element).Div, func(z, x, y *big.Int) *big.Int {
+			var yInv big.Int
+			if yInv.ModInverse(y, Modulus()) == nil {
+				return z.SetUint64(0)
+			}
+			return z.Mul(x, &yInv)
+		}},
+	}
+	for _, bop := range binaryOps {
+		bop := bop
+		properties.Property(bop.name+": should match math/big", prop.ForAll(
+			func(a, b testPairElement) bool {
+				var c Element
+				bop.op(&c, &a.element, &b.element)
+				var expected big.Int
+				bop.ref(&expected, &a.bigint, &b.bigint).Mod(&expected, Modulus())
+				return c.BigInt(new(big.Int)).Cmp(&expected) == 0
+			},
+			genA,
+			genB,
+		))
+
+		properties.Property(bop.name+": having the receiver as operand should output the same result", prop.ForAll(
+			func(a, b testPairElement) bool {
+				var c, d Element
+				bop.op(&c, &a.element, &b.element)
+				d = a.element
+				bop.op(&d, &d, &b.element)
+				return c.Equal(&d)
+			},
+			genA,
+			genB,
+		))
+	}
+
+	unaryOps := []struct {
+		name string
+		op   func(z, x *Element) *Element
+		ref  func(z, x *big.Int) *big.Int
+	}{
+		{"Square", (*Element).Square, func(z, x *big.Int) *big.Int { return z.Mul(x, x) }},
+		{"Double", (*Element).Double, func(z, x *big.Int) *big.Int { return z.Lsh(x, 1) }},
+		{"Neg", (*Element).Neg, (*big.Int).Neg},
+		{"Inverse", (*Element).Inverse, func(z, x *big.Int) *big.Int {
+			if z.ModInverse(x, Modulus()) == nil {
+				return z.SetUint64(0)
+			}
+			return z
+		}},
+	}
+	for _, uop := range unaryOps {
+		uop := uop
+		properties.Property(uop.name+": should match math/big", prop.ForAll(
+			func(a testPairElement) bool {
+				var c Element
+				uop.op(&c, &a.element)
+				var expected big.Int
+				uop.ref(&expected, &a.bigint).Mod(&expected, Modulus())
+				return c.BigInt(new(big.Int)).Cmp(&expected) == 0
+			},
+			genA,
+		))
+	}
+
+	properties.Property("Halve: 2 * (x / 2) == x", prop.ForAll(
+		func(a testPairElement) bool {
+			c := a.element
+			c.Halve()
+			c.Double(&c)
+			return c.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("Exp: should match math/big", prop.ForAll(
+		func(a testPairElement, e int64) bool {
+			var c Element
+			c.Exp(a.element, big.NewInt(e))
+			var expected big.Int
+			if e < 0 {
+				if expected.ModInverse(&a.bigint, Modulus()) == nil {
+					return c.IsZero()
+				}
+				expected.Exp(&expected, big.NewInt(-e), Modulus())
+			} else {
+				expected.Exp(&a.bigint, big.NewInt(e), Modulus())
+			}
+			return c.BigInt(new(big.Int)).Cmp(&expected) == 0
+		},
+		genA,
+		ggen.Int64Range(-1<<40, 1<<40),
+	))
+
+	properties.Property("Legendre: should match math/big", prop.ForAll(
+		func(a testPairElement) bool {
+			return a.element.Legendre() == big.Jacobi(&a.bigint, Modulus())
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt: should match math/big", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			var expected big.Int
+			if expected.ModSqrt(&a.bigint, Modulus()) == nil {
+				return c.Sqrt(&a.element) == nil
+			}
+			if c.Sqrt(&a.element) == nil {
+				return false
+			}
+			var square Element
+			square.Square(&c)
+			return square.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("LexicographicallyLargest: should match math/big", prop.ForAll(
+		func(a testPairElement) bool {
+			var halfQ big.Int
+			halfQ.Rsh(Modulus(), 1)
+			return a.element.LexicographicallyLargest() == (a.bigint.Cmp(&halfQ) == 1)
+		},
+		genA,
+	))
+
+	properties.Property("Butterfly: should output a+b and a-b", prop.ForAll(
+		func(a, b testPairElement) bool {
+			a0, b0 := a.element, b.element
+			var s, d Element
+			s.Add(&a0, &b0)
+			d.Sub(&a0, &b0)
+			Butterfly(&a0, &b0)
+			return a0.Equal(&s) && b0.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("MulBy3, MulBy5, MulBy13: should match Mul", prop.ForAll(
+		func(a testPairElement) bool {
+			ok := true
+			for _, c := range []struct {
+				f func(*Element)
+				v uint64
+			}{{MulBy3, 3}, {MulBy5, 5}, {MulBy13, 13}} {
+				x := a.element
+				c.f(&x)
+				y := NewElement(c.v)
+				y.Mul(&y, &a.element)
+				ok = ok && x.Equal(&y)
+			}
+			return ok
+		},
+		genA,
+	))
+
+	properties.Property("Select: should pick the right operand", prop.ForAll(
+		func(a, b testPairElement, c int) bool {
+			var z Element
+			z.Select(c, &a.element, &b.element)
+			if c == 0 {
+				return z.Equal(&a.element)
+			}
+			return z.Equal(&b.element)
+		},
+		genA,
+		genB,
+		ggen.OneConstOf(0, 1, -1, 42),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementConversions(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SetBytes(Bytes()) should stay constant", prop.ForAll(
+		func(a testPairElement) bool {
+			var b Element
+			bytes := a.element.Bytes()
+			b.SetBytes(bytes[:])
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetBytesCanonical(Bytes()) should stay constant", prop.ForAll(
+		func(a testPairElement) bool {
+			var b Element
+			bytes := a.element.Bytes()
+			if err := b.SetBytesCanonical(bytes[:]); err != nil {
+				return false
+			}
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("LittleEndian round trip should stay constant", prop.ForAll(
+		func(a testPairElement) bool {
+			var bytes [Bytes]byte
+			LittleEndian.PutElement(&bytes, a.element)
+			b, err := LittleEndian.Element(&bytes)
+			return err == nil && a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetBigInt(BigInt()) should stay constant", prop.ForAll(
+		func(a testPairElement) bool {
+			var b Element
+			b.SetBigInt(&a.bigint)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetString(Text()) should stay constant", prop.ForAll(
+		func(a testPairElement) bool {
+			var b Element
+			if _, err := b.SetString(a.element.String()); err != nil {
+				return false
+			}
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SetUint64 and SetInt64 should match math/big", prop.ForAll(
+		func(v int64) bool {
+			var a, b Element
+			a.SetInt64(v)
+			b.SetUint64(uint64(v))
+			var expectedA, expectedB big.Int
+			expectedA.SetInt64(v).Mod(&expectedA, Modulus())
+			expectedB.SetUint64(uint64(v)).Mod(&expectedB, Modulus())
+			return a.BigInt(new(big.Int)).Cmp(&expectedA) == 0 && b.BigInt(new(big.Int)).Cmp(&expectedB) == 0
+		},
+		ggen.Int64(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// non canonical encodings
+	var invalid [Bytes]byte
+	for i := range invalid {
+		invalid[i] = 0xff
+	}
+	var e Element
+	if err := e.SetBytesCanonical(invalid[:]); err == nil {
+		t.Fatal("SetBytesCanonical should fail on a value larger than q")
+	}
+	if err := e.SetBytesCanonical(invalid[1:]); err == nil {
+		t.Fatal("SetBytesCanonical should fail on a short slice")
+	}
+}
+
+func TestElementBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]Element, 10)
+	for i := range a {
+		if i%3 == 0 {
+			continue // test the handling of zeroes
+		}
+		a[i].SetRandom()
+	}
+
+	aInv := BatchInvert(a)
+
+	assert.True(len(aInv) == len(a))
+	for i := range a {
+		var expected Element
+		expected.Inverse(&a[i])
+		assert.True(aInv[i].Equal(&expected), "batchInvert != invert")
+	}
+}
+
+func TestElementJSON(t *testing.T) {
+	assert := require.New(t)
+
+	type S struct {
+		A Element
+		B [3]Element
+		C *Element
+		D *Element
+	}
+
+	// encode to JSON
+	var s S
+	s.A.SetString("-1")
+	s.B[2].SetUint64(42)
+	s.D = new(Element).SetUint64(8000)
+
+	encoded, err := json.Marshal(&s)
+	assert.NoError(err)
+	// we may need to adjust "42" and "8000" values for some moduli; see Text() method for more details.
+	formatValue := func(v int64) string {
+		var a big.Int
+		a.SetInt64(v)
+		a.Mod(&a, Modulus())
+		const maxUint16 = 65535
+		var aNeg big.Int
+		aNeg.Neg(&a).Mod(&aNeg, Modulus())
+		if aNeg.Uint64() != 0 && aNeg.Uint64() <= maxUint16 {
+			return "-" + aNeg.Text(10)
+		}
+		return a.Text(10)
+	}
+	expected := fmt.Sprintf("{\"A\":%s,\"B\":[0,0,%s],\"C\":null,\"D\":%s}", formatValue(-1), formatValue(42), formatValue(8000))
+	assert.Equal(expected, string(encoded))
+
+	// decode valid
+	var decoded S
+	err = json.Unmarshal([]byte(expected), &decoded)
+	assert.NoError(err)
+
+	assert.Equal(s, decoded, "element -> json -> element round trip failed")
+}
+
+type testPairElement struct {
+	element Element
+	bigint  big.Int
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPairElement
+
+		g.element = Element{uint32(genParams.NextUint64() % uint64(q))}
+
+		g.element.BigInt(&g.bigint)
+		genResult := gopter.NewGenResult(g, gopter.NoShrinker)
+		return genResult
+	}
+}
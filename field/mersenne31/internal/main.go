@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	// q = 2³¹ - 1
+	const modulus = "0x7fffffff"
+	mersenne31, err := config.NewFieldConfig("mersenne31", "Element", modulus, false)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(mersenne31, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated mersenne31 field")
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package hash provides MiMC hash function defined over curves implemented in gnark-crypto/ecc.
+// Package hash provides MiMC, Poseidon and Poseidon2 hash functions defined over curves implemented in gnark-crypto/ecc.
 //
 // Originally developed and used in a ZKP context.
 package hash
@@ -21,14 +21,32 @@ import (
 	"hash"
 
 	bls377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/mimc"
+	poseidon_bls377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/poseidon"
+	poseidon2_bls377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/poseidon2"
 	bls378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr/mimc"
+	poseidon_bls378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr/poseidon"
+	poseidon2_bls378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr/poseidon2"
 	bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/mimc"
+	poseidon_bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/poseidon"
+	poseidon2_bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/poseidon2"
 	bls315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/mimc"
+	poseidon_bls315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/poseidon"
+	poseidon2_bls315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/poseidon2"
 	bls317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr/mimc"
+	poseidon_bls317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr/poseidon"
+	poseidon2_bls317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr/poseidon2"
 	bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	poseidon_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon"
+	poseidon2_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
 	bw633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr/mimc"
+	poseidon_bw633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr/poseidon"
+	poseidon2_bw633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr/poseidon2"
 	bw756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr/mimc"
+	poseidon_bw756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr/poseidon"
+	poseidon2_bw756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr/poseidon2"
 	bw761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/mimc"
+	poseidon_bw761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/poseidon"
+	poseidon2_bw761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/poseidon2"
 )
 
 type Hash uint
@@ -43,22 +61,58 @@ const (
 	MIMC_BLS24_317
 	MIMC_BW6_633
 	MIMC_BW6_756
+	POSEIDON_BN254
+	POSEIDON_BLS12_381
+	POSEIDON_BLS12_377
+	POSEIDON_BLS12_378
+	POSEIDON_BW6_761
+	POSEIDON_BLS24_315
+	POSEIDON_BLS24_317
+	POSEIDON_BW6_633
+	POSEIDON_BW6_756
+	POSEIDON2_BN254
+	POSEIDON2_BLS12_381
+	POSEIDON2_BLS12_377
+	POSEIDON2_BLS12_378
+	POSEIDON2_BW6_761
+	POSEIDON2_BLS24_315
+	POSEIDON2_BLS24_317
+	POSEIDON2_BW6_633
+	POSEIDON2_BW6_756
 )
 
 // size of digests in bytes
 var digestSize = []uint8{
-	MIMC_BN254:     32,
-	MIMC_BLS12_381: 48,
-	MIMC_BLS12_377: 48,
-	MIMC_BLS12_378: 48,
-	MIMC_BW6_761:   96,
-	MIMC_BLS24_315: 48,
-	MIMC_BLS24_317: 48,
-	MIMC_BW6_633:   80,
-	MIMC_BW6_756:   96,
+	MIMC_BN254:          32,
+	MIMC_BLS12_381:      48,
+	MIMC_BLS12_377:      48,
+	MIMC_BLS12_378:      48,
+	MIMC_BW6_761:        96,
+	MIMC_BLS24_315:      48,
+	MIMC_BLS24_317:      48,
+	MIMC_BW6_633:        80,
+	MIMC_BW6_756:        96,
+	POSEIDON_BN254:      32,
+	POSEIDON_BLS12_381:  32,
+	POSEIDON_BLS12_377:  32,
+	POSEIDON_BLS12_378:  32,
+	POSEIDON_BW6_761:    48,
+	POSEIDON_BLS24_315:  32,
+	POSEIDON_BLS24_317:  32,
+	POSEIDON_BW6_633:    40,
+	POSEIDON_BW6_756:    48,
+	POSEIDON2_BN254:     32,
+	POSEIDON2_BLS12_381: 32,
+	POSEIDON2_BLS12_377: 32,
+	POSEIDON2_BLS12_378: 32,
+	POSEIDON2_BW6_761:   48,
+	POSEIDON2_BLS24_315: 32,
+	POSEIDON2_BLS24_317: 32,
+	POSEIDON2_BW6_633:   40,
+	POSEIDON2_BW6_756:   48,
 }
 
-// New creates the corresponding mimc hash function.
+// New creates the corresponding hash function.
 func (m Hash) New() hash.Hash {
 	switch m {
 	case MIMC_BN254:
@@ -79,12 +133,48 @@ func (m Hash) New() hash.Hash {
 		return bw633.NewMiMC()
 	case MIMC_BW6_756:
 		return bw756.NewMiMC()
+	case POSEIDON_BN254:
+		return poseidon_bn254.NewPoseidon()
+	case POSEIDON_BLS12_381:
+		return poseidon_bls381.NewPoseidon()
+	case POSEIDON_BLS12_377:
+		return poseidon_bls377.NewPoseidon()
+	case POSEIDON_BLS12_378:
+		return poseidon_bls378.NewPoseidon()
+	case POSEIDON_BW6_761:
+		return poseidon_bw761.NewPoseidon()
+	case POSEIDON_BLS24_315:
+		return poseidon_bls315.NewPoseidon()
+	case POSEIDON_BLS24_317:
+		return poseidon_bls317.NewPoseidon()
+	case POSEIDON_BW6_633:
+		return poseidon_bw633.NewPoseidon()
+	case POSEIDON_BW6_756:
+		return poseidon_bw756.NewPoseidon()
+	case POSEIDON2_BN254:
+		return poseidon2_bn254.NewPoseidon2()
+	case POSEIDON2_BLS12_381:
+		return poseidon2_bls381.NewPoseidon2()
+	case POSEIDON2_BLS12_377:
+		return poseidon2_bls377.NewPoseidon2()
+	case POSEIDON2_BLS12_378:
+		return poseidon2_bls378.NewPoseidon2()
+	case POSEIDON2_BW6_761:
+		return poseidon2_bw761.NewPoseidon2()
+	case POSEIDON2_BLS24_315:
+		return poseidon2_bls315.NewPoseidon2()
+	case POSEIDON2_BLS24_317:
+		return poseidon2_bls317.NewPoseidon2()
+	case POSEIDON2_BW6_633:
+		return poseidon2_bw633.NewPoseidon2()
+	case POSEIDON2_BW6_756:
+		return poseidon2_bw756.NewPoseidon2()
 	default:
-		panic("Unknown mimc ID")
+		panic("Unknown hash ID")
 	}
 }
 
-// String returns the mimc ID to string format.
+// String returns the hash ID to string format.
 func (m Hash) String() string {
 	switch m {
 	case MIMC_BN254:
@@ -105,8 +195,44 @@ func (m Hash) String() string {
 		return "MIMC_BW633"
 	case MIMC_BW6_756:
 		return "MIMC_BW756"
+	case POSEIDON_BN254:
+		return "POSEIDON_BN254"
+	case POSEIDON_BLS12_381:
+		return "POSEIDON_BLS381"
+	case POSEIDON_BLS12_377:
+		return "POSEIDON_BLS377"
+	case POSEIDON_BLS12_378:
+		return "POSEIDON_BLS378"
+	case POSEIDON_BW6_761:
+		return "POSEIDON_BW761"
+	case POSEIDON_BLS24_315:
+		return "POSEIDON_BLS315"
+	case POSEIDON_BLS24_317:
+		return "POSEIDON_BLS317"
+	case POSEIDON_BW6_633:
+		return "POSEIDON_BW633"
+	case POSEIDON_BW6_756:
+		return "POSEIDON_BW756"
+	case POSEIDON2_BN254:
+		return "POSEIDON2_BN254"
+	case POSEIDON2_BLS12_381:
+		return "POSEIDON2_BLS381"
+	case POSEIDON2_BLS12_377:
+		return "POSEIDON2_BLS377"
+	case POSEIDON2_BLS12_378:
+		return "POSEIDON2_BLS378"
+	case POSEIDON2_BW6_761:
+		return "POSEIDON2_BW761"
+	case POSEIDON2_BLS24_315:
+		return "POSEIDON2_BLS315"
+	case POSEIDON2_BLS24_317:
+		return "POSEIDON2_BLS317"
+	case POSEIDON2_BW6_633:
+		return "POSEIDON2_BW633"
+	case POSEIDON2_BW6_756:
+		return "POSEIDON2_BW756"
 	default:
-		panic("Unknown mimc ID")
+		panic("Unknown hash ID")
 	}
 }
 
@@ -0,0 +1,151 @@
+// Package dudect is a timing leakage test harness, following the approach of dudect
+// (Reparaz, Balasch and Verbauwhede, "Dude, is my code constant time?",
+// https://eprint.iacr.org/2016/1123).
+//
+// The inputs of the operation under test are split in two classes, typically a fixed input
+// and random ones. The operation is timed on inputs of both classes, in random order, and the
+// two distributions of execution times are compared with Welch's t-test, on all the
+// measurements and on the measurements below several percentiles (the cropping removes the
+// long tail due to interrupts and the scheduler). A |t| above Threshold is evidence that the
+// execution time depends on the class of the input.
+//
+// The harness is statistical and measures wall-clock time: it can't prove that an operation
+// runs in constant time, and should be run on a quiet machine. See the targets in
+// targets_test.go, run with
+//
+//	go test -tags dudect ./internal/dudect -v
+package dudect
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// Threshold is the bound on |t| above which the execution times of the two classes are
+// considered different; dudect flags "probably not constant time" above 4.5, and "definitely
+// not constant time" above 10.
+const Threshold = 4.5
+
+// Target is an operation under test
+type Target interface {
+	// Prepare sets the i-th input of the operation, of class 0 or 1; it is not timed.
+	Prepare(i, class int)
+
+	// Do runs the operation on the i-th input.
+	Do(i int)
+}
+
+// Result of a timing leakage test
+type Result struct {
+	Measurements int     // number of measurements
+	T            float64 // Welch's t statistic with the largest absolute value
+	Percentile   float64 // cropping percentile of T, 1 if all the measurements are used
+}
+
+// Leaks returns true if the execution times of the two classes differ
+func (r Result) Leaks() bool {
+	return math.Abs(r.T) > Threshold
+}
+
+// number of cropping percentiles
+const nbPercentiles = 10
+
+// Run measures the execution time of n runs (rounded up to a multiple of batchSize) of the
+// target on inputs of random classes, and returns the result of the t-tests; the inputs of
+// a batch are prepared by the target before being timed.
+func Run(target Target, n, batchSize int) Result {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano())) //#nosec G404 -- the classes don't need to be unpredictable
+
+	classes := make([]int, batchSize)
+	times := make([]float64, batchSize)
+
+	var tests [nbPercentiles + 1]tTest
+	var thresholds []float64
+
+	for done := 0; done < n; done += batchSize {
+		for i := range classes {
+			classes[i] = rng.Intn(2)
+			target.Prepare(i, classes[i])
+		}
+		for i := range classes {
+			start := time.Now()
+			target.Do(i)
+			times[i] = float64(time.Since(start))
+		}
+
+		// the cropping thresholds are set with the first batch
+		if thresholds == nil {
+			thresholds = cropThresholds(times)
+		}
+
+		for i, t := range times {
+			tests[0].push(classes[i], t)
+			for k, threshold := range thresholds {
+				if t < threshold {
+					tests[k+1].push(classes[i], t)
+				}
+			}
+		}
+	}
+
+	res := Result{Percentile: 1}
+	for k := range tests {
+		if tests[k].n[0] < 2 || tests[k].n[1] < 2 {
+			continue
+		}
+		if t := tests[k].compute(); math.Abs(t) > math.Abs(res.T) {
+			res.T = t
+			res.Percentile = 1
+			if k > 0 {
+				res.Percentile = percentile(k - 1)
+			}
+		}
+	}
+	res.Measurements = int(tests[0].n[0] + tests[0].n[1])
+	return res
+}
+
+// percentile returns the k-th cropping percentile 1 - 0.5^(10(k+1)/nbPercentiles), as dudect:
+// the percentiles are denser close to 1.
+func percentile(k int) float64 {
+	return 1 - math.Pow(0.5, 10*float64(k+1)/nbPercentiles)
+}
+
+// cropThresholds returns the execution times at the cropping percentiles of times
+func cropThresholds(times []float64) []float64 {
+	sorted := make([]float64, len(times))
+	copy(sorted, times)
+	sort.Float64s(sorted)
+
+	thresholds := make([]float64, nbPercentiles)
+	for k := range thresholds {
+		thresholds[k] = sorted[int(percentile(k)*float64(len(sorted)-1))]
+	}
+	return thresholds
+}
+
+// tTest computes Welch's t statistic of two samples, with Welford's online algorithm
+type tTest struct {
+	n, mean, m2 [2]float64
+}
+
+// push adds x to the sample of the given class
+func (t *tTest) push(class int, x float64) {
+	t.n[class]++
+	delta := x - t.mean[class]
+	t.mean[class] += delta / t.n[class]
+	t.m2[class] += delta * (x - t.mean[class])
+}
+
+// compute returns Welch's t statistic; the samples must have at least 2 elements
+func (t *tTest) compute() float64 {
+	v0 := t.m2[0] / (t.n[0] - 1)
+	v1 := t.m2[1] / (t.n[1] - 1)
+	den := math.Sqrt(v0/t.n[0] + v1/t.n[1])
+	if den == 0 {
+		return 0
+	}
+	return (t.mean[0] - t.mean[1]) / den
+}
@@ -0,0 +1,73 @@
+package dudect
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWelchTTest(t *testing.T) {
+	rng := rand.New(rand.NewSource(42)) //#nosec G404 -- deterministic test samples
+
+	// same distributions
+	var same tTest
+	for i := 0; i < 10000; i++ {
+		same.push(i%2, rng.NormFloat64())
+	}
+	if v := same.compute(); math.Abs(v) > Threshold {
+		t.Fatalf("t = %f for samples of the same distribution", v)
+	}
+
+	// shifted distributions
+	var shifted tTest
+	for i := 0; i < 10000; i++ {
+		shifted.push(i%2, rng.NormFloat64()+0.5*float64(i%2))
+	}
+	if v := shifted.compute(); v > -Threshold {
+		t.Fatalf("t = %f for samples of shifted distributions", v)
+	}
+
+	// known values: means 2 and 5, variances 2 and 2, n = 3
+	var known tTest
+	for _, x := range []float64{1, 2, 3} {
+		known.push(0, x)
+		known.push(1, x+3)
+	}
+	if v, expected := known.compute(), -3/math.Sqrt(2.0/3); math.Abs(v-expected) > 1e-12 {
+		t.Fatalf("t = %f, expected %f", v, expected)
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	for k := 1; k < nbPercentiles; k++ {
+		if percentile(k) <= percentile(k-1) || percentile(k) >= 1 {
+			t.Fatal("the cropping percentiles must be increasing and below 1")
+		}
+	}
+}
+
+// leakyTarget runs a loop whose length depends on the class of the input
+type leakyTarget struct {
+	classes [100]int
+	sink    uint64
+}
+
+func (l *leakyTarget) Prepare(i, class int) { l.classes[i] = class }
+
+func (l *leakyTarget) Do(i int) {
+	n := 1000 + 9000*l.classes[i]
+	for j := 0; j < n; j++ {
+		l.sink += uint64(j) * l.sink
+	}
+}
+
+func TestRunDetectsLeak(t *testing.T) {
+	var target leakyTarget
+	res := Run(&target, 10000, len(target.classes))
+	if !res.Leaks() {
+		t.Fatalf("the leak was not detected: %+v", res)
+	}
+	if res.Measurements != 10000 {
+		t.Fatalf("expected 10000 measurements, got %d", res.Measurements)
+	}
+}
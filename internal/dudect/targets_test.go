@@ -0,0 +1,140 @@
+//go:build dudect
+
+package dudect
+
+import (
+	"crypto/rand"
+	"flag"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bn254fp "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	secp256k1fr "github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// the targets are timed on a fixed input (class 0) and random inputs (class 1)
+
+var nbMeasurements = flag.Int("dudect.n", 1<<17, "number of measurements of each target")
+
+const batchSize = 1 << 10
+
+func runTarget(t *testing.T, target Target) {
+	res := Run(target, *nbMeasurements, batchSize)
+	t.Logf("%d measurements, t = %.2f (percentile %.3f)", res.Measurements, res.T, res.Percentile)
+	if res.Leaks() {
+		t.Errorf("timing leak: |t| = %.2f > %.1f", res.T, Threshold)
+	}
+}
+
+type inverseTarget struct {
+	inputs [batchSize]bn254fp.Element
+	res    bn254fp.Element
+	ct     bool
+}
+
+func (tg *inverseTarget) Prepare(i, class int) {
+	if class == 0 {
+		tg.inputs[i].SetOne()
+	} else {
+		tg.inputs[i].SetRandom()
+	}
+}
+
+func (tg *inverseTarget) Do(i int) {
+	if tg.ct {
+		tg.res.InverseCT(&tg.inputs[i])
+	} else {
+		tg.res.Inverse(&tg.inputs[i])
+	}
+}
+
+func TestInverseCT(t *testing.T) {
+	runTarget(t, &inverseTarget{ct: true})
+}
+
+// TestInverse checks the sensitivity of the harness on the variable-time inversion, whose
+// leak is reported but doesn't fail the test.
+func TestInverse(t *testing.T) {
+	res := Run(&inverseTarget{}, *nbMeasurements, batchSize)
+	t.Logf("%d measurements, t = %.2f (percentile %.3f), leaks: %v", res.Measurements, res.T, res.Percentile, res.Leaks())
+}
+
+type expTarget struct {
+	exponents [batchSize]big.Int
+	x, res    secp256k1fr.Element
+}
+
+func (tg *expTarget) Prepare(i, class int) {
+	if class == 0 {
+		tg.exponents[i].SetUint64(1)
+	} else {
+		var e secp256k1fr.Element
+		e.SetRandom()
+		e.BigInt(&tg.exponents[i])
+	}
+}
+
+func (tg *expTarget) Do(i int) {
+	tg.res.ExpCT(tg.x, &tg.exponents[i])
+}
+
+func TestExpCT(t *testing.T) {
+	var tg expTarget
+	tg.x.SetRandom()
+	runTarget(t, &tg)
+}
+
+type scalarMulSecp256k1Target struct {
+	scalars [batchSize]big.Int
+	res     secp256k1.G1Affine
+}
+
+func (tg *scalarMulSecp256k1Target) Prepare(i, class int) {
+	setScalar(&tg.scalars[i], class, secp256k1fr.Bits)
+}
+
+func (tg *scalarMulSecp256k1Target) Do(i int) {
+	tg.res.ScalarMultiplicationBaseCT(&tg.scalars[i])
+}
+
+func TestScalarMultiplicationCTSecp256k1(t *testing.T) {
+	runTarget(t, &scalarMulSecp256k1Target{})
+}
+
+type scalarMulBn254Target struct {
+	scalars [batchSize]big.Int
+	base    bn254.G1Jac
+	res     bn254.G1Jac
+}
+
+func (tg *scalarMulBn254Target) Prepare(i, class int) {
+	setScalar(&tg.scalars[i], class, 254)
+}
+
+func (tg *scalarMulBn254Target) Do(i int) {
+	tg.res.ScalarMultiplicationCT(&tg.base, &tg.scalars[i])
+}
+
+func TestScalarMultiplicationCTBn254(t *testing.T) {
+	var tg scalarMulBn254Target
+	tg.base, _, _, _ = bn254.Generators()
+	runTarget(t, &tg)
+}
+
+// setScalar sets s to a scalar of nbBits-1 bits: a fixed one with a sparse binary expansion
+// for class 0, a random one for class 1; both have the same bit length, since the
+// conversions from big.Int are not in the scope of the test.
+func setScalar(s *big.Int, class, nbBits int) {
+	high := new(big.Int).Lsh(big.NewInt(1), uint(nbBits-2))
+	if class == 0 {
+		s.Set(high)
+		return
+	}
+	r, err := rand.Int(rand.Reader, high)
+	if err != nil {
+		panic(err)
+	}
+	s.Add(r, high)
+}
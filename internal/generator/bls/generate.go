@@ -0,0 +1,50 @@
+package bls
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+type blsConfig struct {
+	config.Curve
+	CurveLabel   string // curve name as it appears in the ciphersuite ID, e.g. BLS12381
+	G1MapToCurve config.FieldElementToCurvePoint
+	G2MapToCurve config.FieldElementToCurvePoint
+}
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// bls signatures
+	conf.Package = "bls"
+	baseDir = filepath.Join(baseDir, conf.Package)
+
+	data := blsConfig{
+		Curve:        conf,
+		CurveLabel:   strings.ToUpper(strings.ReplaceAll(conf.Name, "-", "")),
+		G1MapToCurve: mapToCurve(conf.HashE1),
+		G2MapToCurve: mapToCurve(conf.HashE2),
+	}
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bls.go"), Templates: []string{"bls.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bls_test.go"), Templates: []string{"bls.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate.go"), Templates: []string{"aggregate.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate_test.go"), Templates: []string{"aggregate.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
+	}
+	return bgen.Generate(data, conf.Package, "./bls/template", entries...)
+
+}
+
+// mapToCurve returns the map used by the hash-to-curve suite. Curves without
+// an explicit suite fall back to the Shallue-van de Woestijne map.
+func mapToCurve(suite config.HashSuite) config.FieldElementToCurvePoint {
+	if _, ok := suite.(*config.HashSuiteSswu); ok {
+		return config.SSWU
+	}
+	return config.SVDW
+}
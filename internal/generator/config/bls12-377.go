@@ -5,6 +5,7 @@ var BLS12_377 = Curve{
 	CurvePackage: "bls12377",
 	EnumID:       "BLS12_377",
 	FrModulus:    "8444461749428370424248824938781546531375899335154063827935233455917409239041",
+	FrGenerator:  22,
 	FpModulus:    "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177",
 	G1: Point{
 		CoordType:        "fp.Element",
@@ -5,6 +5,7 @@ var BLS12_381 = Curve{
 	CurvePackage: "bls12381",
 	EnumID:       "BLS12_381",
 	FrModulus:    "52435875175126190479447740508185965837690552500527637822603658699938581184513",
+	FrGenerator:  7,
 	FpModulus:    "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
 	G1: Point{
 		CoordType:        "fp.Element",
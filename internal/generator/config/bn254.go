@@ -5,6 +5,7 @@ var BN254 = Curve{
 	CurvePackage: "bn254",
 	EnumID:       "BN254",
 	FrModulus:    "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+	FrGenerator:  5,
 	FpModulus:    "21888242871839275222246405745257275088696311157297823662689037894645226208583",
 	G1: Point{
 		CoordType:        "fp.Element",
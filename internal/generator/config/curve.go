@@ -8,12 +8,14 @@ import (
 
 // Curve describes parameters of the curve useful for the template
 type Curve struct {
-	Name         string
-	CurvePackage string
-	Package      string // current package being generated
-	EnumID       string
-	FpModulus    string
-	FrModulus    string
+	Name             string
+	CurvePackage     string
+	CurvePackagePath string // import path of the curve package
+	Package          string // current package being generated
+	EnumID           string
+	FpModulus        string
+	FrModulus        string
+	FrGenerator      uint64 // generator of 𝔽ᵣ*, for the fft over 𝔽ᵣ
 
 	Fp           *config.FieldConfig
 	Fr           *config.FieldConfig
@@ -25,6 +27,10 @@ type Curve struct {
 
 	HashE1 HashSuite
 	HashE2 HashSuite
+
+	// Custom is set for the curves defined by users (see NewCustomCurve), whose parameters
+	// are generated along with G1
+	Custom *CustomCurve
 }
 
 type TwistedEdwardsCurve struct {
@@ -50,6 +56,27 @@ func (c Curve) Equal(other Curve) bool {
 	return c.Name == other.Name
 }
 
+// HasG2 returns true if the curve has a second group G2, that is if it is pairing-friendly
+func (c Curve) HasG2() bool {
+	return c.G2.CoordType != ""
+}
+
+// A0 returns true if the curve has an equation y² = x³ + b
+func (c Curve) A0() bool {
+	if c.Custom != nil {
+		return c.Custom.A == "0"
+	}
+	return !c.Equal(STARK_CURVE)
+}
+
+// G1PrimeOrder returns true if E(𝔽p) has prime order r, that is if G1 is the whole curve
+func (c Curve) G1PrimeOrder() bool {
+	if c.Custom != nil {
+		return c.Custom.Cofactor == "1"
+	}
+	return c.Equal(BN254) || c.Equal(SECP256K1) || c.Equal(STARK_CURVE)
+}
+
 type Point struct {
 	CoordType        string
 	CoordExtDegree   uint8 // value n, such that q = pⁿ
@@ -72,6 +99,7 @@ func defaultCRange() []int {
 }
 
 func addCurve(c *Curve) {
+	c.CurvePackagePath = "github.com/consensys/gnark-crypto/ecc/" + c.Name
 	// init FpInfo and FrInfo
 	c.FpInfo = newFieldInfo(c.FpModulus)
 	c.FrInfo = newFieldInfo(c.FrModulus)
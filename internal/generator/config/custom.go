@@ -0,0 +1,577 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// CustomCurveParams are the parameters of a short Weierstrass curve E: y² = x³ + ax + b over
+// 𝔽p defined by users, from which a standalone package is generated (see NewCustomCurve).
+// The integers are in base 10 or 16 with the 0x prefix.
+type CustomCurveParams struct {
+	Name       string      `json:"name"`       // name of the curve; its package name is the name without dashes
+	ImportPath string      `json:"importPath"` // import path of the curve package
+	P          string      `json:"p"`          // modulus of the base field 𝔽p
+	R          string      `json:"r"`          // prime order of G1
+	A          string      `json:"a"`
+	B          string      `json:"b"`
+	Generator  CustomPoint `json:"generator"`          // generator of G1
+	Cofactor   string      `json:"cofactor,omitempty"` // #E(𝔽p) / r, 1 if empty
+	GLV        *CustomGLV  `json:"glv,omitempty"`      // endomorphism for the GLV scalar multiplication
+	SSWU       *CustomSSWU `json:"sswu,omitempty"`     // hash to curve with the SSWU map; with the SVDW map if nil
+}
+
+// CustomPoint is a point of E(𝔽p) in affine coordinates
+type CustomPoint struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+// CustomGLV describes the endomorphism ϕ: (x,y) ↦ (ωx,y) of a curve with a = 0, such that
+// ϕ(P) = [λ]P on G1
+type CustomGLV struct {
+	ThirdRootOne string `json:"thirdRootOne"` // ω, a primitive third root of 1 in 𝔽p
+	Lambda       string `json:"lambda"`       // λ, a primitive third root of 1 in 𝔽r
+}
+
+// CustomSSWU are the parameters of the simplified SWU map (RFC 9380, section 6.6.2). The map
+// needs a curve with ab ≠ 0; if a = 0 or b = 0, it goes to an isogenous curve E' instead.
+type CustomSSWU struct {
+	Z       int            `json:"z"` // non-square of 𝔽p, see RFC 9380, appendix H.2
+	Isogeny *CustomIsogeny `json:"isogeny,omitempty"`
+}
+
+// CustomIsogeny describes the isogeny E' → E, (x,y) ↦ (xNum(x)/xDen(x), y·yNum(x)/yDen(x)),
+// E' being the curve y² = x³ + a'x + b'. The coefficients of the polynomials are listed by
+// increasing degree, and the denominators are monic: their leading 1 is omitted. NewCustomCurve
+// maps a few points of E' and rejects the isogeny if their images are not on E.
+type CustomIsogeny struct {
+	A    string   `json:"a"`
+	B    string   `json:"b"`
+	XNum []string `json:"xNum"`
+	XDen []string `json:"xDen"`
+	YNum []string `json:"yNum"`
+	YDen []string `json:"yDen"`
+}
+
+// CustomCurve holds the parameters of a curve defined by users which are not in Curve, in
+// base 10
+type CustomCurve struct {
+	A, B                   string
+	Cofactor               string
+	GeneratorX, GeneratorY string
+	ThirdRootOne, Lambda   string // set if G1.GLV
+}
+
+var curveName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// NewCustomCurve checks the parameters of a user-defined curve and returns its configuration,
+// from which G1 arithmetic, multi-exponentiation, serialization, hash to curve and ECDSA are
+// generated.
+func NewCustomCurve(params CustomCurveParams) (Curve, error) {
+	if !curveName.MatchString(params.Name) {
+		return Curve{}, fmt.Errorf("invalid curve name %q: it must start with a lower case letter, followed by lower case letters, digits and dashes", params.Name)
+	}
+	for _, c := range Curves {
+		if c.Name == params.Name {
+			return Curve{}, fmt.Errorf("%s is already implemented in gnark-crypto", c.Name)
+		}
+	}
+	if params.ImportPath == "" {
+		return Curve{}, errors.New("missing import path of the curve package")
+	}
+
+	var p, r, a, b, h, gx, gy big.Int
+	ints := []struct {
+		name  string
+		s     string
+		value *big.Int
+	}{{"p", params.P, &p}, {"r", params.R, &r}, {"a", params.A, &a}, {"b", params.B, &b},
+		{"cofactor", params.Cofactor, &h}, {"generator.x", params.Generator.X, &gx}, {"generator.y", params.Generator.Y, &gy}}
+	for _, v := range ints {
+		if v.name == "cofactor" && v.s == "" {
+			v.value.SetUint64(1)
+			continue
+		}
+		if err := setInt(v.value, v.name, v.s); err != nil {
+			return Curve{}, err
+		}
+	}
+
+	// the fields
+	if p.Cmp(big.NewInt(3)) <= 0 || !p.ProbablyPrime(20) {
+		return Curve{}, errors.New("p must be a prime greater than 3")
+	}
+	if r.Cmp(big.NewInt(3)) <= 0 || !r.ProbablyPrime(20) {
+		return Curve{}, errors.New("r must be a prime greater than 3")
+	}
+	a.Mod(&a, &p)
+	b.Mod(&b, &p)
+	if b.Sign() == 0 {
+		return Curve{}, errors.New("b must not be 0")
+	}
+
+	// the curve is non-singular: 4a³ + 27b² ≠ 0
+	E := curve{&p, &a, &b}
+	var d, t big.Int
+	d.Exp(&a, big.NewInt(3), &p).Lsh(&d, 2)
+	t.Mul(&b, &b).Mul(&t, big.NewInt(27))
+	if d.Add(&d, &t).Mod(&d, &p).Sign() == 0 {
+		return Curve{}, errors.New("the curve is singular: 4a³ + 27b² = 0")
+	}
+
+	// #E(𝔽p) = h·r is in the Hasse interval: |p + 1 - h·r| ≤ 2√p
+	if h.Sign() <= 0 {
+		return Curve{}, errors.New("the cofactor must be positive")
+	}
+	var n, t2, fourP big.Int
+	n.Mul(&h, &r)
+	t.Add(&p, big.NewInt(1)).Sub(&t, &n)
+	if t2.Mul(&t, &t).Cmp(fourP.Lsh(&p, 2)) > 0 {
+		return Curve{}, errors.New("h·r is not the order of a curve over 𝔽p (Hasse bound)")
+	}
+	if t.Cmp(big.NewInt(1)) == 0 {
+		return Curve{}, errors.New("the curve is anomalous: #E(𝔽p) = p")
+	}
+
+	// G1 = <g> is of order r
+	g := point{x: &gx, y: &gy}
+	gx.Mod(&gx, &p)
+	gy.Mod(&gy, &p)
+	if !E.isOnCurve(g) {
+		return Curve{}, errors.New("the generator is not on the curve")
+	}
+	if !E.isInfinity(E.scalarMul(g, &r)) {
+		return Curve{}, errors.New("the generator is not of order r")
+	}
+
+	custom := CustomCurve{
+		A:          a.String(),
+		B:          b.String(),
+		Cofactor:   h.String(),
+		GeneratorX: gx.String(),
+		GeneratorY: gy.String(),
+	}
+
+	// GLV
+	if params.GLV != nil {
+		if a.Sign() != 0 {
+			return Curve{}, errors.New("the GLV endomorphism (x,y) ↦ (ωx,y) needs a = 0")
+		}
+		var omega, lambda big.Int
+		if err := setInt(&omega, "glv.thirdRootOne", params.GLV.ThirdRootOne); err != nil {
+			return Curve{}, err
+		}
+		if err := setInt(&lambda, "glv.lambda", params.GLV.Lambda); err != nil {
+			return Curve{}, err
+		}
+		omega.Mod(&omega, &p)
+		lambda.Mod(&lambda, &r)
+		if !isPrimitiveThirdRootOfOne(&omega, &p) {
+			return Curve{}, errors.New("glv.thirdRootOne must be a primitive third root of 1 in 𝔽p")
+		}
+		if !isPrimitiveThirdRootOfOne(&lambda, &r) {
+			return Curve{}, errors.New("glv.lambda must be a primitive third root of 1 in 𝔽r")
+		}
+		phi := point{x: new(big.Int).Mul(&omega, &gx), y: &gy}
+		phi.x.Mod(phi.x, &p)
+		if !E.equal(phi, E.scalarMul(g, &lambda)) {
+			return Curve{}, errors.New("ϕ(g) ≠ [λ]g: swap glv.thirdRootOne with its square, or glv.lambda with its square")
+		}
+		custom.ThirdRootOne = omega.String()
+		custom.Lambda = lambda.String()
+	}
+
+	c := Curve{
+		Name:             params.Name,
+		CurvePackage:     strings.ReplaceAll(params.Name, "-", ""),
+		CurvePackagePath: params.ImportPath,
+		FpModulus:        p.String(),
+		FrModulus:        r.String(),
+		G1: Point{
+			CoordType:        "fp.Element",
+			CoordExtDegree:   1,
+			PointName:        "g1",
+			GLV:              params.GLV != nil,
+			CofactorCleaning: h.Cmp(big.NewInt(1)) != 0,
+		},
+		Custom: &custom,
+	}
+
+	var err error
+	if c.Fp, err = config.NewFieldConfig("fp", "Element", c.FpModulus, false); err != nil {
+		return Curve{}, err
+	}
+	if c.Fr, err = config.NewFieldConfig("fr", "Element", c.FrModulus, false); err != nil {
+		return Curve{}, err
+	}
+	c.FpUnusedBits = (64 - c.Fp.NbBits%64) % 64
+	c.FpInfo = newFieldInfo(c.FpModulus)
+	c.FrInfo = newFieldInfo(c.FrModulus)
+
+	// the last window of the multi-exponentiation must fit in a uint16 digit (see ecc.Generate)
+	for _, w := range defaultCRange() {
+		nbChunks := (c.Fr.NbBits + w - 1) / w
+		if w+1-(nbChunks*w-c.Fr.NbBits) <= 16 {
+			c.G1.CRange = append(c.G1.CRange, w)
+		}
+	}
+
+	// hash to curve
+	if params.SSWU != nil {
+		if c.HashE1, err = newCustomSSWU(E, params.SSWU); err != nil {
+			return Curve{}, err
+		}
+	} else {
+		if c.HashE1, err = newCustomSVDW(E); err != nil {
+			return Curve{}, err
+		}
+	}
+
+	return c, nil
+}
+
+// newCustomSVDW returns the parameters of the Shallue-van de Woestijne map to E, with Z found
+// as in RFC 9380, appendix H.1
+func newCustomSVDW(E curve) (*HashSuiteSvdw, error) {
+	p := E.p
+	var three, four big.Int
+	three.SetUint64(3)
+	four.SetUint64(4)
+
+	// h(Z) = -(3Z² + 4a) / (4g(Z))
+	var z, gz, gHalf, halfZ, hz, t big.Int
+	for ctr := int64(1); ctr < 1000; ctr++ {
+		for _, zCand := range []int64{ctr, -ctr} {
+			z.SetInt64(zCand).Mod(&z, p)
+			E.rhs(&gz, &z)
+			if gz.Sign() == 0 {
+				continue
+			}
+			t.Mul(&z, &z).Mul(&t, &three).Add(&t, new(big.Int).Mul(&four, E.a)).Mod(&t, p)
+			hz.Mul(&four, &gz).ModInverse(&hz, p).Mul(&hz, &t).Neg(&hz).Mod(&hz, p)
+			if hz.Sign() == 0 || big.Jacobi(&hz, p) != 1 {
+				continue
+			}
+			halfZ.Neg(&z).Mul(&halfZ, new(big.Int).ModInverse(big.NewInt(2), p)).Mod(&halfZ, p)
+			E.rhs(&gHalf, &halfZ)
+			if big.Jacobi(&gz, p) != 1 && big.Jacobi(&gHalf, p) != 1 {
+				continue
+			}
+
+			// c1 = g(Z), c2 = -Z/2, c3 = sqrt(-g(Z)·(3Z² + 4a)) with sgn0(c3) = 0,
+			// c4 = -4g(Z) / (3Z² + 4a)
+			var c3, c4 big.Int
+			c3.Mul(&gz, &t).Neg(&c3).Mod(&c3, p)
+			if c3.ModSqrt(&c3, p) == nil {
+				return nil, errors.New("svdw: no square root for c3")
+			}
+			if c3.Bit(0) == 1 {
+				c3.Sub(p, &c3)
+			}
+			c4.ModInverse(&t, p).Mul(&c4, &gz).Mul(&c4, &four).Neg(&c4).Mod(&c4, p)
+
+			return &HashSuiteSvdw{
+				z:  []string{z.String()},
+				c1: []string{gz.String()},
+				c2: []string{halfZ.String()},
+				c3: []string{c3.String()},
+				c4: []string{c4.String()},
+			}, nil
+		}
+	}
+	return nil, errors.New("svdw: no suitable Z found")
+}
+
+// newCustomSSWU checks the parameters of the simplified SWU map to E (or to an isogenous curve)
+func newCustomSSWU(E curve, params *CustomSSWU) (*HashSuiteSswu, error) {
+	p := E.p
+	suite := HashSuiteSswu{Z: []int{params.Z}}
+
+	// the map goes to E' : y² = x³ + a'x + b'
+	EPrime := E
+	if params.Isogeny != nil {
+		iso := params.Isogeny
+		var a, b big.Int
+		if err := setInt(&a, "sswu.isogeny.a", iso.A); err != nil {
+			return nil, err
+		}
+		if err := setInt(&b, "sswu.isogeny.b", iso.B); err != nil {
+			return nil, err
+		}
+		EPrime = curve{p, a.Mod(&a, p), b.Mod(&b, p)}
+		suite.A = []string{EPrime.a.String()}
+		suite.B = []string{EPrime.b.String()}
+
+		maps := [][]string{iso.XNum, iso.XDen, iso.YNum, iso.YDen}
+		var polys isogeny
+		coeffs := make([][][]string, len(maps))
+		for i, m := range maps {
+			if len(m) == 0 {
+				return nil, errors.New("sswu: the isogeny maps must not be empty")
+			}
+			polys[i] = make([]big.Int, len(m))
+			coeffs[i] = make([][]string, len(m))
+			for j := range m {
+				c := &polys[i][j]
+				if err := setInt(c, "sswu.isogeny coefficient", m[j]); err != nil {
+					return nil, err
+				}
+				coeffs[i][j] = []string{c.Mod(c, p).String()}
+			}
+		}
+		if err := polys.check(EPrime, E); err != nil {
+			return nil, err
+		}
+		suite.Isogeny = &Isogeny{
+			XMap: RationalPolynomial{Num: coeffs[0], Den: coeffs[1]},
+			YMap: RationalPolynomial{Num: coeffs[2], Den: coeffs[3]},
+		}
+	}
+	if EPrime.a.Sign() == 0 || EPrime.b.Sign() == 0 {
+		return nil, errors.New("sswu: the map needs a curve with ab ≠ 0, set the isogeny")
+	}
+
+	// RFC 9380, appendix H.2: Z is a non-square, Z ≠ -1, g(x) - Z is irreducible and
+	// g(b / (Z·a)) is a square
+	var z, t big.Int
+	z.SetInt64(int64(params.Z)).Mod(&z, p)
+	if z.Sign() == 0 || big.Jacobi(&z, p) != -1 {
+		return nil, errors.New("sswu: Z must be a non-square of 𝔽p")
+	}
+	if t.Add(&z, big.NewInt(1)).Cmp(p) == 0 {
+		return nil, errors.New("sswu: Z must not be -1")
+	}
+	if EPrime.hasRoot(&z) {
+		return nil, errors.New("sswu: x³ + ax + b - Z must be irreducible")
+	}
+	t.Mul(&z, EPrime.a).ModInverse(&t, p).Mul(&t, EPrime.b).Mod(&t, p)
+	EPrime.rhs(&t, &t)
+	if big.Jacobi(&t, p) != 1 {
+		return nil, errors.New("sswu: g(b / (Z·a)) must be a square")
+	}
+
+	return &suite, nil
+}
+
+// isogeny holds the coefficients of xNum, xDen, yNum and yDen, by increasing degree, the
+// leading 1 of the denominators being omitted
+type isogeny [4][]big.Int
+
+// nbIsogenyChecks is the number of points of E' mapped to E by isogeny.check
+const nbIsogenyChecks = 4
+
+// check maps a few points of E' and returns an error if one of their images is not on E,
+// which catches a wrong coefficient of the isogeny with overwhelming probability
+func (iso *isogeny) check(EPrime, E curve) error {
+	p := E.p
+	nbChecked := 0
+	var x, y, y2 big.Int
+	for x.SetUint64(2); nbChecked < nbIsogenyChecks; x.Add(&x, big.NewInt(1)) {
+		if x.Cmp(p) >= 0 || x.BitLen() > 16 {
+			return errors.New("sswu: no point of E' found to check the isogeny")
+		}
+		if y.ModSqrt(EPrime.rhs(&y2, &x), p) == nil {
+			continue
+		}
+		xDen := iso.eval(1, &x, p)
+		yDen := iso.eval(3, &x, p)
+		if xDen.Sign() == 0 || yDen.Sign() == 0 {
+			// a point of the kernel
+			continue
+		}
+		var X, Y big.Int
+		X.ModInverse(xDen, p).Mul(&X, iso.eval(0, &x, p)).Mod(&X, p)
+		Y.ModInverse(yDen, p).Mul(&Y, iso.eval(2, &x, p)).Mul(&Y, &y).Mod(&Y, p)
+		if !E.isOnCurve(point{&X, &Y}) {
+			return errors.New("sswu: the isogeny does not map E' to E, check its coefficients")
+		}
+		nbChecked++
+	}
+	return nil
+}
+
+// eval returns the i-th polynomial of the isogeny at x; the denominators (odd i) are monic
+func (iso *isogeny) eval(i int, x, p *big.Int) *big.Int {
+	var res big.Int
+	if i%2 == 1 {
+		res.SetUint64(1)
+	}
+	c := iso[i]
+	for j := len(c) - 1; j >= 0; j-- {
+		res.Mul(&res, x).Add(&res, &c[j]).Mod(&res, p)
+	}
+	return &res
+}
+
+func setInt(z *big.Int, name, s string) error {
+	if _, ok := z.SetString(s, 0); !ok {
+		return fmt.Errorf("invalid %s: %q", name, s)
+	}
+	return nil
+}
+
+// isPrimitiveThirdRootOfOne returns true if x³ = 1 and x ≠ 1 mod q
+func isPrimitiveThirdRootOfOne(x, q *big.Int) bool {
+	var t big.Int
+	return x.Cmp(big.NewInt(1)) != 0 && t.Exp(x, big.NewInt(3), q).Cmp(big.NewInt(1)) == 0
+}
+
+// curve is the curve y² = x³ + ax + b over 𝔽p, with the affine arithmetic needed to check
+// the parameters of a custom curve
+type curve struct {
+	p, a, b *big.Int
+}
+
+// point is an affine point of a curve; the point at infinity has nil coordinates
+type point struct {
+	x, y *big.Int
+}
+
+// rhs sets z = x³ + ax + b
+func (E curve) rhs(z, x *big.Int) *big.Int {
+	var t big.Int
+	t.Mul(x, x).Add(&t, E.a).Mul(&t, x).Add(&t, E.b)
+	return z.Mod(&t, E.p)
+}
+
+func (E curve) isInfinity(P point) bool {
+	return P.x == nil
+}
+
+func (E curve) isOnCurve(P point) bool {
+	var l, r big.Int
+	l.Mul(P.y, P.y).Mod(&l, E.p)
+	return l.Cmp(E.rhs(&r, P.x)) == 0
+}
+
+func (E curve) equal(P, Q point) bool {
+	if E.isInfinity(P) || E.isInfinity(Q) {
+		return E.isInfinity(P) && E.isInfinity(Q)
+	}
+	return P.x.Cmp(Q.x) == 0 && P.y.Cmp(Q.y) == 0
+}
+
+func (E curve) add(P, Q point) point {
+	if E.isInfinity(P) {
+		return Q
+	}
+	if E.isInfinity(Q) {
+		return P
+	}
+	var s, t big.Int
+	if P.x.Cmp(Q.x) == 0 {
+		if t.Add(P.y, Q.y).Mod(&t, E.p).Sign() == 0 {
+			return point{}
+		}
+		// s = (3x² + a) / 2y
+		s.Mul(P.x, P.x).Mul(&s, big.NewInt(3)).Add(&s, E.a)
+		t.Lsh(P.y, 1).ModInverse(&t, E.p)
+	} else {
+		// s = (y₂ - y₁) / (x₂ - x₁)
+		s.Sub(Q.y, P.y)
+		t.Sub(Q.x, P.x).Mod(&t, E.p).ModInverse(&t, E.p)
+	}
+	s.Mul(&s, &t).Mod(&s, E.p)
+
+	var x, y big.Int
+	x.Mul(&s, &s).Sub(&x, P.x).Sub(&x, Q.x).Mod(&x, E.p)
+	y.Sub(P.x, &x).Mul(&y, &s).Sub(&y, P.y).Mod(&y, E.p)
+	return point{&x, &y}
+}
+
+// scalarMul returns [k]P, k ≥ 0
+func (E curve) scalarMul(P point, k *big.Int) point {
+	var res point
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = E.add(res, res)
+		if k.Bit(i) == 1 {
+			res = E.add(res, P)
+		}
+	}
+	return res
+}
+
+// hasRoot returns true if x³ + ax + b - z has a root in 𝔽p, that is if gcd(xᵖ - x, x³ + ax + b - z) ≠ 1
+func (E curve) hasRoot(z *big.Int) bool {
+	p := E.p
+	var c0 big.Int
+	c0.Sub(E.b, z).Mod(&c0, p)
+	// f = x³ + a·x + c0; polynomials mod f are [3]big.Int of increasing degree
+	mulMod := func(u, v [3]big.Int) [3]big.Int {
+		var w [5]big.Int
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				var t big.Int
+				t.Mul(&u[i], &v[j])
+				w[i+j].Add(&w[i+j], &t)
+			}
+		}
+		// x⁴ = -a·x² - c0·x, x³ = -a·x - c0
+		for i := 4; i >= 3; i-- {
+			var t big.Int
+			t.Mul(&w[i], E.a)
+			w[i-2].Sub(&w[i-2], &t)
+			t.Mul(&w[i], &c0)
+			w[i-3].Sub(&w[i-3], &t)
+		}
+		var res [3]big.Int
+		for i := range res {
+			res[i].Mod(&w[i], p)
+		}
+		return res
+	}
+
+	// xᵖ mod f
+	var res, x [3]big.Int
+	res[0].SetUint64(1)
+	x[1].SetUint64(1)
+	for i := p.BitLen() - 1; i >= 0; i-- {
+		res = mulMod(res, res)
+		if p.Bit(i) == 1 {
+			res = mulMod(res, x)
+		}
+	}
+	// g = xᵖ - x mod f; gcd(f, g) ≠ 1 iff f has a root, f being of degree 3
+	res[1].Sub(&res[1], big.NewInt(1)).Mod(&res[1], p)
+	f := make([]big.Int, 4)
+	f[0].Set(&c0)
+	f[1].Set(E.a)
+	f[3].SetUint64(1)
+	return polyGCDDegree(p, f, res[:]) > 0
+}
+
+// polyGCDDegree returns the degree of gcd(u, v) in 𝔽p[x], the polynomials being given by
+// their coefficients of increasing degree; u and v are overwritten
+func polyGCDDegree(p *big.Int, u, v []big.Int) int {
+	trim := func(f []big.Int) []big.Int {
+		for len(f) > 0 && f[len(f)-1].Sign() == 0 {
+			f = f[:len(f)-1]
+		}
+		return f
+	}
+	u, v = trim(u), trim(v)
+	for len(v) > 0 {
+		// u = u mod v
+		var inv big.Int
+		inv.ModInverse(&v[len(v)-1], p)
+		for len(u) >= len(v) {
+			var q big.Int
+			q.Mul(&u[len(u)-1], &inv).Mod(&q, p)
+			shift := len(u) - len(v)
+			for i := range v {
+				var t big.Int
+				t.Mul(&q, &v[i])
+				u[shift+i].Sub(&u[shift+i], &t).Mod(&u[shift+i], p)
+			}
+			u = trim(u)
+		}
+		u, v = v, u
+	}
+	return len(u) - 1
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func secp256k1Params() CustomCurveParams {
+	return CustomCurveParams{
+		Name:       "k1",
+		ImportPath: "example.com/k1",
+		P:          SECP256K1.FpModulus,
+		R:          SECP256K1.FrModulus,
+		A:          "0",
+		B:          "7",
+		Generator: CustomPoint{
+			X: "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			Y: "0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+		},
+		GLV: &CustomGLV{
+			ThirdRootOne: "55594575648329892869085402983802832744385952214688224221778511981742606582254",
+			Lambda:       "37718080363155996902926221483475020450927657555482586988616620542887997980018",
+		},
+	}
+}
+
+func TestNewCustomCurve(t *testing.T) {
+	t.Parallel()
+	c, err := NewCustomCurve(secp256k1Params())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.G1.GLV || c.G1.CofactorCleaning || !c.A0() || !c.G1PrimeOrder() || c.HasG2() {
+		t.Fatal("wrong configuration of secp256k1")
+	}
+	// the SVDW parameters must be the ones of RFC 9380, section 8.7
+	if !reflect.DeepEqual(c.HashE1, SECP256K1.HashE1) {
+		t.Fatalf("expected SVDW parameters %v, got %v", SECP256K1.HashE1, c.HashE1)
+	}
+
+	// P-256, with the SSWU map of RFC 9380, section 8.2
+	p256 := CustomCurveParams{
+		Name:       "p256",
+		ImportPath: "example.com/p256",
+		P:          "0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff",
+		R:          "0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551",
+		A:          "-3",
+		B:          "0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b",
+		Generator: CustomPoint{
+			X: "0x6b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
+			Y: "0x4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5",
+		},
+		SSWU: &CustomSSWU{Z: -10},
+	}
+	if c, err = NewCustomCurve(p256); err != nil {
+		t.Fatal(err)
+	}
+	if c.A0() || c.FpUnusedBits != 0 {
+		t.Fatal("wrong configuration of P-256")
+	}
+	p256.SSWU.Z = -2
+	if _, err = NewCustomCurve(p256); err == nil {
+		t.Fatal("Z = -2 should be rejected: x³ - 3x + b + 2 is reducible")
+	}
+}
+
+func TestNewCustomCurveIsogeny(t *testing.T) {
+	t.Parallel()
+	// G1 of BLS12-381, with the SSWU map to the 11-isogenous curve of RFC 9380, section 8.8.1
+	sswu := BLS12_381.HashE1.(*HashSuiteSswu)
+	flatten := func(coeffs [][]string) []string {
+		res := make([]string, len(coeffs))
+		for i := range coeffs {
+			res[i] = coeffs[i][0]
+		}
+		return res
+	}
+	params := CustomCurveParams{
+		Name:       "g381",
+		ImportPath: "example.com/g381",
+		P:          BLS12_381.FpModulus,
+		R:          BLS12_381.FrModulus,
+		A:          "0",
+		B:          "4",
+		Generator: CustomPoint{
+			X: "0x17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+			Y: "0x08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1",
+		},
+		Cofactor: "0x396c8c005555e1568c00aaab0000aaab",
+		SSWU: &CustomSSWU{
+			Z: sswu.Z[0],
+			Isogeny: &CustomIsogeny{
+				A:    sswu.A[0],
+				B:    sswu.B[0],
+				XNum: flatten(sswu.Isogeny.XMap.Num),
+				XDen: flatten(sswu.Isogeny.XMap.Den),
+				YNum: flatten(sswu.Isogeny.YMap.Num),
+				YDen: flatten(sswu.Isogeny.YMap.Den),
+			},
+		},
+	}
+	c, err := NewCustomCurve(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, ok := c.HashE1.(*HashSuiteSswu); !ok || h.Isogeny == nil || len(h.Isogeny.YMap.Den) != len(sswu.Isogeny.YMap.Den) {
+		t.Fatal("hash to curve should use the SSWU map and the isogeny")
+	}
+
+	// a wrong coefficient of any of the maps is caught
+	iso := params.SSWU.Isogeny
+	for _, m := range [][]string{iso.XNum, iso.XDen, iso.YNum, iso.YDen} {
+		old := m[1]
+		m[1] = "1"
+		if _, err = NewCustomCurve(params); err == nil {
+			t.Fatal("an isogeny which doesn't map E' to E should be rejected")
+		}
+		m[1] = old
+	}
+	iso.B = "1"
+	if _, err = NewCustomCurve(params); err == nil {
+		t.Fatal("an isogeny from the wrong curve should be rejected")
+	}
+}
+
+func TestNewCustomCurveInvalid(t *testing.T) {
+	t.Parallel()
+	for name, edit := range map[string]func(*CustomCurveParams){
+		"existing curve":    func(p *CustomCurveParams) { p.Name = "secp256k1" },
+		"composite p":       func(p *CustomCurveParams) { p.P = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d" },
+		"not on the curve":  func(p *CustomCurveParams) { p.B = "5" },
+		"wrong order":       func(p *CustomCurveParams) { p.R = SECP256K1.FpModulus },
+		"wrong cofactor":    func(p *CustomCurveParams) { p.Cofactor = "2" },
+		"wrong eigenvalue":  func(p *CustomCurveParams) { p.GLV.Lambda = "2" },
+		"wrong third root":  func(p *CustomCurveParams) { p.GLV.ThirdRootOne = "1" },
+		"sswu with a = 0":   func(p *CustomCurveParams) { p.SSWU = &CustomSSWU{Z: -11} },
+		"invalid integer":   func(p *CustomCurveParams) { p.A = "zero" },
+		"invalid name":      func(p *CustomCurveParams) { p.Name = "K1" },
+		"no import path":    func(p *CustomCurveParams) { p.ImportPath = "" },
+		"negative cofactor": func(p *CustomCurveParams) { p.Cofactor = "-1" },
+	} {
+		params := secp256k1Params()
+		edit(&params)
+		if _, err := NewCustomCurve(params); err == nil {
+			t.Fatalf("%s: the parameters should be rejected", name)
+		}
+	}
+}
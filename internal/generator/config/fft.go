@@ -0,0 +1,183 @@
+package config
+
+import (
+	"errors"
+	"math/big"
+)
+
+// FFT describes the largest 2-adic subgroup of the multiplicative group of a
+// field, from which the fft package over the field is generated
+type FFT struct {
+	GeneratorFullMultiplicativeGroup uint64 // generator of 𝔽*
+	GeneratorMaxTwoAdicSubgroup      string // generator of the largest 2-adic subgroup of 𝔽*, in base 10
+	LogTwoOrderMaxTwoAdicSubgroup    uint64 // log₂ of the order of the largest 2-adic subgroup of 𝔽*
+}
+
+// NewFFT returns the FFT parameters of the field of the given modulus (in base
+// 10 or 16 with the 0x prefix), where generator generates 𝔽*. The generator
+// of the largest 2-adic subgroup is generator^((q-1)/2ˢ), s being the 2-adicity
+// of q-1.
+func NewFFT(modulus string, generator uint64) (FFT, error) {
+	q, ok := new(big.Int).SetString(modulus, 0)
+	if !ok {
+		return FFT{}, errors.New("invalid modulus")
+	}
+	qMinusOne := new(big.Int).Sub(q, big.NewInt(1))
+	s := qMinusOne.TrailingZeroBits()
+	if s == 0 {
+		return FFT{}, errors.New("the modulus must be odd")
+	}
+
+	g := new(big.Int).SetUint64(generator)
+	e := new(big.Int).Rsh(qMinusOne, s)
+	root := new(big.Int).Exp(g, e, q)
+
+	// the root must be of order exactly 2ˢ, which is the case iff generator is
+	// not a square
+	if new(big.Int).Exp(root, new(big.Int).Lsh(big.NewInt(1), s-1), q).Cmp(qMinusOne) != 0 {
+		return FFT{}, errors.New("generator must generate the multiplicative group")
+	}
+
+	return FFT{
+		GeneratorFullMultiplicativeGroup: generator,
+		GeneratorMaxTwoAdicSubgroup:      root.String(),
+		LogTwoOrderMaxTwoAdicSubgroup:    uint64(s),
+	}, nil
+}
+
+// maxRhoIterations bounds the work of Pollard's rho on each composite factor of q-1, so that
+// MultiplicativeGenerator fails within a second or so: it finds the prime factors up to about
+// 2⁴⁰, which is not enough for the scalar fields of bn254 or bls12-377
+const maxRhoIterations = 1 << 20
+
+// MultiplicativeGenerator returns the smallest generator of 𝔽*, 𝔽 being the field of the
+// given modulus (in base 10 or 16 with the 0x prefix).
+//
+// It factors q-1 with trial division and Pollard's rho, and returns an error if that fails
+// within maxRhoIterations; the generator must then be found by other means.
+func MultiplicativeGenerator(modulus string) (uint64, error) {
+	q, ok := new(big.Int).SetString(modulus, 0)
+	if !ok {
+		return 0, errors.New("invalid modulus")
+	}
+	if !q.ProbablyPrime(20) {
+		return 0, errors.New("the modulus must be prime")
+	}
+	qMinusOne := new(big.Int).Sub(q, big.NewInt(1))
+	factors, err := primeFactors(qMinusOne)
+	if err != nil {
+		return 0, err
+	}
+
+	// g generates 𝔽* iff g^((q-1)/f) ≠ 1 for all the prime factors f of q-1
+	var e, t big.Int
+	for g := uint64(2); ; g++ {
+		isGenerator := true
+		for _, f := range factors {
+			e.Div(qMinusOne, f)
+			if t.Exp(t.SetUint64(g), &e, q).Cmp(big.NewInt(1)) == 0 {
+				isGenerator = false
+				break
+			}
+		}
+		if isGenerator {
+			return g, nil
+		}
+	}
+}
+
+// primeFactors returns the distinct prime factors of n > 1
+func primeFactors(n *big.Int) ([]*big.Int, error) {
+	n = new(big.Int).Set(n)
+	var factors []*big.Int
+	var r big.Int
+
+	// trial division by the small primes
+	for p := int64(2); p < 1<<12; p++ {
+		bp := big.NewInt(p)
+		if !bp.ProbablyPrime(0) {
+			continue
+		}
+		if r.Mod(n, bp).Sign() != 0 {
+			continue
+		}
+		factors = append(factors, bp)
+		for r.Mod(n, bp).Sign() == 0 {
+			n.Div(n, bp)
+		}
+	}
+
+	// Pollard's rho on the composite cofactors
+	toFactor := []*big.Int{n}
+	for len(toFactor) > 0 {
+		m := toFactor[len(toFactor)-1]
+		toFactor = toFactor[:len(toFactor)-1]
+		if m.Cmp(big.NewInt(1)) == 0 {
+			continue
+		}
+		if m.ProbablyPrime(20) {
+			isNew := true
+			for _, f := range factors {
+				isNew = isNew && f.Cmp(m) != 0
+			}
+			if isNew {
+				factors = append(factors, m)
+			}
+			continue
+		}
+		d := pollardRho(m)
+		if d == nil {
+			return nil, errors.New("failed to factor q-1")
+		}
+		toFactor = append(toFactor, d, new(big.Int).Div(m, d))
+	}
+	return factors, nil
+}
+
+// pollardRho returns a non-trivial factor of the composite n, or nil if none is found
+// within maxRhoIterations, with Brent's variant of Pollard's rho; when the cycle is
+// found without splitting n, it restarts with another polynomial x² + c.
+func pollardRho(n *big.Int) *big.Int {
+	one := big.NewInt(1)
+	var x, y, ys, q, t, d big.Int
+	iterations := 0
+	for c := int64(1); iterations < maxRhoIterations; c++ {
+		bc := big.NewInt(c)
+		f := func(z *big.Int) { z.Mul(z, z).Add(z, bc).Mod(z, n) }
+
+		y.SetInt64(2)
+		q.SetInt64(1)
+		d.SetInt64(1)
+		const m = 128
+		for r := 1; d.Cmp(one) == 0 && iterations < maxRhoIterations; r *= 2 {
+			x.Set(&y)
+			for i := 0; i < r; i++ {
+				f(&y)
+			}
+			for k := 0; k < r && d.Cmp(one) == 0; k += m {
+				ys.Set(&y)
+				for i := 0; i < m && i < r-k; i++ {
+					f(&y)
+					t.Sub(&x, &y).Abs(&t)
+					q.Mul(&q, &t).Mod(&q, n)
+				}
+				d.GCD(nil, nil, &q, n)
+				iterations += m
+			}
+		}
+		if d.Cmp(n) == 0 {
+			// backtrack from the last saved point
+			for {
+				f(&ys)
+				t.Sub(&x, &ys).Abs(&t)
+				if d.GCD(nil, nil, &t, n); d.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if d.Cmp(one) != 0 && d.Cmp(n) != 0 {
+			return new(big.Int).Set(&d)
+		}
+	}
+	return nil
+}
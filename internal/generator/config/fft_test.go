@@ -0,0 +1,36 @@
+package config
+
+import "testing"
+
+func TestMultiplicativeGenerator(t *testing.T) {
+	t.Parallel()
+	for _, c := range []struct {
+		modulus   string
+		generator uint64
+	}{
+		{"0xFFFFFFFF00000001", 7},
+		{"0x78000001", 31},
+		{BLS12_381.FrModulus, BLS12_381.FrGenerator},
+		{BLS24_317.FrModulus, BLS24_317.FrGenerator},
+	} {
+		g, err := MultiplicativeGenerator(c.modulus)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g != c.generator {
+			t.Fatalf("expected generator %d of 𝔽*, q = %s, got %d", c.generator, c.modulus, g)
+		}
+	}
+
+	if _, err := MultiplicativeGenerator("0xFFFFFFFF00000003"); err == nil {
+		t.Fatal("a composite modulus should be rejected")
+	}
+
+	// r-1 has a prime factor of about 2⁵⁰ (bn254) or 2⁶⁰ (bls12-377), out of reach of the
+	// bounded Pollard's rho
+	for _, modulus := range []string{BN254.FrModulus, BLS12_377.FrModulus} {
+		if _, err := MultiplicativeGenerator(modulus); err == nil {
+			t.Fatalf("factoring q-1 should fail, q = %s", modulus)
+		}
+	}
+}
@@ -193,10 +193,12 @@ type HashSuiteInfo struct {
 	Field             *field.Extension
 	FieldCoordName    string
 	Name              string
+	CurvePackagePath  string // import path of the curve package
 	FieldSizeMod256   uint8
 	PrecomputedParams []field.Element // PrecomputedParams[0][n] correspond to integer cₙ₋₁ in std doc
 	// PrecomputedParams[n≥1] correspond to field element c_( len(PrecomputedParams[0]) + n - 1 ) in std doc
 	Z                []big.Int // z (or zeta) is a quadratic non-residue with //TODO: some extra nice properties, refer to WB19
 	CofactorClearing bool
 	MappingAlgorithm FieldElementToCurvePoint
+	A0               bool // the curve has an equation y² = x³ + b
 }
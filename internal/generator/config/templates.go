@@ -0,0 +1,29 @@
+package config
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ExtractTemplates copies the template directory embedded in fsys (the Templates of the
+// generator packages) in dir, and returns its path. It is used by the generators running
+// outside of internal/generator, as bavard reads the templates from disk.
+func ExtractTemplates(fsys embed.FS, dir string) (string, error) {
+	err := fs.WalkDir(fsys, "template", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(path))
+		if d.IsDir() {
+			return os.MkdirAll(dst, 0o700)
+		}
+		data, err := fsys.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dst, data, 0o600)
+	})
+	return filepath.Join(dir, "template"), err
+}
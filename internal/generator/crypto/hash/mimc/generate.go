@@ -1,6 +1,9 @@
 package mimc
 
 import (
+	"embed"
+	"errors"
+	"math/big"
 	"os"
 	"path/filepath"
 
@@ -8,16 +11,105 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Templates are the mimc templates, embedded for the generators running outside of
+// internal/generator (see GenerateWithTemplates)
+//
+//go:embed template
+var Templates embed.FS
 
-	conf.Package = "mimc"
-	entries := []bavard.Entry{
-		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
-		{File: filepath.Join(baseDir, "mimc.go"), Templates: []string{"mimc.go.tmpl"}},
+// Config is the data of the mimc templates
+type Config struct {
+	config.FieldDependency
+	Package  string
+	NbRounds int
+	Exponent int    // exponent of the round function x ↦ xᵉ, coprime with q-1
+	ExpChain []bool // bits of Exponent after the most significant one
+}
+
+// nbRounds of the curves, and the exponent of their round function if not 5
+var (
+	nbRounds = map[string]int{
+		"bn254":     110,
+		"bls12-381": 111,
+		"bls12-377": 62,
+		"bls12-378": 109,
+		"bls24-315": 109,
+		"bls24-317": 91,
+		"bw6-633":   136,
+		"bw6-761":   163,
+		"bw6-756":   163,
+	}
+	exponents = map[string]int{
+		"bls12-377": 17,
+		"bls24-317": 7,
+	}
+)
+
+// NewConfig returns the mimc configuration of the field described by fieldDependency, of the
+// given modulus: the exponent of the round function is the smallest of 3, 5, 7, 11, 13 and 17
+// coprime with q-1, and the number of rounds is ⌈log_e(q)⌉.
+func NewConfig(fieldDependency config.FieldDependency, modulus string) (Config, error) {
+	q, ok := new(big.Int).SetString(modulus, 0)
+	if !ok {
+		return Config{}, errors.New("invalid modulus")
+	}
+	qMinusOne := new(big.Int).Sub(q, big.NewInt(1))
+
+	exponent := 0
+	for _, e := range []int64{3, 5, 7, 11, 13, 17} {
+		if new(big.Int).GCD(nil, nil, big.NewInt(e), qMinusOne).Cmp(big.NewInt(1)) == 0 {
+			exponent = int(e)
+			break
+		}
+	}
+	if exponent == 0 {
+		return Config{}, errors.New("no exponent ⩽ 17 of the MiMC round function is coprime with q-1")
+	}
+
+	rounds := 0
+	for pow := big.NewInt(1); pow.Cmp(q) < 0; rounds++ {
+		pow.Mul(pow, big.NewInt(int64(exponent)))
 	}
+
+	return newConfig(fieldDependency, rounds, exponent), nil
+}
+
+func newConfig(fieldDependency config.FieldDependency, nbRounds, exponent int) Config {
+	conf := Config{
+		FieldDependency: fieldDependency,
+		Package:         "mimc",
+		NbRounds:        nbRounds,
+		Exponent:        exponent,
+	}
+	for i := big.NewInt(int64(exponent)).BitLen() - 2; i >= 0; i-- {
+		conf.ExpChain = append(conf.ExpChain, exponent>>i&1 == 1)
+	}
+	return conf
+}
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	exponent, ok := exponents[conf.Name]
+	if !ok {
+		exponent = 5
+	}
+	mimcConf := newConfig(config.FieldDependency{
+		FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+		FieldPackageName: "fr",
+		ElementType:      "fr.Element",
+	}, nbRounds[conf.Name], exponent)
+
 	os.Remove(filepath.Join(baseDir, "utils.go"))
 	os.Remove(filepath.Join(baseDir, "utils_test.go"))
 
-	return bgen.Generate(conf, conf.Package, "./crypto/hash/mimc/template", entries...)
+	return GenerateWithTemplates(mimcConf, baseDir, "./crypto/hash/mimc/template", bgen)
+}
 
+// GenerateWithTemplates generates the mimc package of conf in baseDir, from the templates
+// in templateDir
+func GenerateWithTemplates(conf Config, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mimc.go"), Templates: []string{"mimc.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, templateDir, entries...)
 }
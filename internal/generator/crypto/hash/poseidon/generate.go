@@ -0,0 +1,43 @@
+package poseidon
+
+import (
+	"math/big"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+type poseidonConfig struct {
+	config.Curve
+	Alpha int // degree of the s-box x ↦ xᵅ
+}
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	conf.Package = "poseidon"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "grain.go"), Templates: []string{"grain.go.tmpl"}},
+		{File: filepath.Join(baseDir, "poseidon.go"), Templates: []string{"poseidon.go.tmpl"}},
+		{File: filepath.Join(baseDir, "poseidon_test.go"), Templates: []string{"poseidon.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sponge.go"), Templates: []string{"sponge.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sponge_test.go"), Templates: []string{"sponge.test.go.tmpl"}},
+	}
+
+	return bgen.Generate(poseidonConfig{conf, Alpha(conf)}, conf.Package, "./crypto/hash/poseidon/template", entries...)
+
+}
+
+// Alpha returns the smallest integer α ≥ 3 such that x ↦ xᵅ is a permutation
+// of the scalar field, i.e. gcd(α, r-1) = 1.
+func Alpha(conf config.Curve) int {
+	one := big.NewInt(1)
+	rMinusOne := new(big.Int).Sub(conf.FrInfo.Modulus(), one)
+	var gcd big.Int
+	for alpha := 3; ; alpha++ {
+		if gcd.GCD(nil, nil, big.NewInt(int64(alpha)), rMinusOne).Cmp(one) == 0 {
+			return alpha
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package poseidon2
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/poseidon"
+)
+
+type poseidon2Config struct {
+	config.Curve
+	Alpha int // degree of the s-box x ↦ xᵅ
+}
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	conf.Package = "poseidon2"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "poseidon2.go"), Templates: []string{"poseidon2.go.tmpl"}},
+		{File: filepath.Join(baseDir, "poseidon2_test.go"), Templates: []string{"poseidon2.test.go.tmpl"}},
+	}
+
+	return bgen.Generate(poseidon2Config{conf, poseidon.Alpha(conf)}, conf.Package, "./crypto/hash/poseidon2/template", entries...)
+
+}
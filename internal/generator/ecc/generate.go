@@ -1,7 +1,9 @@
 package ecc
 
 import (
+	"embed"
 	"fmt"
+	"math/big"
 	"path/filepath"
 	"reflect"
 	"sort"
@@ -12,7 +14,19 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// Templates are the ecc templates, embedded for the generators running outside of
+// internal/generator (see GenerateWithTemplates)
+//
+//go:embed template
+var Templates embed.FS
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	return GenerateWithTemplates(conf, baseDir, "./ecc/template", bgen)
+}
+
+// GenerateWithTemplates generates the curve package of conf in baseDir, from the templates
+// in templateDir
+func GenerateWithTemplates(conf config.Curve, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
 
@@ -29,12 +43,17 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			{File: filepath.Join(baseDir, fmt.Sprintf("hash_to_%s_test.go", point.PointName)), Templates: []string{"tests/hash_to_curve.go.tmpl"}}}
 
 		hashConf := suite.GetInfo(conf.Fp, point, conf.Name)
+		hashConf.CurvePackagePath = conf.CurvePackagePath
+		hashConf.A0 = conf.A0()
 
 		funcs := make(template.FuncMap)
 		funcs["asElement"] = hashConf.Field.Base.WriteElement
+		funcs["abs"] = func(z big.Int) big.Int {
+			return *new(big.Int).Abs(&z)
+		}
 		bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
 
-		return bgen.GenerateWithOptions(hashConf, packageName, "./ecc/template", bavardOpts, entries...)
+		return bgen.GenerateWithOptions(hashConf, packageName, templateDir, bavardOpts, entries...)
 	}
 
 	if err := genHashToCurve(&conf.G1, conf.HashE1); err != nil {
@@ -50,7 +69,10 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "g1_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 	}
 	g1 := pconf{conf, conf.G1}
-	if err := bgen.Generate(g1, packageName, "./ecc/template", entries...); err != nil {
+	if err := bgen.Generate(g1, packageName, templateDir, entries...); err != nil {
+		return err
+	}
+	if err := generateCT(conf, baseDir, templateDir, bgen); err != nil {
 		return err
 	}
 
@@ -59,9 +81,19 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "multiexp.go"), Templates: []string{"multiexp.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multiexp_affine.go"), Templates: []string{"multiexp_affine.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multiexp_jacobian.go"), Templates: []string{"multiexp_jacobian.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_precomputed.go"), Templates: []string{"multiexp_precomputed.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_stream.go"), Templates: []string{"multiexp_stream.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_batch.go"), Templates: []string{"multiexp_batch.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
 	}
 	conf.Package = packageName
+	if conf.Custom != nil {
+		// the parameters of the curves of gnark-crypto are written by hand
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, packageName+".go"), Templates: []string{"curve.go.tmpl"}},
+		)
+	}
 	funcs := make(template.FuncMap)
 	funcs["last"] = func(x int, a interface{}) bool {
 		return x == reflect.ValueOf(a).Len()-1
@@ -160,33 +192,55 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	}
 
 	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", bavardOpts, entries...); err != nil {
+	if err := bgen.GenerateWithOptions(conf, packageName, templateDir, bavardOpts, entries...); err != nil {
 		return err
 	}
 
-	// No G2 for secp256k1
-	if conf.Equal(config.SECP256K1) {
-		return nil
-	}
-
-	// marshal
+	// marshal; without 2 spare bits in the most significant byte of fp, the points are only
+	// serialized uncompressed, with no flag
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
 	}
+	if conf.FpUnusedBits < 2 {
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal_raw.go.tmpl"}},
+			{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal_raw.go.tmpl"}},
+		}
+	}
 
 	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", marshal, entries...); err != nil {
+	if err := bgen.GenerateWithOptions(conf, packageName, templateDir, marshal, entries...); err != nil {
 		return err
 	}
 
+	if !conf.HasG2() {
+		return nil
+	}
+
 	// G2
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 	}
 	g2 := pconf{conf, conf.G2}
-	return bgen.Generate(g2, packageName, "./ecc/template", entries...)
+	return bgen.Generate(g2, packageName, templateDir, entries...)
+}
+
+// GenerateCT generates the constant-time scalar multiplication on G1; unlike the rest of the
+// package, it is also generated for the curves with a ≠ 0, whose arithmetic is not generated.
+func GenerateCT(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	return generateCT(conf, baseDir, "./ecc/template", bgen)
+}
+
+func generateCT(conf config.Curve, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
+	packageName := strings.ReplaceAll(conf.Name, "-", "")
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "g1_ct.go"), Templates: []string{"point_ct.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g1_ct_test.go"), Templates: []string{"tests/point_ct.go.tmpl"}},
+	}
+	g1 := pconf{conf, conf.G1}
+	return bgen.Generate(g1, packageName, templateDir, entries...)
 }
 
 type pconf struct {
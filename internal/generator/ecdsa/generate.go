@@ -1,13 +1,26 @@
 package ecdsa
 
 import (
+	"embed"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// Templates are the ecdsa templates, embedded for the generators running outside of
+// internal/generator (see GenerateWithTemplates)
+//
+//go:embed template
+var Templates embed.FS
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	return GenerateWithTemplates(conf, baseDir, "./ecdsa/template", bgen)
+}
+
+// GenerateWithTemplates generates the ecdsa package of conf in baseDir/ecdsa, from the
+// templates in templateDir
+func GenerateWithTemplates(conf config.Curve, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
 	// ecdsa
 	conf.Package = "ecdsa"
 	baseDir = filepath.Join(baseDir, conf.Package)
@@ -19,6 +32,6 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./ecdsa/template", entries...)
+	return bgen.Generate(conf, conf.Package, templateDir, entries...)
 
 }
@@ -1,14 +1,51 @@
 package fft
 
 import (
+	"embed"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Templates are the fft templates, embedded for the generators running outside of
+// internal/generator (see GenerateWithTemplates)
+//
+//go:embed template
+var Templates embed.FS
 
+// Config is the data of the fft templates
+type Config struct {
+	config.FieldDependency
+	config.FFT
+	Package string // current package being generated
+
+	// CurvePackagePath is the import path of the curve whose encoder serializes
+	// the domain. If empty, the domain is serialized on its own.
+	CurvePackagePath string
+}
+
+// NewConfig returns the fft configuration of the field described by fieldDependency,
+// of the given modulus and generator of its multiplicative group.
+func NewConfig(fieldDependency config.FieldDependency, modulus string, generator uint64, curvePackagePath string) (Config, error) {
+	fftConf, err := config.NewFFT(modulus, generator)
+	if err != nil {
+		return Config{}, err
+	}
+	return Config{
+		FieldDependency:  fieldDependency,
+		FFT:              fftConf,
+		CurvePackagePath: curvePackagePath,
+	}, nil
+}
+
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	return GenerateWithTemplates(conf, baseDir, "./fft/template/", bgen)
+}
+
+// GenerateWithTemplates generates the fft package of conf in baseDir, from the templates
+// in templateDir
+func GenerateWithTemplates(conf Config, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
 	conf.Package = "fft"
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
@@ -18,5 +55,5 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./fft/template/", entries...)
+	return bgen.Generate(conf, conf.Package, templateDir, entries...)
 }
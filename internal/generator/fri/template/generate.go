@@ -4,10 +4,12 @@ import (
 	"path/filepath"
 
 	"github.com/consensys/bavard"
-	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/fft"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Generate generates the fri package over the field of conf, next to its fft
+// package (conf.FieldPackagePath/fft).
+func Generate(conf fft.Config, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	// fri commitment scheme
 	conf.Package = "fri"
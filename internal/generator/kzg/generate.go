@@ -16,7 +16,28 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "kzg.go"), Templates: []string{"kzg.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange.go"), Templates: []string{"lagrange.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
+	// readers of the public ceremonies transcripts
+	if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "ceremony.go"), Templates: []string{"ceremony.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ceremony_test.go"), Templates: []string{"ceremony.test.go.tmpl"}},
+		)
+	}
+	if err := bgen.Generate(conf, conf.Package, "./kzg/template/", entries...); err != nil {
+		return err
+	}
+
+	// powers of tau ceremony
+	conf.Package = "mpc"
+	mpcDir := filepath.Join(baseDir, conf.Package)
+	entries = []bavard.Entry{
+		{File: filepath.Join(mpcDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(mpcDir, "mpc.go"), Templates: []string{"mpc.go.tmpl"}},
+		{File: filepath.Join(mpcDir, "mpc_test.go"), Templates: []string{"mpc.test.go.tmpl"}},
+		{File: filepath.Join(mpcDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./kzg/template/mpc/", entries...)
 
 }
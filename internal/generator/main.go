@@ -10,8 +10,11 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator"
 	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/bls"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/poseidon"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/poseidon2"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
@@ -26,6 +29,8 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/schnorr"
+	"github.com/consensys/gnark-crypto/internal/generator/shplonk"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
@@ -58,7 +63,7 @@ func main() {
 			conf.Fr, err = field.NewFieldConfig("fr", "Element", conf.FrModulus, !conf.Equal(config.STARK_CURVE))
 			assertNoError(err)
 
-			conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
+			conf.FpUnusedBits = (64 - conf.Fp.NbBits%64) % 64
 
 			assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")))
 			assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")))
@@ -67,6 +72,8 @@ func main() {
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
 
 			if conf.Equal(config.STARK_CURVE) {
+				// only the constant-time scalar multiplication is generated
+				assertNoError(ecc.GenerateCT(conf, curveDir, bgen))
 				return // TODO @yelhousni
 			}
 
@@ -74,6 +81,8 @@ func main() {
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
 			if conf.Equal(config.SECP256K1) {
+				// generate BIP-340 Schnorr signatures
+				assertNoError(schnorr.Generate(conf, curveDir, bgen))
 				return
 			}
 
@@ -83,15 +92,30 @@ func main() {
 			// generate pairing tests
 			assertNoError(pairing.Generate(conf, curveDir, bgen))
 
+			// generate bls signatures
+			assertNoError(bls.Generate(conf, curveDir, bgen))
+
+			frInfo := config.FieldDependency{
+				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+				FieldPackageName: "fr",
+				ElementType:      "fr.Element",
+			}
+
+			fftConf, err := fft.NewConfig(frInfo, conf.FrModulus, conf.FrGenerator, "github.com/consensys/gnark-crypto/ecc/"+conf.Name)
+			assertNoError(err)
+
 			// generate fri on fr
-			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
+			assertNoError(fri.Generate(fftConf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate fft on fr
-			assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
+			assertNoError(fft.Generate(fftConf, filepath.Join(curveDir, "fr", "fft"), bgen))
 
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "fr", "kzg"), bgen))
 
+			// generate shplonk on fr
+			assertNoError(shplonk.Generate(conf, filepath.Join(curveDir, "fr", "shplonk"), bgen))
+
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
@@ -104,11 +128,9 @@ func main() {
 			// generate mimc on fr
 			assertNoError(mimc.Generate(conf, filepath.Join(curveDir, "fr", "mimc"), bgen))
 
-			frInfo := config.FieldDependency{
-				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
-				FieldPackageName: "fr",
-				ElementType:      "fr.Element",
-			}
+			// generate poseidon and poseidon2 on fr
+			assertNoError(poseidon.Generate(conf, filepath.Join(curveDir, "fr", "poseidon"), bgen))
+			assertNoError(poseidon2.Generate(conf, filepath.Join(curveDir, "fr", "poseidon2"), bgen))
 
 			// generate polynomial on fr
 			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
@@ -130,7 +152,7 @@ func main() {
 			}, filepath.Join(curveDir, "fr", "test_vector_utils"), bgen))
 
 			// generate eddsa on companion curves
-			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
+			assertNoError(fri.Generate(fftConf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate sumcheck on fr
 			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), bgen))
@@ -176,6 +198,18 @@ func main() {
 		defer wg.Done()
 		assertNoError(test_vector_utils.GenerateRationals(bgen))
 	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assertNoError(generateGoldilocks())
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assertNoError(generateBabyBear())
+	}()
 	wg.Wait()
 
 	// format the whole directory
@@ -219,6 +253,62 @@ func main() {
 	wg.Wait()
 }
 
+// generateGoldilocks generates the fft, fri, polynomial and sumcheck packages
+// over the Goldilocks field 𝔽ₚ, p = 2⁶⁴ - 2³² + 1
+func generateGoldilocks() error {
+	const (
+		modulus   = "0xFFFFFFFF00000001"
+		generator = 7 // generator of 𝔽ₚ*
+	)
+	fieldDir := filepath.Join(baseDir, "field", "goldilocks")
+	fieldInfo := config.FieldDependency{
+		FieldPackagePath: "github.com/consensys/gnark-crypto/field/goldilocks",
+		FieldPackageName: "goldilocks",
+		ElementType:      "goldilocks.Element",
+	}
+
+	fftConf, err := fft.NewConfig(fieldInfo, modulus, generator, "")
+	if err != nil {
+		return err
+	}
+	if err = fft.Generate(fftConf, filepath.Join(fieldDir, "fft"), bgen); err != nil {
+		return err
+	}
+	if err = fri.Generate(fftConf, filepath.Join(fieldDir, "fri"), bgen); err != nil {
+		return err
+	}
+	if err = polynomial.Generate(fieldInfo, filepath.Join(fieldDir, "polynomial"), true, bgen); err != nil {
+		return err
+	}
+	if err = sumcheck.Generate(fieldInfo, filepath.Join(fieldDir, "sumcheck"), bgen); err != nil {
+		return err
+	}
+	return test_vector_utils.Generate(test_vector_utils.Config{
+		FieldDependency:             fieldInfo,
+		RandomizeMissingHashEntries: false,
+	}, filepath.Join(fieldDir, "test_vector_utils"), bgen)
+}
+
+// generateBabyBear generates the fft package over the BabyBear field 𝔽ₚ,
+// p = 2³¹ - 2²⁷ + 1, whose 2-adicity is 27
+func generateBabyBear() error {
+	const (
+		modulus   = "0x78000001"
+		generator = 31 // generator of 𝔽ₚ*
+	)
+	fieldInfo := config.FieldDependency{
+		FieldPackagePath: "github.com/consensys/gnark-crypto/field/babybear",
+		FieldPackageName: "babybear",
+		ElementType:      "babybear.Element",
+	}
+
+	fftConf, err := fft.NewConfig(fieldInfo, modulus, generator, "")
+	if err != nil {
+		return err
+	}
+	return fft.Generate(fftConf, filepath.Join(baseDir, "field", "babybear", "fft"), bgen)
+}
+
 func assertNoError(err error) {
 	if err != nil {
 		fmt.Printf("\n%s\n", err.Error())
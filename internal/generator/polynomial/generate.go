@@ -1,13 +1,26 @@
 package polynomial
 
 import (
+	"embed"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// Templates are the polynomial templates, embedded for the generators running outside of
+// internal/generator (see GenerateWithTemplates)
+//
+//go:embed template
+var Templates embed.FS
+
 func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
+	return GenerateWithTemplates(conf, baseDir, "./polynomial/template/", generateTests, bgen)
+}
+
+// GenerateWithTemplates generates the polynomial package of the field conf in baseDir, from
+// the templates in templateDir
+func GenerateWithTemplates(conf config.FieldDependency, baseDir, templateDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
@@ -23,5 +36,5 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, b
 		)
 	}
 
-	return bgen.Generate(conf, "polynomial", "./polynomial/template/", entries...)
+	return bgen.Generate(conf, "polynomial", templateDir, entries...)
 }
@@ -3,6 +3,8 @@ package utils
 import (
 	"runtime"
 	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 // Would normally put this in internal/parallel; but it may be desirable for it to be accessible from gnark
@@ -62,3 +64,15 @@ func (wp *WorkerPool) Submit(n int, work func(int, int), minBlock int) *sync.Wai
 
 	return &wg
 }
+
+// Parallelize processes [0, nbIterations) in parallel: it splits it in contiguous chunks
+// [start, end), one per task, calls work on each of them in its own goroutine, and returns
+// when all the calls have returned. The number of tasks is runtime.NumCPU(), or maxCpus[0]
+// if given, clamped to [1, 512]; with a single task, work(0, nbIterations) is called in the
+// calling goroutine.
+//
+// It is the function gnark-crypto uses internally (internal/parallel.Execute), exposed for
+// the packages generated by goff and curvegen, which live outside of the module.
+func Parallelize(nbIterations int, work func(int, int), maxCpus ...int) {
+	parallel.Execute(nbIterations, work, maxCpus...)
+}
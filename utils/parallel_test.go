@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelize(t *testing.T) {
+	for _, nbIterations := range []int{0, 1, 7, 1000} {
+		for _, maxCpus := range [][]int{nil, {0}, {1}, {3}, {1000}} {
+			visited := make([]int32, nbIterations)
+			var nbCalls int32
+			Parallelize(nbIterations, func(start, end int) {
+				atomic.AddInt32(&nbCalls, 1)
+				if start >= end && nbIterations > 0 {
+					t.Errorf("empty chunk [%d, %d)", start, end)
+				}
+				for i := start; i < end; i++ {
+					atomic.AddInt32(&visited[i], 1)
+				}
+			}, maxCpus...)
+
+			for i := range visited {
+				if visited[i] != 1 {
+					t.Fatalf("%d iterations, maxCpus = %v: iteration %d processed %d times", nbIterations, maxCpus, i, visited[i])
+				}
+			}
+			if len(maxCpus) == 1 && maxCpus[0] <= 1 && nbCalls != 1 {
+				t.Fatalf("%d iterations, maxCpus = %v: work called %d times with a single task", nbIterations, maxCpus, nbCalls)
+			}
+		}
+	}
+}